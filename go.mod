@@ -12,6 +12,7 @@ replace (
 require (
 	github.com/alecthomas/template v0.0.0-20190718012654-fb15b899a751
 	github.com/beego/beego/v2 v2.0.2
+	github.com/bramvdbogaerde/go-scp v1.0.0
 	github.com/cloud-barista/cb-log v0.5.0
 	github.com/cloud-barista/cb-spider v0.5.0
 	github.com/cloud-barista/cb-store v0.5.0
@@ -33,6 +34,7 @@ require (
 	github.com/swaggo/echo-swagger v1.1.3
 	github.com/swaggo/swag v1.7.1
 	github.com/uber/jaeger-client-go v2.29.1+incompatible
+	golang.org/x/crypto v0.0.0-20210817164053-32db794688a5
 	golang.org/x/sync v0.0.0-20210220032951-036812b2e83c
 	google.golang.org/grpc v1.42.0
 	gopkg.in/yaml.v2 v2.4.0
@@ -1,12 +1,22 @@
 package app
 
 import (
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
 	"errors"
 	"fmt"
+	"net"
+	"net/url"
+	"path"
+	"regexp"
+	"strings"
 
 	"github.com/beego/beego/v2/core/validation"
 	"github.com/cloud-barista/cb-mcks/src/utils/lang"
 	"github.com/labstack/echo/v4"
+	"gopkg.in/yaml.v2"
 )
 
 func SendMessage(c echo.Context, httpCode int, msg string) error {
@@ -57,8 +67,16 @@ func ClusterReqValidate(req ClusterReq) error {
 	if len(req.Worker) == 0 {
 		return errors.New("Worker node must be at least one")
 	}
-	if !(req.Config.Kubernetes.NetworkCni == NETWORKCNI_CANAL || req.Config.Kubernetes.NetworkCni == NETWORKCNI_KILO) {
-		return errors.New("Network-cni allows only canal or kilo")
+	for _, nodeSet := range append(append([]NodeSetReq{}, req.ControlPlane...), req.Worker...) {
+		if err := NodeLabelsTaintsValidate(nodeSet.Labels, nodeSet.Taints); err != nil {
+			return err
+		}
+		if err := SSHReqValidate(nodeSet.SSH); err != nil {
+			return err
+		}
+	}
+	if !IsNetworkCni(req.Config.Kubernetes.NetworkCni) {
+		return errors.New("Network-cni allows only canal, kilo, calico, calico-wireguard, cilium or flannel")
 	}
 
 	if len(req.Name) == 0 {
@@ -82,10 +100,343 @@ func ClusterReqValidate(req ClusterReq) error {
 			return err
 		}
 	}
+	if err := KubernetesConfigReqValidate(req.Config.Kubernetes); err != nil {
+		return err
+	}
+	if req.Config.Kubernetes.Kilo != nil {
+		if req.Config.Kubernetes.NetworkCni != NETWORKCNI_KILO {
+			return errors.New("Kilo config is allowed only for the kilo network-cni")
+		}
+		if err := KiloReqValidate(*req.Config.Kubernetes.Kilo); err != nil {
+			return err
+		}
+	}
+	for _, addon := range req.Addons {
+		if err := AddonReqValidate(addon); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
 
+var (
+	featureGateRegex   = regexp.MustCompile(`^[A-Z][A-Za-z0-9]*$`)
+	extraArgKeyRegex   = regexp.MustCompile(`^[a-z0-9][a-z0-9\-]*$`)
+	quantityRegex      = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?(m|k|K|M|G|T|Ki|Mi|Gi|Ti)?$`)
+	thresholdRegex     = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?(%|k|K|M|G|T|Ki|Mi|Gi|Ti)?$`)
+	dnsLabelRegex      = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`)
+	dnsSubdomainRegex  = regexp.MustCompile(`^[a-z0-9]([-a-z0-9]*[a-z0-9])?(\.[a-z0-9]([-a-z0-9]*[a-z0-9])?)*$`)
+	absolutePathRegex  = regexp.MustCompile(`^/[A-Za-z0-9/_.\-]*$`)
+	oidcClaimRegex     = regexp.MustCompile(`^[A-Za-z0-9_:./\-]+$`)
+	labelNameRegex     = regexp.MustCompile(`^[A-Za-z0-9]([-A-Za-z0-9_.]*[A-Za-z0-9])?$`)
+	labelValueRegex    = regexp.MustCompile(`^(([A-Za-z0-9][-A-Za-z0-9_.]*)?[A-Za-z0-9])?$`)
+	sshUsernameRegex   = regexp.MustCompile(`^[a-z_][a-z0-9_\-]{0,31}$`)
+	labelSelectorRegex = regexp.MustCompile(`^[A-Za-z0-9./_\-=!,() ]+$`)
+	chartRegex         = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9./_:@\-]*$`)
+	chartRepoRegex     = regexp.MustCompile(`^https?://[A-Za-z0-9./_:@%~\-]+$`)
+	chartVersionRegex  = regexp.MustCompile(`^[A-Za-z0-9.+\-]+$`)
+)
+
+/* verify kubeadm cluster-configuration fields (feature-gates, extra-args, kubelet, cert-SANs, etcd) */
+func KubernetesConfigReqValidate(req ClusterConfigKubernetesReq) error {
+	for name := range req.FeatureGates {
+		if !featureGateRegex.MatchString(name) {
+			return errors.New(fmt.Sprintf("Feature-gate '%s' is invalid", name))
+		}
+	}
+	components := map[string]*ClusterConfigComponentReq{"apiServer": req.ApiServer, "controllerManager": req.ControllerManager, "scheduler": req.Scheduler}
+	for component, c := range components {
+		if c == nil {
+			continue
+		}
+		if err := extraArgsValidate(component, c.ExtraArgs); err != nil {
+			return err
+		}
+		if _, exists := c.ExtraArgs["advertise-address"]; exists && component == "apiServer" {
+			return errors.New("Extra-arg 'advertise-address' of apiServer is managed by MCKS")
+		}
+		for key := range c.ExtraArgs {
+			if component != "apiServer" {
+				break
+			}
+			if strings.HasPrefix(key, "oidc-") {
+				return errors.New(fmt.Sprintf("Extra-arg '%s' of apiServer is not allowed, use oidc", key))
+			}
+			if strings.HasPrefix(key, "audit-") {
+				return errors.New(fmt.Sprintf("Extra-arg '%s' of apiServer is not allowed, use audit", key))
+			}
+			if key == "encryption-provider-config" {
+				return errors.New(fmt.Sprintf("Extra-arg '%s' of apiServer is not allowed, use encryption", key))
+			}
+		}
+	}
+	if req.Kubelet != nil {
+		if req.Kubelet.MaxPods < 0 {
+			return errors.New("Kubelet maxPods must be zero or positive")
+		}
+		for _, reserved := range []map[string]string{req.Kubelet.SystemReserved, req.Kubelet.KubeReserved} {
+			for resource, quantity := range reserved {
+				if resource != "cpu" && resource != "memory" && resource != "ephemeral-storage" && resource != "pid" {
+					return errors.New(fmt.Sprintf("Kubelet reserved resource '%s' allows only cpu, memory, ephemeral-storage or pid", resource))
+				}
+				if !quantityRegex.MatchString(quantity) {
+					return errors.New(fmt.Sprintf("Kubelet reserved quantity '%s' is invalid. (resource=%s)", quantity, resource))
+				}
+			}
+		}
+		for signal, threshold := range req.Kubelet.EvictionHard {
+			switch signal {
+			case "memory.available", "nodefs.available", "nodefs.inodesFree", "imagefs.available", "imagefs.inodesFree", "pid.available":
+			default:
+				return errors.New(fmt.Sprintf("Kubelet eviction signal '%s' is not supported", signal))
+			}
+			if !thresholdRegex.MatchString(threshold) {
+				return errors.New(fmt.Sprintf("Kubelet eviction threshold '%s' is invalid. (signal=%s)", threshold, signal))
+			}
+		}
+	}
+	if err := EndpointReqValidate(EndpointReq{Endpoint: req.Endpoint, CertSANs: req.CertSANs}); err != nil {
+		return err
+	}
+	if req.Etcd != nil {
+		if len(req.Etcd.DataDir) > 0 && !absolutePathRegex.MatchString(req.Etcd.DataDir) {
+			return errors.New("Etcd dataDir must be an absolute path")
+		}
+		if err := extraArgsValidate("etcd", req.Etcd.ExtraArgs); err != nil {
+			return err
+		}
+	}
+	if req.Oidc != nil {
+		if err := OidcReqValidate(*req.Oidc); err != nil {
+			return err
+		}
+	}
+	if req.Audit != nil {
+		if err := AuditReqValidate(*req.Audit); err != nil {
+			return err
+		}
+	}
+	if req.Encryption != nil {
+		if err := EncryptionReqValidate(*req.Encryption); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+func AuditReqValidate(req ClusterConfigAuditReq) error {
+	switch req.Level {
+	case "", AUDIT_LEVEL_METADATA, AUDIT_LEVEL_REQUEST, AUDIT_LEVEL_REQUEST_RESPONSE:
+		if len(req.Policy) > 0 {
+			return errors.New("Audit policy is allowed only for the custom level")
+		}
+	case AUDIT_LEVEL_CUSTOM:
+		policy := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(req.Policy), &policy); err != nil {
+			return errors.New(fmt.Sprintf("Audit policy is invalid. (cause='%v')", err))
+		}
+		if policy["kind"] != "Policy" || !strings.HasPrefix(fmt.Sprint(policy["apiVersion"]), "audit.k8s.io/") {
+			return errors.New("Audit policy must be a 'Policy' of 'audit.k8s.io'")
+		}
+	default:
+		return errors.New("Audit level allows only metadata, request, request-response or custom")
+	}
+	if req.MaxAge < 0 || req.MaxBackup < 0 || req.MaxSize < 0 {
+		return errors.New("Audit maxAge, maxBackup and maxSize must be zero or positive")
+	}
+	return nil
+}
+func EncryptionReqValidate(req ClusterConfigEncryptionReq) error {
+	switch req.Provider {
+	case "", ENCRYPTION_PROVIDER_AESCBC, ENCRYPTION_PROVIDER_AESGCM:
+	default:
+		return errors.New("Encryption provider allows only aescbc or aesgcm")
+	}
+	for _, resource := range req.Resources {
+		if !dnsSubdomainRegex.MatchString(resource) {
+			return errors.New(fmt.Sprintf("Encryption resource '%s' is invalid", resource))
+		}
+	}
+	return nil
+}
+func OidcReqValidate(req ClusterConfigOidcReq) error {
+	u, err := url.Parse(req.IssuerUrl)
+	if err != nil || u.Scheme != "https" || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return errors.New(fmt.Sprintf("OIDC issuerUrl '%s' must be a https URL without a query and a fragment", req.IssuerUrl))
+	}
+	if len(req.ClientId) == 0 || strings.ContainsAny(req.ClientId, " \t\r\n") {
+		return errors.New("OIDC clientId is required and must not contain whitespaces")
+	}
+	for name, claim := range map[string]string{"usernameClaim": req.UsernameClaim, "groupsClaim": req.GroupsClaim} {
+		if len(claim) > 0 && !oidcClaimRegex.MatchString(claim) {
+			return errors.New(fmt.Sprintf("OIDC %s '%s' is invalid", name, claim))
+		}
+	}
+	for name, prefix := range map[string]string{"usernamePrefix": req.UsernamePrefix, "groupsPrefix": req.GroupsPrefix} {
+		if strings.ContainsAny(prefix, " \t\r\n'\"") {
+			return errors.New(fmt.Sprintf("OIDC %s '%s' must not contain whitespaces or quotes", name, prefix))
+		}
+	}
+	if len(req.CA) > 0 {
+		rest, count := []byte(req.CA), 0
+		for {
+			var block *pem.Block
+			if block, rest = pem.Decode(rest); block == nil {
+				break
+			}
+			if _, err := x509.ParseCertificate(block.Bytes); block.Type != "CERTIFICATE" || err != nil {
+				return errors.New("OIDC ca must contain only PEM encoded certificates")
+			}
+			count++
+		}
+		if count == 0 || len(strings.TrimSpace(string(rest))) > 0 {
+			return errors.New("OIDC ca must be PEM encoded certificates")
+		}
+	}
+	return nil
+}
+
+func EndpointReqValidate(req EndpointReq) error {
+	if len(req.Endpoint) > 0 && !isHost(req.Endpoint) {
+		return errors.New(fmt.Sprintf("Endpoint '%s' must be an IP address or a DNS name", req.Endpoint))
+	}
+	for _, san := range req.CertSANs {
+		if !isHost(san) {
+			return errors.New(fmt.Sprintf("Cert-SAN '%s' must be an IP address or a DNS name", san))
+		}
+	}
+	return nil
+}
+
+/* ssh settings of a node-set (unset fields are filled with global settings, except a username which falls back to a VM user account) */
+func SSHReqDef(req *SSHReq) SSHReq {
+	ssh := SSHReq{}
+	if req != nil {
+		ssh = *req
+	}
+	if ssh.Port == 0 {
+		ssh.Port = *Config.SSHPort
+	}
+	if ssh.DialTimeout == 0 {
+		ssh.DialTimeout = *Config.SSHDialTimeout
+	}
+	if ssh.Retries == 0 {
+		ssh.Retries = *Config.SSHRetries
+	}
+	if ssh.RetryInterval == 0 {
+		ssh.RetryInterval = *Config.SSHRetryInterval
+	}
+	if ssh.MaxRetryInterval == 0 {
+		ssh.MaxRetryInterval = *Config.SSHMaxRetryInterval
+	}
+	if ssh.MaxRetryInterval < ssh.RetryInterval {
+		ssh.MaxRetryInterval = ssh.RetryInterval
+	}
+	if ssh.Keepalive == 0 {
+		ssh.Keepalive = *Config.SSHKeepalive
+	}
+	return ssh
+}
+
+func SSHReqValidate(req *SSHReq) error {
+	if req == nil {
+		return nil
+	}
+	if req.Port < 0 || req.Port > 65535 {
+		return errors.New(fmt.Sprintf("SSH port '%d' is out of range (1~65535)", req.Port))
+	}
+	if len(req.Username) > 0 && !sshUsernameRegex.MatchString(req.Username) {
+		return errors.New(fmt.Sprintf("SSH username '%s' is invalid", req.Username))
+	}
+	if req.DialTimeout < 0 || req.Retries < 0 || req.RetryInterval < 0 || req.MaxRetryInterval < 0 {
+		return errors.New("SSH dial-timeout, retries and retry-intervals must not be negative")
+	}
+	return nil
+}
+
+func BastionReqDef(req *BastionReq) {
+	if req.Port == 0 {
+		req.Port = 22
+	}
+}
+
+func BastionReqValidate(req BastionReq) error {
+	if !isHost(req.Host) {
+		return errors.New(fmt.Sprintf("Bastion host '%s' must be an IP address or a DNS name", req.Host))
+	}
+	if req.Port < 1 || req.Port > 65535 {
+		return errors.New(fmt.Sprintf("Bastion port '%d' is out of range (1~65535)", req.Port))
+	}
+	if len(req.Username) == 0 {
+		return errors.New("Bastion username is required")
+	}
+	if block, _ := pem.Decode([]byte(req.PrivateKey)); block == nil {
+		return errors.New("Bastion private-key must be a PEM encoded private key")
+	}
+	return nil
+}
+
+/* verify an IP address or a DNS name */
+func isHost(host string) bool {
+	return net.ParseIP(host) != nil || (len(host) <= 253 && dnsSubdomainRegex.MatchString(host))
+}
+
+func extraArgsValidate(component string, args map[string]string) error {
+	for key, value := range args {
+		if !extraArgKeyRegex.MatchString(key) {
+			return errors.New(fmt.Sprintf("Extra-arg '%s' of %s is invalid", key, component))
+		}
+		if key == "feature-gates" {
+			return errors.New(fmt.Sprintf("Extra-arg 'feature-gates' of %s is not allowed, use featureGates", component))
+		}
+		if strings.ContainsAny(value, "\r\n") {
+			return errors.New(fmt.Sprintf("Extra-arg '%s' of %s must be a single line", key, component))
+		}
+	}
+	return nil
+}
+
+func KiloReqValidate(req ClusterConfigKiloReq) error {
+	switch req.Topology {
+	case "", KILO_TOPOLOGY_FULL_MESH, KILO_TOPOLOGY_REGION, KILO_TOPOLOGY_CSP, KILO_TOPOLOGY_CUSTOM:
+	default:
+		return errors.New("Kilo topology allows only full-mesh, region, csp or custom")
+	}
+	if req.Topology == KILO_TOPOLOGY_CUSTOM && len(req.Locations) == 0 {
+		return errors.New("Kilo locations are required for the custom topology")
+	}
+	for key, value := range req.Locations {
+		if !labelNameRegex.MatchString(value) {
+			return errors.New(fmt.Sprintf("Kilo location '%s' is invalid. (key=%s)", value, key))
+		}
+	}
+	if req.PersistentKeepalive != nil && *req.PersistentKeepalive < 0 {
+		return errors.New("Kilo persistent-keepalive must be zero (off) or positive")
+	}
+	if req.Port != nil && (*req.Port < 1 || *req.Port > 65535) {
+		return errors.New("Kilo port must be between 1 and 65535")
+	}
+	for key, value := range req.AllowedLocationIPs {
+		for _, cidr := range strings.Split(value, ",") {
+			if err := lang.VerifyCIDR("allowedLocationIPs", strings.TrimSpace(cidr)); err != nil {
+				return errors.New(fmt.Sprintf("Kilo allowed-location-ips is invalid. (location=%s, cause='%v')", key, err))
+			}
+		}
+	}
+
+	return nil
+}
+
+/* verify a network-cni is supported */
+func IsNetworkCni(networkCni NetworkCni) bool {
+	switch networkCni {
+	case NETWORKCNI_CANAL, NETWORKCNI_KILO, NETWORKCNI_CALICO, NETWORKCNI_CALICO_WIREGUARD, NETWORKCNI_CILIUM, NETWORKCNI_FLANNEL:
+		return true
+	}
+	return false
+}
+
 func NodeReqValidate(req NodeReq) error {
 	if len(req.ControlPlane) > 0 {
 		return errors.New("Control plane node is not supported")
@@ -93,6 +444,224 @@ func NodeReqValidate(req NodeReq) error {
 	if len(req.Worker) == 0 {
 		return errors.New("Worker node must be at least one")
 	}
+	for _, nodeSet := range req.Worker {
+		if err := NodeLabelsTaintsValidate(nodeSet.Labels, nodeSet.Taints); err != nil {
+			return err
+		}
+		if err := SSHReqValidate(nodeSet.SSH); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+func NodeUpdateReqValidate(req NodeUpdateReq) error {
+	return NodeLabelsTaintsValidate(req.Labels, req.Taints)
+}
+func SpecListReqDef(req *SpecListReq) {
+	req.ControlPlane = strings.ToUpper(lang.NVL(req.ControlPlane, "N"))
+	req.Gpu = strings.ToUpper(req.Gpu)
+	req.Sort = lang.NVL(req.Sort, "name")
+	switch strings.ToLower(req.Arch) {
+	case "amd64", "x86-64", SPEC_ARCH_X86_64:
+		req.Arch = SPEC_ARCH_X86_64
+	case "aarch64", SPEC_ARCH_ARM64:
+		req.Arch = SPEC_ARCH_ARM64
+	}
+	minimum := 1
+	if req.ControlPlane == "Y" {
+		minimum = SPEC_MIN_CPU_CONTROL_PLANE
+	}
+	if req.CpuMin == 0 {
+		req.CpuMin = minimum
+	}
+	if req.MemoryMin == 0 {
+		req.MemoryMin = float64(minimum)
+	}
+}
+func SpecListReqValidate(req SpecListReq) error {
+	if req.ControlPlane != "Y" && req.ControlPlane != "N" {
+		return errors.New(fmt.Sprintf("control-plane must be 'Y' or 'N'. (control-plane=%s)", req.ControlPlane))
+	}
+	if req.ControlPlane == "Y" {
+		if req.CpuMin < SPEC_MIN_CPU_CONTROL_PLANE {
+			return errors.New(fmt.Sprintf("Kubernetes control plane node needs %d cpu at least. (cpu-min=%d)", SPEC_MIN_CPU_CONTROL_PLANE, req.CpuMin))
+		}
+		if req.MemoryMin < SPEC_MIN_MEMORY_GIB {
+			return errors.New(fmt.Sprintf("Kubernetes control plane node needs %d memory at least. (memory-min=%g)", SPEC_MIN_MEMORY_GIB, req.MemoryMin))
+		}
+	}
+	if req.CpuMin < 0 || req.CpuMax < 0 || req.MemoryMin < 0 || req.MemoryMax < 0 || req.ClockMin < 0 || req.ClockMax < 0 || req.Limit < 0 {
+		return errors.New("cpu, memory, clock and limit must not be negative")
+	}
+	if req.CpuMax > 0 && req.CpuMin > req.CpuMax {
+		return errors.New(fmt.Sprintf("The cpu-max must be greater than or equal to the cpu-min. (cpu-min=%d cpu-max=%d)", req.CpuMin, req.CpuMax))
+	}
+	if req.MemoryMax > 0 && req.MemoryMin > req.MemoryMax {
+		return errors.New(fmt.Sprintf("The memory-max must be greater than or equal to the memory-min. (memory-min=%g memory-max=%g)", req.MemoryMin, req.MemoryMax))
+	}
+	if req.ClockMax > 0 && req.ClockMin > req.ClockMax {
+		return errors.New(fmt.Sprintf("The clock-max must be greater than or equal to the clock-min. (clock-min=%g clock-max=%g)", req.ClockMin, req.ClockMax))
+	}
+	if req.Arch != "" && req.Arch != SPEC_ARCH_X86_64 && req.Arch != SPEC_ARCH_ARM64 {
+		return errors.New(fmt.Sprintf("Architecture '%s' is not supported (x86_64, arm64)", req.Arch))
+	}
+	if req.Gpu != "" && req.Gpu != "Y" && req.Gpu != "N" {
+		return errors.New(fmt.Sprintf("gpu must be 'Y' or 'N'. (gpu=%s)", req.Gpu))
+	}
+	if _, err := path.Match(strings.ToLower(req.Name), ""); err != nil {
+		return errors.New(fmt.Sprintf("Invalid name pattern '%s'. (cause='%v')", req.Name, err))
+	}
+	switch strings.TrimPrefix(req.Sort, "-") {
+	case "name", "cpu", "memory", "clock":
+	default:
+		return errors.New(fmt.Sprintf("Sort '%s' is not supported (name, cpu, memory, clock with an optional '-' prefix)", req.Sort))
+	}
+	if req.Limit > SPEC_LIST_LIMIT_MAX {
+		return errors.New(fmt.Sprintf("limit must be less than or equal to %d. (limit=%d)", SPEC_LIST_LIMIT_MAX, req.Limit))
+	}
+	if req.Cursor != "" {
+		if cursor, err := DecodeSpecCursor(req.Cursor); err != nil {
+			return err
+		} else if cursor.Sort != req.Sort {
+			return errors.New(fmt.Sprintf("The cursor was issued for a different sort. (cursor=%s, sort=%s)", cursor.Sort, req.Sort))
+		}
+	}
+	return nil
+}
+func EncodeSpecCursor(cursor SpecCursor) string {
+	b, _ := json.Marshal(cursor)
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+func DecodeSpecCursor(value string) (*SpecCursor, error) {
+	cursor := &SpecCursor{}
+	b, err := base64.RawURLEncoding.DecodeString(value)
+	if err == nil {
+		err = json.Unmarshal(b, cursor)
+	}
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Invalid cursor '%s'", value))
+	}
+	return cursor, nil
+}
+func SpecRecommendReqDef(req *SpecRecommendReq) {
+	if req.Role == "" {
+		req.Role = WORKER
+	}
+	if req.Sort == "" {
+		req.Sort = SPEC_SORT_PRICE
+	}
+	if req.Limit == 0 {
+		req.Limit = SPEC_RECOMMEND_LIMIT
+	}
+	connections := []string{}
+	for _, connection := range req.Connections {
+		for _, name := range strings.Split(connection, ",") {
+			if name = strings.TrimSpace(name); name != "" {
+				connections = append(connections, name)
+			}
+		}
+	}
+	req.Connections = connections
+}
+func SpecRecommendReqValidate(req SpecRecommendReq) error {
+	if req.Role != CONTROL_PLANE && req.Role != WORKER {
+		return errors.New(fmt.Sprintf("Role '%s' is not supported (control-plane, worker)", req.Role))
+	}
+	if req.Cpu < 0 || req.Memory < 0 {
+		return errors.New(fmt.Sprintf("cpu and memory must not be negative. (cpu=%d, memory=%d)", req.Cpu, req.Memory))
+	}
+	if len(req.Connections) == 0 {
+		return errors.New("connection must be at least one")
+	}
+	if req.Sort != SPEC_SORT_PRICE && req.Sort != SPEC_SORT_FIT {
+		return errors.New(fmt.Sprintf("Sort '%s' is not supported (price, fit)", req.Sort))
+	}
+	if req.Limit < 1 || req.Limit > SPEC_RECOMMEND_LIMIT_MAX {
+		return errors.New(fmt.Sprintf("limit must be between 1 and %d. (limit=%d)", SPEC_RECOMMEND_LIMIT_MAX, req.Limit))
+	}
+	return nil
+}
+func NodeLabelsTaintsValidate(labels map[string]string, taints []NodeTaintReq) error {
+	for key, value := range labels {
+		if !isQualifiedName(key) {
+			return errors.New(fmt.Sprintf("Label key '%s' is invalid", key))
+		}
+		if IsReservedLabelKey(key) {
+			return errors.New(fmt.Sprintf("Label key '%s' is managed by MCKS", key))
+		}
+		if len(value) > 63 || !labelValueRegex.MatchString(value) {
+			return errors.New(fmt.Sprintf("Label value '%s' is invalid. (key=%s)", value, key))
+		}
+	}
+	for _, taint := range taints {
+		if !isQualifiedName(taint.Key) {
+			return errors.New(fmt.Sprintf("Taint key '%s' is invalid", taint.Key))
+		}
+		if len(taint.Value) > 63 || !labelValueRegex.MatchString(taint.Value) {
+			return errors.New(fmt.Sprintf("Taint value '%s' is invalid. (key=%s)", taint.Value, taint.Key))
+		}
+		if taint.Effect != "NoSchedule" && taint.Effect != "PreferNoSchedule" && taint.Effect != "NoExecute" {
+			return errors.New(fmt.Sprintf("Taint effect allows only NoSchedule, PreferNoSchedule or NoExecute. (key=%s)", taint.Key))
+		}
+	}
+	return nil
+}
+
+/* labels assigned by MCKS (topology & instance-type labels) */
+func IsReservedLabelKey(key string) bool {
+	return key == LABEL_KEY_CSP || key == LABEL_KEY_REGION || key == LABEL_KEY_ZONE || key == LABEL_KEY_INSTANCE_TYPE || strings.HasPrefix(key, "topology.cloud-barista.github.io/")
+}
+
+/* a qualified name of kubernetes labels & taints (an optional dns-subdomain prefix and a name) */
+func isQualifiedName(key string) bool {
+	name := key
+	if idx := strings.LastIndex(key, "/"); idx >= 0 {
+		if prefix := key[:idx]; len(prefix) == 0 || len(prefix) > 253 || !dnsSubdomainRegex.MatchString(prefix) {
+			return false
+		}
+		name = key[idx+1:]
+	}
+	return len(name) > 0 && len(name) <= 63 && labelNameRegex.MatchString(name)
+}
+
+func AddonReqValidate(req AddonReq) error {
+	if len(req.Name) == 0 {
+		return errors.New("Add-on name is empty")
+	}
+
+	return nil
+}
+
+func ManifestReqValidate(req ManifestReq) error {
+	if len(strings.TrimSpace(req.Manifest)) == 0 {
+		return errors.New("Manifest is empty")
+	}
+	if len(req.PruneSelector) > 0 && !labelSelectorRegex.MatchString(req.PruneSelector) {
+		return errors.New("Prune-selector must be a label selector. (e.g. app=nginx)")
+	}
+
+	return nil
+}
+
+func ReleaseReqValidate(req ReleaseReq) error {
+	if len(req.Name) == 0 {
+		return errors.New("Release name is empty")
+	} else if len(req.Name) > 53 || !dnsLabelRegex.MatchString(req.Name) {
+		return errors.New("Release name must consist of lower case alphanumeric characters or '-' (max 53 characters)")
+	}
+	if len(req.Namespace) > 0 && (len(req.Namespace) > 63 || !dnsLabelRegex.MatchString(req.Namespace)) {
+		return errors.New("Namespace must consist of lower case alphanumeric characters or '-' (max 63 characters)")
+	}
+	if len(req.Chart) > 0 && !chartRegex.MatchString(req.Chart) {
+		return errors.New("Chart is invalid")
+	}
+	if len(req.Repo) > 0 && !chartRepoRegex.MatchString(req.Repo) {
+		return errors.New("Repo must be a http(s) URL")
+	}
+	if len(req.Version) > 0 && !chartVersionRegex.MatchString(req.Version) {
+		return errors.New("Version is invalid")
+	}
 
 	return nil
 }
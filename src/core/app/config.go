@@ -3,6 +3,7 @@ package app
 import (
 	"flag"
 	"os"
+	"strconv"
 
 	"github.com/cloud-barista/cb-mcks/src/utils/lang"
 	"github.com/sirupsen/logrus"
@@ -11,15 +12,30 @@ import (
 )
 
 type conf struct {
-	SpiderCallMethod    *string
-	TumblebugCallMethod *string
-	SpiderUrl           *string
-	TumblebugUrl        *string
-	RootURL             *string
-	Username            *string
-	Password            *string
-	AppRootPath         *string
-	LoglevelHTTP        *bool
+	SpiderCallMethod     *string
+	TumblebugCallMethod  *string
+	SpiderUrl            *string
+	TumblebugUrl         *string
+	RootURL              *string
+	Username             *string
+	Password             *string
+	AppRootPath          *string
+	PricingFile          *string
+	LoglevelHTTP         *bool
+	SSHPort              *int
+	SSHUsername          *string
+	SSHDialTimeout       *int
+	SSHRetries           *int
+	SSHRetryInterval     *int
+	SSHMaxRetryInterval  *int
+	SSHKeepalive         *int
+	ProvisionConcurrency *int
+	JoinRetries          *int
+	BootstrapTimeout     *int
+	InitTimeout          *int
+	JoinTimeout          *int
+	CniTimeout           *int
+	NodeLogMaxSize       *int
 }
 
 var Config *conf
@@ -29,15 +45,30 @@ func Setup() {
 	var logLevel *string
 
 	Config = &conf{
-		AppRootPath:         flag.String("app-root", lang.NVL(os.Getenv("APP_ROOT"), ""), "application root path"),
-		RootURL:             flag.String("root-url", lang.NVL(os.Getenv("BASE_URL"), "/mcks"), "root url"),
-		SpiderCallMethod:    flag.String("spider-call-method", lang.NVL(os.Getenv("SPIDER_CALL_METHOD"), "REST"), "Method of calling CB-Spider (REST/gRPC)"),
-		TumblebugCallMethod: flag.String("tumblebug-call-method", lang.NVL(os.Getenv("TUMBLEBUG_CALL_METHOD"), "REST"), "Method of calling CB-Tumblebug (REST/gRPC)"),
-		SpiderUrl:           flag.String("spider-url", lang.NVL(os.Getenv("SPIDER_URL"), "http://localhost:1024/spider"), "cb-spider service end-point url"),
-		TumblebugUrl:        flag.String("tumblebug-url", lang.NVL(os.Getenv("TUMBLEBUG_URL"), "http://localhost:1323/tumblebug"), "cb-tumblebug service end-point url"),
-		Username:            flag.String("basic-auth-username", lang.NVL(os.Getenv("BASIC_AUTH_USERNAME"), "default"), "rest-api basic auth usernmae"),
-		Password:            flag.String("basic-auth-password", lang.NVL(os.Getenv("BASIC_AUTH_PASSWORD"), "default"), "rest-api basic auth password"),
-		LoglevelHTTP:        flag.Bool("log-http", os.Getenv("LOG_HTTP") == "true", "The logging http data"),
+		AppRootPath:          flag.String("app-root", lang.NVL(os.Getenv("APP_ROOT"), ""), "application root path"),
+		RootURL:              flag.String("root-url", lang.NVL(os.Getenv("BASE_URL"), "/mcks"), "root url"),
+		SpiderCallMethod:     flag.String("spider-call-method", lang.NVL(os.Getenv("SPIDER_CALL_METHOD"), "REST"), "Method of calling CB-Spider (REST/gRPC)"),
+		TumblebugCallMethod:  flag.String("tumblebug-call-method", lang.NVL(os.Getenv("TUMBLEBUG_CALL_METHOD"), "REST"), "Method of calling CB-Tumblebug (REST/gRPC)"),
+		SpiderUrl:            flag.String("spider-url", lang.NVL(os.Getenv("SPIDER_URL"), "http://localhost:1024/spider"), "cb-spider service end-point url"),
+		TumblebugUrl:         flag.String("tumblebug-url", lang.NVL(os.Getenv("TUMBLEBUG_URL"), "http://localhost:1323/tumblebug"), "cb-tumblebug service end-point url"),
+		Username:             flag.String("basic-auth-username", lang.NVL(os.Getenv("BASIC_AUTH_USERNAME"), "default"), "rest-api basic auth usernmae"),
+		Password:             flag.String("basic-auth-password", lang.NVL(os.Getenv("BASIC_AUTH_PASSWORD"), "default"), "rest-api basic auth password"),
+		LoglevelHTTP:         flag.Bool("log-http", os.Getenv("LOG_HTTP") == "true", "The logging http data"),
+		PricingFile:          flag.String("pricing-file", lang.NVL(os.Getenv("PRICING_FILE"), ""), "pricing table file path (default : <app-root>/conf/pricing.yaml)"),
+		SSHPort:              flag.Int("ssh-port", envInt("SSH_PORT", 22), "ssh port of nodes"),
+		SSHUsername:          flag.String("ssh-username", lang.NVL(os.Getenv("SSH_USERNAME"), "cb-user"), "ssh username of nodes (if a VM user account is empty)"),
+		SSHDialTimeout:       flag.Int("ssh-dial-timeout", envInt("SSH_DIAL_TIMEOUT", 10), "ssh dial timeout (seconds)"),
+		SSHRetries:           flag.Int("ssh-retries", envInt("SSH_RETRIES", 15), "ssh connection attempts"),
+		SSHRetryInterval:     flag.Int("ssh-retry-interval", envInt("SSH_RETRY_INTERVAL", 2), "an initial interval of ssh connection attempts (seconds, doubled every retry)"),
+		SSHMaxRetryInterval:  flag.Int("ssh-max-retry-interval", envInt("SSH_MAX_RETRY_INTERVAL", 16), "a maximum interval of ssh connection attempts (seconds)"),
+		SSHKeepalive:         flag.Int("ssh-keepalive", envInt("SSH_KEEPALIVE", 30), "an interval of ssh keepalive requests (seconds, a negative value disables keepalive)"),
+		ProvisionConcurrency: flag.Int("provision-concurrency", envInt("PROVISION_CONCURRENCY", 10), "a maximum number of nodes which are bootstrapped or joined concurrently"),
+		JoinRetries:          flag.Int("join-retries", envInt("JOIN_RETRIES", 1), "retries of a failed worker-node join"),
+		BootstrapTimeout:     flag.Int("bootstrap-timeout", envInt("BOOTSTRAP_TIMEOUT", 1200), "a timeout of a bootstrap step (seconds, 0 : no timeout)"),
+		InitTimeout:          flag.Int("init-timeout", envInt("INIT_TIMEOUT", 600), "a timeout of a control-plane init step (seconds, 0 : no timeout)"),
+		JoinTimeout:          flag.Int("join-timeout", envInt("JOIN_TIMEOUT", 600), "a timeout of a control-plane & worker-node join step (seconds, 0 : no timeout)"),
+		CniTimeout:           flag.Int("cni-timeout", envInt("CNI_TIMEOUT", 600), "a timeout of a network-cni installation step (seconds, 0 : no timeout)"),
+		NodeLogMaxSize:       flag.Int("node-log-max-size", envInt("NODE_LOG_MAX_SIZE", 262144), "a maximum size of a node log per a step (bytes, older outputs are truncated)"),
 	}
 	logLevel = flag.String("log-level", lang.NVL(os.Getenv("LOG_LEVEL"), "debug"), "The log level")
 
@@ -67,4 +98,18 @@ func Setup() {
 		}
 	}
 
+	// pricing table
+	if len(*Config.PricingFile) == 0 {
+		path := *Config.AppRootPath + "/conf/pricing.yaml"
+		Config.PricingFile = &path
+	}
+
+}
+
+/* an integer environment variable (a default value if not set or invalid) */
+func envInt(key string, def int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return value
+	}
+	return def
 }
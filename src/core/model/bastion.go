@@ -0,0 +1,62 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+)
+
+/* new instance of bastion-entity (a jump-host of a connection) */
+func NewBastion(connection string) *Bastion {
+	return &Bastion{
+		Model:      Model{Kind: app.KIND_BASTION, Name: connection},
+		Connection: connection,
+		Port:       22,
+	}
+}
+
+/* bastion-entity */
+func (self *Bastion) PutStore() error {
+	key := getStoreBastionKey(self.Connection)
+	value, _ := json.Marshal(self)
+	err := app.CBStore.Put(key, string(value))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (self *Bastion) Select() (bool, error) {
+	exists := false
+
+	key := getStoreBastionKey(self.Connection)
+	keyValue, err := app.CBStore.Get(key)
+	if err != nil {
+		return exists, err
+	}
+	exists = (keyValue != nil)
+	if exists {
+		json.Unmarshal([]byte(keyValue.Value), &self)
+	}
+
+	return exists, nil
+}
+
+func (self *Bastion) Delete() error {
+	key := getStoreBastionKey(self.Connection)
+	if err := app.CBStore.Delete(key); err != nil {
+		return err
+	}
+	return nil
+}
+
+/* a ssh address of a bastion */
+func (self *Bastion) Address() string {
+	return fmt.Sprintf("%s:%d", self.Host, self.Port)
+}
+
+// get store bastion key
+func getStoreBastionKey(connection string) string {
+	return fmt.Sprintf("/bastions/%s", connection)
+}
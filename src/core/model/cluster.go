@@ -16,6 +16,7 @@ func NewCluster(namespace string, name string) *Cluster {
 		Namespace: namespace,
 		Status:    ClusterStatus{Phase: ClusterPhasePending, Reason: "", Message: ""},
 		Nodes:     []*Node{},
+		Addons:    []*Addon{},
 	}
 }
 
@@ -47,6 +48,32 @@ func NewNodeList(namespace string, clusterName string) *NodeList {
 	}
 }
 
+/* new instance of add-on entity */
+func NewAddon(name string, version string) *Addon {
+	return &Addon{
+		Model:   Model{Kind: app.KIND_ADDON, Name: name},
+		Version: version,
+	}
+}
+
+/* new instance of add-on entity list */
+func NewAddonList(namespace string, clusterName string) *AddonList {
+	return &AddonList{
+		ListModel:   ListModel{Kind: app.KIND_ADDON_LIST},
+		Items:       []*Addon{},
+		namespace:   namespace,
+		clusterName: clusterName,
+	}
+}
+
+/* new instance of manifest apply result */
+func NewManifestResult() *ManifestResult {
+	return &ManifestResult{
+		ListModel: ListModel{Kind: app.KIND_MANIFEST},
+		Items:     []ManifestObject{},
+	}
+}
+
 /* cluster-entity */
 func (self *Cluster) UpdatePhase(phase ClusterPhase) error {
 	self.Status.Phase = phase
@@ -95,6 +122,22 @@ func (self *Cluster) Select() (bool, error) {
 
 func (self *Cluster) Delete() error {
 
+	// delete releases
+	releases := NewReleaseList(self.Namespace, self.Name)
+	if err := releases.SelectList(); err != nil {
+		return err
+	}
+	for _, release := range releases.Items {
+		if err := release.Delete(); err != nil {
+			return err
+		}
+	}
+
+	// delete node logs
+	if err := deleteClusterNodeLogs(self.Namespace, self.Name); err != nil {
+		return err
+	}
+
 	// delete cluster
 	key := getStoreClusterKey(self.Namespace, self.Name)
 	if err := app.CBStore.Delete(key); err != nil {
@@ -104,6 +147,16 @@ func (self *Cluster) Delete() error {
 	return nil
 }
 
+/* whether a csi driver is deployed to nodes of a csp */
+func (self *Cluster) HasCsiDriver(csp app.CSP) bool {
+	for _, c := range self.CsiDrivers {
+		if c == csp {
+			return true
+		}
+	}
+	return false
+}
+
 func (self *Cluster) NextNodeIndex(role app.ROLE) int {
 
 	max := 0
@@ -138,6 +191,9 @@ func (self *Cluster) DeleteNode(nodeName string) error {
 	if err := self.PutStore(); err != nil {
 		return err
 	}
+	if err := NewNodeLogList(self.Namespace, self.Name, nodeName).Delete(); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -153,13 +209,56 @@ func (self *Cluster) ExistsNode(nodeName string) bool {
 
 }
 
+func (self *Cluster) GetAddon(addonName string) *Addon {
+
+	for _, addon := range self.Addons {
+		if addon.Name == addonName {
+			return addon
+		}
+	}
+	return nil
+}
+
+/* put (append or replace) an add-on & save a cluster-entity */
+func (self *Cluster) PutAddon(addon *Addon) error {
+
+	replaced := false
+	for i, a := range self.Addons {
+		if a.Name == addon.Name {
+			self.Addons[i] = addon
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		self.Addons = append(self.Addons, addon)
+	}
+	return self.PutStore()
+}
+
+func (self *Cluster) DeleteAddon(addonName string) error {
+
+	for i, addon := range self.Addons {
+		if addon.Name == addonName {
+			self.Addons = append(self.Addons[:i], self.Addons[i+1:]...)
+			break
+		}
+	}
+	return self.PutStore()
+}
+
 func (self *ClusterList) SelectList() error {
 	keyValues, err := app.CBStore.GetList(getStoreClusterKey(self.namespace, ""), true)
 	if err != nil {
 		return err
 	}
 	self.Items = []Cluster{}
+	prefix := getStoreClusterKey(self.namespace, "") + "/"
 	for _, keyValue := range keyValues {
+		// skip sub-keys of a cluster (e.g. releases)
+		if strings.Contains(strings.TrimPrefix(keyValue.Key, prefix), "/") {
+			continue
+		}
 		if !strings.Contains(keyValue.Key, "/nodes") {
 			cluster := &Cluster{}
 			json.Unmarshal([]byte(keyValue.Value), &cluster)
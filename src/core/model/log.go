@@ -0,0 +1,153 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/utils/lang"
+)
+
+var nodeLogMutex sync.Mutex
+
+/* new instance of node-log-entity (outputs of remote commands of a node in a provisioning step) */
+func NewNodeLog(namespace string, clusterName string, nodeName string, step app.Step) *NodeLog {
+	return &NodeLog{
+		Model:       Model{Kind: app.KIND_NODE_LOG, Name: string(step)},
+		namespace:   namespace,
+		clusterName: clusterName,
+		Node:        nodeName,
+		Step:        step,
+	}
+}
+
+/* new instance of node-log-entity list */
+func NewNodeLogList(namespace string, clusterName string, nodeName string) *NodeLogList {
+	return &NodeLogList{
+		ListModel:   ListModel{Kind: app.KIND_NODE_LOG_LIST},
+		namespace:   namespace,
+		clusterName: clusterName,
+		node:        nodeName,
+		Items:       []*NodeLog{},
+	}
+}
+
+/* node-log-entity */
+func (self *NodeLog) PutStore() error {
+	key := getStoreNodeLogKey(self.namespace, self.clusterName, self.Node, self.Step)
+	value, _ := json.Marshal(self)
+	err := app.CBStore.Put(key, string(value))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (self *NodeLog) Select() (bool, error) {
+	exists := false
+
+	key := getStoreNodeLogKey(self.namespace, self.clusterName, self.Node, self.Step)
+	keyValue, err := app.CBStore.Get(key)
+	if err != nil {
+		return exists, err
+	}
+	exists = (keyValue != nil)
+	if exists {
+		json.Unmarshal([]byte(keyValue.Value), &self)
+	}
+
+	return exists, nil
+}
+
+func (self *NodeLog) Delete() error {
+	key := getStoreNodeLogKey(self.namespace, self.clusterName, self.Node, self.Step)
+	if err := app.CBStore.Delete(key); err != nil {
+		return err
+	}
+	return nil
+}
+
+/* append an output to a log (older outputs are truncated over a maximum size, maxSize <= 0 : unlimited) */
+func (self *NodeLog) Append(output string, maxSize int) {
+
+	self.Output += output
+	if maxSize > 0 && len(self.Output) > maxSize {
+		output := self.Output[len(self.Output)-maxSize:]
+		if idx := strings.Index(output, "\n"); idx >= 0 {
+			output = output[idx+1:] // cut on a line boundary
+		}
+		self.Output = output
+		self.Truncated = true
+	}
+	self.Size = len(self.Output)
+	self.UpdatedTime = lang.GetNowUTC()
+}
+
+/* append an output to a log of a node & a step and save it */
+func AppendNodeLog(namespace string, clusterName string, nodeName string, step app.Step, output string, maxSize int) error {
+	nodeLogMutex.Lock()
+	defer nodeLogMutex.Unlock()
+
+	log := NewNodeLog(namespace, clusterName, nodeName, step)
+	if _, err := log.Select(); err != nil {
+		return err
+	}
+	log.Append(output, maxSize)
+	return log.PutStore()
+}
+
+func (self *NodeLogList) SelectList() error {
+	prefix := getStoreNodeLogKey(self.namespace, self.clusterName, self.node, "") + "/"
+	keyValues, err := app.CBStore.GetList(prefix, true)
+	if err != nil {
+		return err
+	}
+	self.Items = []*NodeLog{}
+	for _, keyValue := range keyValues {
+		log := NewNodeLog(self.namespace, self.clusterName, self.node, "")
+		json.Unmarshal([]byte(keyValue.Value), &log)
+		self.Items = append(self.Items, log)
+	}
+
+	return nil
+}
+
+/* delete logs of a node */
+func (self *NodeLogList) Delete() error {
+	if err := self.SelectList(); err != nil {
+		return err
+	}
+	for _, log := range self.Items {
+		if err := log.Delete(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/* delete logs of all nodes of a cluster (contains logs of removed nodes) */
+func deleteClusterNodeLogs(namespace string, clusterName string) error {
+	keyValues, err := app.CBStore.GetList(getStoreClusterKey(namespace, clusterName)+"/nodes/", true)
+	if err != nil {
+		return err
+	}
+	for _, keyValue := range keyValues {
+		if strings.Contains(keyValue.Key, "/logs/") {
+			if err := app.CBStore.Delete(keyValue.Key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// get store node-log key
+func getStoreNodeLogKey(namespace string, clusterName string, nodeName string, step app.Step) string {
+	if step == "" {
+		return fmt.Sprintf("%s/nodes/%s/logs", getStoreClusterKey(namespace, clusterName), nodeName)
+	} else {
+		return fmt.Sprintf("%s/nodes/%s/logs/%s", getStoreClusterKey(namespace, clusterName), nodeName, step)
+	}
+}
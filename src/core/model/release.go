@@ -0,0 +1,91 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+)
+
+/* new instance of release-entity */
+func NewRelease(namespace string, clusterName string, releaseName string) *Release {
+	return &Release{
+		Model:       Model{Kind: app.KIND_RELEASE, Name: releaseName},
+		cbNamespace: namespace,
+		clusterName: clusterName,
+	}
+}
+
+/* new instance of release-entity list */
+func NewReleaseList(namespace string, clusterName string) *ReleaseList {
+	return &ReleaseList{
+		ListModel:   ListModel{Kind: app.KIND_RELEASE_LIST},
+		cbNamespace: namespace,
+		clusterName: clusterName,
+		Items:       []*Release{},
+	}
+}
+
+/* release-entity */
+func (self *Release) PutStore() error {
+	key := getStoreReleaseKey(self.cbNamespace, self.clusterName, self.Name)
+	value, _ := json.Marshal(self)
+	err := app.CBStore.Put(key, string(value))
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+func (self *Release) Select() (bool, error) {
+	exists := false
+
+	key := getStoreReleaseKey(self.cbNamespace, self.clusterName, self.Name)
+	keyValue, err := app.CBStore.Get(key)
+	if err != nil {
+		return exists, err
+	}
+	exists = (keyValue != nil)
+	if exists {
+		json.Unmarshal([]byte(keyValue.Value), &self)
+	}
+
+	return exists, nil
+}
+
+func (self *Release) Delete() error {
+	key := getStoreReleaseKey(self.cbNamespace, self.clusterName, self.Name)
+	if err := app.CBStore.Delete(key); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (self *ReleaseList) SelectList() error {
+	prefix := getStoreReleaseKey(self.cbNamespace, self.clusterName, "") + "/"
+	keyValues, err := app.CBStore.GetList(prefix, true)
+	if err != nil {
+		return err
+	}
+	self.Items = []*Release{}
+	for _, keyValue := range keyValues {
+		if strings.Contains(strings.TrimPrefix(keyValue.Key, prefix), "/") {
+			continue
+		}
+		release := NewRelease(self.cbNamespace, self.clusterName, "")
+		json.Unmarshal([]byte(keyValue.Value), &release)
+		self.Items = append(self.Items, release)
+	}
+
+	return nil
+}
+
+// get store release key
+func getStoreReleaseKey(namespace string, clusterName string, releaseName string) string {
+	if releaseName == "" {
+		return fmt.Sprintf("%s/releases", getStoreClusterKey(namespace, clusterName))
+	} else {
+		return fmt.Sprintf("%s/releases/%s", getStoreClusterKey(namespace, clusterName), releaseName)
+	}
+}
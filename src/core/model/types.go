@@ -6,6 +6,8 @@ import (
 
 type ClusterPhase string
 type ClusterReason string
+type AddonPhase string
+type PlanAction string
 
 const (
 	ClusterPhasePending      = ClusterPhase("Pending")
@@ -32,6 +34,14 @@ const (
 	SetupNetworkCNIFailedReason               = ClusterReason("SetupNetworkCNIFailedReason")
 	JoinControlPlaneFailedReason              = ClusterReason("JoinControlPlaneFailedReason")
 	JoinWorkerFailedReason                    = ClusterReason("JoinWorkerFailedReason")
+	StepTimeoutReason                         = ClusterReason("StepTimeoutReason")
+
+	AddonPhaseInstalled = AddonPhase("Installed")
+	AddonPhaseFailed    = AddonPhase("Failed")
+
+	PlanActionCreate = PlanAction("create")
+	PlanActionReuse  = PlanAction("reuse")
+	PlanActionDelete = PlanAction("delete")
 )
 
 type Model struct {
@@ -44,18 +54,83 @@ type ListModel struct {
 
 type Cluster struct {
 	Model
-	Status          ClusterStatus  `json:"status"`
-	MCIS            string         `json:"mcis"`
-	Namespace       string         `json:"namespace"`
-	Version         string         `json:"k8sVersion"`
-	ClusterConfig   string         `json:"clusterConfig"`
-	CpLeader        string         `json:"cpLeader"`
-	NetworkCni      app.NetworkCni `json:"networkCni" enums:"canal,kilo"`
-	Label           string         `json:"label"`
-	InstallMonAgent string         `json:"installMonAgent" example:"no" default:"yes"`
-	Description     string         `json:"description"`
-	CreatedTime     string         `json:"createdTime" example:"2022-01-02T12:00:00Z" default:""`
-	Nodes           []*Node        `json:"nodes"`
+	Status          ClusterStatus                   `json:"status"`
+	MCIS            string                          `json:"mcis"`
+	Namespace       string                          `json:"namespace"`
+	Version         string                          `json:"k8sVersion"`
+	ClusterConfig   string                          `json:"clusterConfig"`
+	CpLeader        string                          `json:"cpLeader"`
+	NetworkCni      app.NetworkCni                  `json:"networkCni" enums:"canal,kilo,calico,calico-wireguard,cilium,flannel"`
+	Kilo            *app.ClusterConfigKiloReq       `json:"kilo,omitempty"`
+	Endpoint        string                          `json:"endpoint" example:"api.cluster-01.example.com"`
+	CertSANs        []string                        `json:"certSANs,omitempty"`
+	Oidc            *app.ClusterConfigOidcReq       `json:"oidc,omitempty"`
+	Audit           *app.ClusterConfigAuditReq      `json:"audit,omitempty"`
+	Encryption      *app.ClusterConfigEncryptionReq `json:"encryption,omitempty"`
+	CsiDrivers      []app.CSP                       `json:"csiDrivers,omitempty"` // csps which a csi driver is deployed to
+	Label           string                          `json:"label"`
+	InstallMonAgent string                          `json:"installMonAgent" example:"no" default:"yes"`
+	Description     string                          `json:"description"`
+	CreatedTime     string                          `json:"createdTime" example:"2022-01-02T12:00:00Z" default:""`
+	Nodes           []*Node                         `json:"nodes"`
+	Addons          []*Addon                        `json:"addons"`
+	Cost            *CostEstimate                   `json:"cost,omitempty"` // estimated on GetCluster
+}
+
+type CostEstimate struct {
+	Kind     app.Kind   `json:"kind"`
+	Currency string     `json:"currency" example:"USD"`
+	Hourly   float64    `json:"hourly" example:"0.2304"`
+	Monthly  float64    `json:"monthly" example:"168.19"` // 730 hours
+	Items    []CostItem `json:"items"`
+}
+
+type CostItem struct {
+	Role       app.ROLE `json:"role" enums:"control-plane,worker"`
+	Connection string   `json:"connection" example:"config-aws-ap-northeast-2"`
+	Csp        app.CSP  `json:"csp" enums:"aws,gcp,azure,alibaba,tencent,openstack,ibm,cloudit"`
+	Region     string   `json:"region" example:"ap-northeast-2"`
+	Spec       string   `json:"spec" example:"t2.medium"`
+	Count      int      `json:"count" example:"3"`
+	UnitHourly float64  `json:"unitHourly" example:"0.0576"`
+	Hourly     float64  `json:"hourly" example:"0.1728"`
+	Monthly    float64  `json:"monthly" example:"126.14"`
+	Source     string   `json:"source" enums:"table,spider,unknown"` // unknown : no price (excluded from a total)
+}
+
+type ClusterPlan struct {
+	Kind      app.Kind       `json:"kind"`
+	Namespace string         `json:"namespace" example:"cb-mcks-ns"`
+	Name      string         `json:"name" example:"cluster-01"`
+	Version   string         `json:"version" example:"1.23.14-00"`
+	Valid     bool           `json:"valid"`            // false if there are errors (nothing would be created)
+	Errors    []PlanError    `json:"errors,omitempty"` // errors that CreateCluster would fail with
+	Nodes     []PlanNode     `json:"nodes"`
+	Resources []PlanResource `json:"resources"` // resources to create, reuse or delete (in order)
+	Addons    []string       `json:"addons"`
+}
+
+type PlanError struct {
+	Reason  ClusterReason `json:"reason,omitempty"`
+	Message string        `json:"message"`
+}
+
+type PlanNode struct {
+	Name       string   `json:"name" example:"c-1-*"` // a random suffix is generated on a creation
+	Role       app.ROLE `json:"role" enums:"control-plane,worker"`
+	Connection string   `json:"connection" example:"config-aws-ap-northeast-2"`
+	Csp        app.CSP  `json:"csp" enums:"aws,gcp,azure,alibaba,tencent,openstack,ibm,cloudit"`
+	Region     string   `json:"region" example:"ap-northeast-2"`
+	Zone       string   `json:"zone" example:"ap-northeast-2a"`
+	Spec       string   `json:"spec" example:"t2.medium"`
+}
+
+type PlanResource struct {
+	Kind       string     `json:"kind" enums:"cluster,mcis,vpc,firewall,sshKey,image,spec"`
+	Name       string     `json:"name" example:"config-aws-ap-northeast-2-vpc"`
+	Connection string     `json:"connection,omitempty" example:"config-aws-ap-northeast-2"`
+	Action     PlanAction `json:"action" enums:"create,reuse,delete"`
+	Message    string     `json:"message,omitempty"`
 }
 
 type ClusterStatus struct {
@@ -72,22 +147,116 @@ type ClusterList struct {
 
 type Node struct {
 	Model
+	namespace         string
+	clusterName       string
+	Credential        string             `json:"credential"`
+	PublicIP          string             `json:"publicIp"`
+	PrivateIP         string             `json:"privateIp,omitempty"`
+	Connection        string             `json:"connection,omitempty" example:"config-aws-ap-northeast-2"`
+	Role              app.ROLE           `json:"role" enums:"control-plane,worker"`
+	Spec              string             `json:"spec"`
+	Csp               app.CSP            `json:"csp" enums:"aws,gcp,azure,alibaba,tencent,openstack,ibm,cloudit"`
+	CreatedTime       string             `json:"createdTime" example:"2022-01-02T12:00:00Z" default:""`
+	CspLabel          string             `json:"cspLabel"`
+	RegionLabel       string             `json:"regionLabel"`
+	ZoneLabel         string             `json:"zoneLabel"`
+	InstanceTypeLabel string             `json:"instanceTypeLabel"`
+	ConnectionLabel   string             `json:"connectionLabel"`
+	ProviderId        string             `json:"providerId" example:"tumblebug://default/cluster-01/cluster-01-w-1-abcde"`
+	HostKey           string             `json:"hostKey,omitempty" example:"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIL..."` // a pinned ssh host key
+	SSH               *app.SSHReq        `json:"ssh,omitempty"`                                                         // ssh settings of a node
+	Labels            map[string]string  `json:"labels,omitempty"`
+	Taints            []app.NodeTaintReq `json:"taints,omitempty"`
+}
+
+type NodeList struct {
+	ListModel
 	namespace   string
 	clusterName string
-	Credential  string   `json:"credential"`
-	PublicIP    string   `json:"publicIp"`
-	Role        app.ROLE `json:"role" enums:"control-plane,worker"`
-	Spec        string   `json:"spec"`
-	Csp         app.CSP  `json:"csp" enums:"aws,gcp,azure,alibaba,tencent,openstack,ibm,cloudit"`
-	CreatedTime string   `json:"createdTime" example:"2022-01-02T12:00:00Z" default:""`
-	CspLabel    string   `json:"cspLabel"`
-	RegionLabel string   `json:"regionLabel"`
-	ZoneLabel   string   `json:"zoneLabel"`
+	Items       []*Node       `json:"items"`
+	Failed      []NodeFailure `json:"failed,omitempty"` // nodes which are failed to join and have been removed
 }
 
-type NodeList struct {
+type NodeFailure struct {
+	Name    string `json:"name" example:"w-3-abcde"`
+	Message string `json:"message"`
+}
+
+type Addon struct {
+	Model
+	Version       string     `json:"version" example:"v0.5.2"`
+	Phase         AddonPhase `json:"phase" enums:"Installed,Failed"`
+	Message       string     `json:"message"`
+	InstalledTime string     `json:"installedTime" example:"2022-01-02T12:00:00Z" default:""`
+}
+
+type AddonList struct {
 	ListModel
 	namespace   string
 	clusterName string
-	Items       []*Node `json:"items"`
+	Items       []*Addon `json:"items"`
+}
+
+type ManifestResult struct {
+	ListModel
+	Items []ManifestObject `json:"items"`
+}
+
+type ManifestObject struct {
+	Resource string `json:"resource" example:"deployment.apps"`
+	Name     string `json:"name" example:"nginx"`
+	Action   string `json:"action" example:"serverside-applied"`
+	Message  string `json:"message"`
+}
+
+type Bastion struct {
+	Model
+	Connection string `json:"connection" example:"config-aws-ap-northeast-2"`
+	Host       string `json:"host" example:"3.35.10.21"`
+	Port       int    `json:"port" example:"22"`
+	Username   string `json:"username" example:"cb-user"`
+	PrivateKey string `json:"privateKey,omitempty"`
+	HostKey    string `json:"hostKey,omitempty" example:"ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIL..."` // a pinned ssh host key (empty : trust-on-first-use)
+}
+
+type NodeLog struct {
+	Model
+	namespace   string
+	clusterName string
+	Node        string   `json:"node" example:"w-1-abcde"`
+	Step        app.Step `json:"step" enums:"bootstrap,haproxy,init,join,cni,other"`
+	Size        int      `json:"size" example:"1024"`
+	Truncated   bool     `json:"truncated"` // older outputs have been truncated (a maximum size of a log)
+	Output      string   `json:"output"`
+	UpdatedTime string   `json:"updatedTime" example:"2022-01-02T12:00:00Z" default:""`
+}
+
+type NodeLogList struct {
+	ListModel
+	namespace   string
+	clusterName string
+	node        string
+	Items       []*NodeLog `json:"items"`
+}
+
+type Release struct {
+	Model
+	cbNamespace  string
+	clusterName  string
+	Namespace    string `json:"namespace" example:"default"`
+	Chart        string `json:"chart" example:"nginx"`
+	Repo         string `json:"repo" example:"https://charts.bitnami.com/bitnami"`
+	ChartVersion string `json:"chartVersion" example:"13.2.10"`
+	AppVersion   string `json:"appVersion" example:"1.23.1"`
+	Revision     int    `json:"revision" example:"1"`
+	Status       string `json:"status" example:"deployed"`
+	Values       string `json:"values"`
+	UpdatedTime  string `json:"updatedTime" example:"2022-01-02T12:00:00Z" default:""`
+}
+
+type ReleaseList struct {
+	ListModel
+	cbNamespace string
+	clusterName string
+	Items       []*Release `json:"items"`
 }
@@ -0,0 +1,148 @@
+package provision
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+
+	"gopkg.in/yaml.v2"
+)
+
+/* add-on catalog (src/scripts/addons/catalog.yaml) */
+type AddonCatalog struct {
+	Addons []AddonCatalogItem `yaml:"addons"`
+}
+
+type AddonCatalogItem struct {
+	Name        string                `yaml:"name"`
+	Description string                `yaml:"description"`
+	Versions    []AddonCatalogVersion `yaml:"versions"`
+}
+
+type AddonCatalogVersion struct {
+	Version    string       `yaml:"version"`
+	Kubernetes []string     `yaml:"kubernetes"`
+	Manifests  []string     `yaml:"manifests"`
+	Patches    []AddonPatch `yaml:"patches"`
+}
+
+type AddonPatch struct {
+	Resource  string `yaml:"resource"`
+	Namespace string `yaml:"namespace"`
+	Patch     string `yaml:"patch"`
+}
+
+/* load the add-on catalog */
+func GetAddonCatalog() (*AddonCatalog, error) {
+
+	path := fmt.Sprintf("%s/src/scripts/%s", *app.Config.AppRootPath, ADDON_CATALOG_FILE)
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to read a add-on catalog. (path=%s, cause='%v')", path, err))
+	}
+	catalog := &AddonCatalog{}
+	if err := yaml.Unmarshal(data, catalog); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to parse a add-on catalog. (path=%s, cause='%v')", path, err))
+	}
+	return catalog, nil
+}
+
+/* find an add-on version compatible with a kubernetes version (if version is empty, a default version) */
+func (self *AddonCatalog) Find(name string, version string, k8sVersion string) (*AddonCatalogVersion, error) {
+
+	for _, addon := range self.Addons {
+		if addon.Name != name {
+			continue
+		}
+		for i, v := range addon.Versions {
+			if version != "" && v.Version != version {
+				continue
+			}
+			if !v.supports(k8sVersion) {
+				if version != "" {
+					return nil, errors.New(fmt.Sprintf("Add-on '%s' version '%s' does not support kubernetes '%s'", name, version, k8sVersion))
+				}
+				continue
+			}
+			return &addon.Versions[i], nil
+		}
+		if version != "" {
+			return nil, errors.New(fmt.Sprintf("Add-on '%s' version '%s' is not supported", name, version))
+		}
+		return nil, errors.New(fmt.Sprintf("Add-on '%s' has no version for kubernetes '%s'", name, k8sVersion))
+	}
+	return nil, errors.New(fmt.Sprintf("Add-on '%s' is not supported", name))
+}
+
+/* whether a kubernetes version (e.g. 1.18.1-00) is compatible or not */
+func (self *AddonCatalogVersion) supports(k8sVersion string) bool {
+
+	if len(self.Kubernetes) == 0 {
+		return true
+	}
+	for _, v := range self.Kubernetes {
+		if k8sVersion == v || strings.HasPrefix(k8sVersion, v+".") {
+			return true
+		}
+	}
+	return false
+}
+
+/* install an add-on (copy local manifests to the control-plane leader & apply manifests, patches) */
+func (self *Provisioner) InstallAddon(ctx context.Context, addon *AddonCatalogVersion) error {
+
+	for _, m := range addon.Manifests {
+		manifest, err := self.copyManifest(ctx, m)
+		if err != nil {
+			return err
+		}
+		if _, err := self.Kubectl(ctx, "apply -f %s", manifest); err != nil {
+			return err
+		}
+	}
+	for _, patch := range addon.Patches {
+		if _, err := self.Kubectl(ctx, "patch %s -n %s --type=json -p '%s'", patch.Resource, patch.Namespace, patch.Patch); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/* uninstall an add-on (delete manifests in reverse order) */
+func (self *Provisioner) UninstallAddon(ctx context.Context, addon *AddonCatalogVersion) error {
+
+	for i := len(addon.Manifests) - 1; i >= 0; i-- {
+		manifest, err := self.copyManifest(ctx, addon.Manifests[i])
+		if err != nil {
+			return err
+		}
+		if _, err := self.Kubectl(ctx, "delete -f %s --ignore-not-found", manifest); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/* copy a local manifest to the control-plane leader and returns a path to apply (a remote path or URL) */
+func (self *Provisioner) copyManifest(ctx context.Context, manifest string) (string, error) {
+
+	if isRemoteManifest(manifest) {
+		return manifest, nil
+	}
+	src := fmt.Sprintf("%s/src/scripts/%s", *app.Config.AppRootPath, manifest)
+	dest := fmt.Sprintf("%s/%s", REMOTE_TARGET_PATH, manifest)
+	if _, err := self.leader.executeSSH(ctx, "mkdir -p %s", filepath.Dir(dest)); err != nil {
+		return "", err
+	}
+	if err := self.leader.executeSCP(ctx, src, dest); err != nil {
+		return "", err
+	}
+	return dest, nil
+}
@@ -0,0 +1,70 @@
+package provision
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"text/template"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+)
+
+const (
+	AUDIT_POLICY_FILE = "audit-policy.yaml"
+	AUDIT_POLICY_PATH = "/etc/kubernetes/mcks/audit-policy.yaml"
+	AUDIT_LOG_DIR     = "/var/log/kubernetes/audit"
+)
+
+/* audit levels of a policy (src/scripts/audit-policy.yaml) */
+var auditLevels = map[app.AuditLevel]string{
+	"":                               "Metadata",
+	app.AUDIT_LEVEL_METADATA:         "Metadata",
+	app.AUDIT_LEVEL_REQUEST:          "Request",
+	app.AUDIT_LEVEL_REQUEST_RESPONSE: "RequestResponse",
+}
+
+/* render an audit policy (a predefined level or a custom policy) */
+func renderAuditPolicy(audit app.ClusterConfigAuditReq) (string, error) {
+
+	if audit.Level == app.AUDIT_LEVEL_CUSTOM {
+		return audit.Policy, nil
+	}
+
+	path := fmt.Sprintf("%s/src/scripts/%s", *app.Config.AppRootPath, AUDIT_POLICY_FILE)
+	tpl, err := template.New(filepath.Base(path)).ParseFiles(path)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Failed to parse an audit-policy template. (path=%s, cause='%v')", path, err))
+	}
+	var out bytes.Buffer
+	if err := tpl.Execute(&out, map[string]string{"Level": auditLevels[audit.Level]}); err != nil {
+		return "", errors.New(fmt.Sprintf("Failed to render an audit-policy. (cause='%v')", err))
+	}
+	return out.String(), nil
+}
+
+/* audit flags of an api-server */
+func auditExtraArgs(audit *app.ClusterConfigAuditReq) map[string]string {
+
+	args := map[string]string{}
+	if audit == nil {
+		return args
+	}
+	maxAge, maxBackup, maxSize := app.AUDIT_LOG_MAXAGE, app.AUDIT_LOG_MAXBACKUP, app.AUDIT_LOG_MAXSIZE
+	if audit.MaxAge > 0 {
+		maxAge = audit.MaxAge
+	}
+	if audit.MaxBackup > 0 {
+		maxBackup = audit.MaxBackup
+	}
+	if audit.MaxSize > 0 {
+		maxSize = audit.MaxSize
+	}
+	args["audit-policy-file"] = AUDIT_POLICY_PATH
+	args["audit-log-path"] = AUDIT_LOG_DIR + "/audit.log"
+	args["audit-log-maxage"] = strconv.Itoa(maxAge)
+	args["audit-log-maxbackup"] = strconv.Itoa(maxBackup)
+	args["audit-log-maxsize"] = strconv.Itoa(maxSize)
+	return args
+}
@@ -0,0 +1,266 @@
+package provision
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"github.com/cloud-barista/cb-mcks/src/core/tumblebug"
+	"github.com/cloud-barista/cb-mcks/src/utils/lang"
+)
+
+/* network-cni plugin */
+type NetworkCni interface {
+	// name of a network-cni (app.NETWORKCNI_*)
+	Name() app.NetworkCni
+	// manifests to apply, a file path relative to "src/scripts" (copied to control-plane nodes) or a URL
+	Manifests() []string
+	// annotations to assign to a node
+	NodeAnnotations(machine *Machine) map[string]string
+	// an annotation which mcks-bootstrap assigns on every boot ("${PUBLIC_IP}" is replaced with a public-ip of a node)
+	PublicIPAnnotation() string
+	// firewall rules (inbound) which the network-cni requires between nodes
+	FirewallRules() []tumblebug.FirewallRules
+	// hook executed on each machine after bootstrap.sh
+	Bootstrap(ctx context.Context, machine *Machine) error
+	// hook executed on the control-plane leader after manifests are applied
+	PostInstall(ctx context.Context, provisioner *Provisioner) error
+}
+
+var networkCnis = map[app.NetworkCni]func(cluster *model.Cluster) NetworkCni{
+	app.NETWORKCNI_CANAL:            func(cluster *model.Cluster) NetworkCni { return &canal{} },
+	app.NETWORKCNI_KILO:             func(cluster *model.Cluster) NetworkCni { return newKilo(cluster.Kilo) },
+	app.NETWORKCNI_CALICO:           func(cluster *model.Cluster) NetworkCni { return &calico{} },
+	app.NETWORKCNI_CALICO_WIREGUARD: func(cluster *model.Cluster) NetworkCni { return &calico{wireguard: true} },
+	app.NETWORKCNI_CILIUM:           func(cluster *model.Cluster) NetworkCni { return &cilium{} },
+	app.NETWORKCNI_FLANNEL:          func(cluster *model.Cluster) NetworkCni { return &flannel{} },
+}
+
+/* get a network-cni plugin of a cluster */
+func GetNetworkCni(cluster *model.Cluster) (NetworkCni, error) {
+	if newCni, exists := networkCnis[cluster.NetworkCni]; exists {
+		return newCni(cluster), nil
+	}
+	return nil, errors.New(fmt.Sprintf("Network-cni '%s' is not supported", cluster.NetworkCni))
+}
+
+/* whether a manifest is a remote URL or not (a local file) */
+func isRemoteManifest(manifest string) bool {
+	return strings.HasPrefix(manifest, "http://") || strings.HasPrefix(manifest, "https://")
+}
+
+/* install a wireguard (kernel module & tools) */
+func installWireguard(ctx context.Context, machine *Machine) error {
+	if _, err := machine.executeSSH(ctx, "sudo add-apt-repository -y ppa:wireguard/wireguard && sudo apt-get update && sudo apt-get install -y wireguard"); err != nil {
+		return errors.New(fmt.Sprintf("Failed to install wireguard. (node=%s)", machine.Name))
+	}
+	return nil
+}
+
+func inboundRule(protocol string, port int) tumblebug.FirewallRules {
+	return tumblebug.FirewallRules{Protocol: protocol, Direction: "inbound", From: strconv.Itoa(port), To: strconv.Itoa(port)}
+}
+
+/* canal (calico + flannel) */
+type canal struct{}
+
+func (self *canal) Name() app.NetworkCni { return app.NETWORKCNI_CANAL }
+
+func (self *canal) Manifests() []string { return []string{CNI_CANAL_FILE} }
+
+func (self *canal) NodeAnnotations(machine *Machine) map[string]string { return nil }
+
+// canal annotations are assigned by bootstrap.sh (calico & flannel, restarts a docker daemon)
+func (self *canal) PublicIPAnnotation() string { return "" }
+
+// flannel vxlan, calico typha
+func (self *canal) FirewallRules() []tumblebug.FirewallRules {
+	return []tumblebug.FirewallRules{inboundRule("udp", 8472), inboundRule("tcp", 5473)}
+}
+
+func (self *canal) Bootstrap(ctx context.Context, machine *Machine) error { return nil }
+
+func (self *canal) PostInstall(ctx context.Context, provisioner *Provisioner) error { return nil }
+
+/* kilo (wireguard mesh + flannel) */
+type kilo struct {
+	config              app.ClusterConfigKiloReq
+	persistentKeepalive int // 0 : off
+	port                int
+}
+
+/* new a kilo with defaults (full mesh, keepalive 25, port 51820) */
+func newKilo(config *app.ClusterConfigKiloReq) *kilo {
+	self := &kilo{persistentKeepalive: app.KILO_PERSISTENT_KEEPALIVE, port: app.KILO_PORT}
+	if config != nil {
+		self.config = *config
+	}
+	self.config.Topology = app.KiloTopology(lang.NVL(string(self.config.Topology), string(app.KILO_TOPOLOGY_FULL_MESH)))
+	if self.config.PersistentKeepalive != nil {
+		self.persistentKeepalive = *self.config.PersistentKeepalive
+	}
+	if self.config.Port != nil {
+		self.port = *self.config.Port
+	}
+	return self
+}
+
+func (self *kilo) Name() app.NetworkCni { return app.NETWORKCNI_KILO }
+
+func (self *kilo) Manifests() []string {
+	return []string{CNI_KILO_FLANNEL_FILE, CNI_KILO_CRDS_FILE, CNI_KILO_KUBEADM_FILE}
+}
+
+func (self *kilo) NodeAnnotations(machine *Machine) map[string]string {
+	location := self.location(machine)
+	annotations := map[string]string{"kilo.squat.ai/location": location}
+	if self.persistentKeepalive > 0 {
+		annotations["kilo.squat.ai/persistent-keepalive"] = strconv.Itoa(self.persistentKeepalive)
+	}
+	if ips := self.config.AllowedLocationIPs[location]; ips != "" {
+		annotations["kilo.squat.ai/allowed-location-ips"] = strings.ReplaceAll(ips, " ", "")
+	}
+	return annotations
+}
+
+/* a location of a machine (nodes in a same location elect a leader and only leaders are connected by wireguard) */
+func (self *kilo) location(machine *Machine) string {
+	switch self.config.Topology {
+	case app.KILO_TOPOLOGY_REGION:
+		return fmt.Sprintf("%s-%s", machine.CSP, machine.Region)
+	case app.KILO_TOPOLOGY_CSP:
+		return string(machine.CSP)
+	case app.KILO_TOPOLOGY_CUSTOM:
+		for _, key := range []string{machine.Name, machine.Region, string(machine.CSP)} {
+			if location, exists := self.config.Locations[key]; exists {
+				return location
+			}
+		}
+	}
+	// full mesh (a location per node)
+	return machine.Name
+}
+
+func (self *kilo) PublicIPAnnotation() string {
+	return fmt.Sprintf("kilo.squat.ai/force-endpoint=${PUBLIC_IP}:%d", self.port)
+}
+
+// wireguard (between locations), flannel vxlan (in a location)
+func (self *kilo) FirewallRules() []tumblebug.FirewallRules {
+	return []tumblebug.FirewallRules{inboundRule("udp", self.port), inboundRule("udp", 8472)}
+}
+
+func (self *kilo) Bootstrap(ctx context.Context, machine *Machine) error {
+	return installWireguard(ctx, machine)
+}
+
+func (self *kilo) PostInstall(ctx context.Context, provisioner *Provisioner) error {
+	if self.port == app.KILO_PORT {
+		return nil
+	}
+	// wireguard port
+	_, err := provisioner.Kubectl(ctx, `patch daemonset/kilo -n kube-system --type=json -p '[{"op":"add","path":"/spec/template/spec/containers/0/args/-","value":"--port=%d"}]'`, self.port)
+	return err
+}
+
+/* calico (vxlan encapsulation, optional wireguard encryption) */
+type calico struct {
+	wireguard bool
+}
+
+func (self *calico) Name() app.NetworkCni {
+	if self.wireguard {
+		return app.NETWORKCNI_CALICO_WIREGUARD
+	}
+	return app.NETWORKCNI_CALICO
+}
+
+func (self *calico) Manifests() []string { return []string{CNI_CALICO_VXLAN_URL} }
+
+func (self *calico) NodeAnnotations(machine *Machine) map[string]string { return nil }
+
+func (self *calico) PublicIPAnnotation() string { return "projectcalico.org/IPv4Address=${PUBLIC_IP}" }
+
+// vxlan, typha (+ wireguard ipv4 & ipv6)
+func (self *calico) FirewallRules() []tumblebug.FirewallRules {
+	rules := []tumblebug.FirewallRules{inboundRule("udp", 4789), inboundRule("tcp", 5473)}
+	if self.wireguard {
+		rules = append(rules, inboundRule("udp", 51820), inboundRule("udp", 51821))
+	}
+	return rules
+}
+
+func (self *calico) Bootstrap(ctx context.Context, machine *Machine) error {
+	if self.wireguard {
+		return installWireguard(ctx, machine)
+	}
+	return nil
+}
+
+func (self *calico) PostInstall(ctx context.Context, provisioner *Provisioner) error {
+	if !self.wireguard {
+		return nil
+	}
+	// enable wireguard encryption (felix-configuration)
+	src := fmt.Sprintf("%s/src/scripts/%s", *app.Config.AppRootPath, CNI_CALICO_WIREGUARD_FILE)
+	dest := fmt.Sprintf("%s/%s", REMOTE_TARGET_PATH, CNI_CALICO_WIREGUARD_FILE)
+	if _, err := provisioner.leader.executeSSH(ctx, "mkdir -p %s/addons/calico", REMOTE_TARGET_PATH); err != nil {
+		return err
+	}
+	if err := provisioner.leader.executeSCP(ctx, src, dest); err != nil {
+		return err
+	}
+	if _, err := provisioner.Kubectl(ctx, "wait --for condition=established --timeout=120s crd/felixconfigurations.crd.projectcalico.org"); err != nil {
+		return err
+	}
+	if _, err := provisioner.Kubectl(ctx, "apply -f %s", dest); err != nil {
+		return err
+	}
+	return nil
+}
+
+/* cilium (vxlan tunneling) */
+type cilium struct{}
+
+func (self *cilium) Name() app.NetworkCni { return app.NETWORKCNI_CILIUM }
+
+func (self *cilium) Manifests() []string { return []string{CNI_CILIUM_URL} }
+
+func (self *cilium) NodeAnnotations(machine *Machine) map[string]string { return nil }
+
+func (self *cilium) PublicIPAnnotation() string { return "" }
+
+// vxlan, health checks
+func (self *cilium) FirewallRules() []tumblebug.FirewallRules {
+	return []tumblebug.FirewallRules{inboundRule("udp", 8472), inboundRule("tcp", 4240)}
+}
+
+func (self *cilium) Bootstrap(ctx context.Context, machine *Machine) error { return nil }
+
+func (self *cilium) PostInstall(ctx context.Context, provisioner *Provisioner) error { return nil }
+
+/* flannel (vxlan backend) */
+type flannel struct{}
+
+func (self *flannel) Name() app.NetworkCni { return app.NETWORKCNI_FLANNEL }
+
+func (self *flannel) Manifests() []string { return []string{CNI_FLANNEL_FILE} }
+
+func (self *flannel) NodeAnnotations(machine *Machine) map[string]string { return nil }
+
+func (self *flannel) PublicIPAnnotation() string {
+	return "flannel.alpha.coreos.com/public-ip-overwrite=${PUBLIC_IP}"
+}
+
+// vxlan
+func (self *flannel) FirewallRules() []tumblebug.FirewallRules {
+	return []tumblebug.FirewallRules{inboundRule("udp", 8472)}
+}
+
+func (self *flannel) Bootstrap(ctx context.Context, machine *Machine) error { return nil }
+
+func (self *flannel) PostInstall(ctx context.Context, provisioner *Provisioner) error { return nil }
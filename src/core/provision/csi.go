@@ -0,0 +1,344 @@
+package provision
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/tumblebug"
+
+	"gopkg.in/yaml.v2"
+)
+
+/* cloud-specific csi driver (a driver per csp, restricted to nodes of the csp) */
+// a cloud-controller-manager is not deployed : a ccm assumes that all nodes of a cluster are in its cloud,
+// so its node-lifecycle controller would delete nodes of other csps and ccms of csps would race on a same LoadBalancer service
+type CsiDriver interface {
+	// csp of a csi driver
+	CSP() app.CSP
+	// name of a csi driver (a provisioner of storage-class)
+	Name() string
+	// kubernetes objects (secrets) which contain cloud credentials
+	Secrets(cloud *CsiCloud) ([]interface{}, error)
+	// install a csi driver on the control-plane leader
+	Install(ctx context.Context, provisioner *Provisioner) error
+	// workloads to restrict to nodes of a csp
+	Workloads() []CsiWorkload
+	// parameters of a storage-class
+	Parameters() map[string]string
+}
+
+/* credential & region key-values of a connection (cb-spider) */
+type CsiCloud struct {
+	Credential map[string]string
+	Region     map[string]string
+}
+
+/* a workload (e.g. daemonset/ebs-csi-node) of a csi driver */
+type CsiWorkload struct {
+	Namespace string
+	Resource  string
+}
+
+var csiDrivers = map[app.CSP]CsiDriver{
+	app.CSP_AWS:       &awsEbs{},
+	app.CSP_GCP:       &gcpPd{},
+	app.CSP_AZURE:     &azureDisk{},
+	app.CSP_OPENSTACK: &openstackCinder{},
+}
+
+/* get a csi driver of a csp */
+func GetCsiDriver(csp app.CSP) (CsiDriver, error) {
+	if driver, exists := csiDrivers[csp]; exists {
+		return driver, nil
+	}
+	return nil, errors.New(fmt.Sprintf("CSI driver of the CSP '%s' is not supported", csp))
+}
+
+/* new a csi-cloud from key-values of a credential & a region */
+func NewCsiCloud(credential []tumblebug.KeyValue, region []tumblebug.KeyValue) *CsiCloud {
+	cloud := &CsiCloud{Credential: map[string]string{}, Region: map[string]string{}}
+	for _, kv := range credential {
+		cloud.Credential[kv.Key] = kv.Value
+	}
+	for _, kv := range region {
+		cloud.Region[kv.Key] = kv.Value
+	}
+	return cloud
+}
+
+/* name of a storage-class for a csp */
+func CsiStorageClassName(csp app.CSP) string {
+	return fmt.Sprintf("csi-%s", csp)
+}
+
+/* install a csi driver (secrets, driver, node affinity of workloads, storage-class) */
+func (self *Provisioner) InstallCsiDriver(ctx context.Context, csp app.CSP, cloud *CsiCloud) error {
+
+	driver, err := GetCsiDriver(csp)
+	if err != nil {
+		return err
+	}
+
+	// secrets
+	secrets, err := driver.Secrets(cloud)
+	if err != nil {
+		return err
+	}
+	if err := self.applyObjects(ctx, secrets...); err != nil {
+		return err
+	}
+
+	// driver
+	if err := driver.Install(ctx, self); err != nil {
+		return err
+	}
+
+	// restrict workloads to nodes of the csp
+	affinity, _ := json.Marshal(csiNodeAffinity(csp))
+	for _, w := range driver.Workloads() {
+		if _, err := self.Kubectl(ctx, "patch %s -n %s --type=merge -p '%s'", w.Resource, w.Namespace, string(affinity)); err != nil {
+			return err
+		}
+	}
+
+	// storage-class
+	return self.applyObjects(ctx, csiStorageClass(csp, driver))
+}
+
+/* apply kubernetes objects */
+func (self *Provisioner) applyObjects(ctx context.Context, objects ...interface{}) error {
+
+	docs := []string{}
+	for _, obj := range objects {
+		b, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, string(b))
+	}
+	results, err := self.ApplyManifest(ctx, strings.Join(docs, "---\n"), "", true)
+	if err != nil {
+		return err
+	}
+	for _, r := range results {
+		if r.Action == "failed" {
+			return errors.New(fmt.Sprintf("Failed to apply objects. (cause='%s')", r.Message))
+		}
+	}
+	return nil
+}
+
+/* upgrade or install a helm chart */
+func (self *Provisioner) installChart(ctx context.Context, name string, chart string, repo string, version string, namespace string, values ...string) error {
+
+	if err := self.InstallHelm(ctx); err != nil {
+		return err
+	}
+	command := fmt.Sprintf("upgrade %s %s --install --namespace %s --repo %s --version %s", name, chart, namespace, repo, version)
+	for _, v := range values {
+		command += fmt.Sprintf(" --set %s", v)
+	}
+	_, err := self.Helm(ctx, command)
+	return err
+}
+
+/* a merge-patch of a node affinity (topology.cloud-barista.github.io/csp) */
+func csiNodeAffinity(csp app.CSP) map[string]interface{} {
+	return map[string]interface{}{
+		"spec": map[string]interface{}{
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"affinity": map[string]interface{}{
+						"nodeAffinity": map[string]interface{}{
+							"requiredDuringSchedulingIgnoredDuringExecution": map[string]interface{}{
+								"nodeSelectorTerms": []interface{}{
+									map[string]interface{}{
+										"matchExpressions": []interface{}{
+											map[string]interface{}{"key": app.LABEL_KEY_CSP, "operator": "In", "values": []string{string(csp)}},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+/* a storage-class of a csi driver (volumes are allowed on nodes of the csp only) */
+func csiStorageClass(csp app.CSP, driver CsiDriver) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "storage.k8s.io/v1",
+		"kind":       "StorageClass",
+		"metadata": map[string]interface{}{
+			"name":   CsiStorageClassName(csp),
+			"labels": map[string]string{"app.kubernetes.io/managed-by": MANIFEST_FIELD_MANAGER},
+		},
+		"provisioner":          driver.Name(),
+		"parameters":           driver.Parameters(),
+		"volumeBindingMode":    "WaitForFirstConsumer",
+		"allowVolumeExpansion": true,
+		"allowedTopologies": []interface{}{
+			map[string]interface{}{
+				"matchLabelExpressions": []interface{}{
+					map[string]interface{}{"key": app.LABEL_KEY_CSP, "values": []string{string(csp)}},
+				},
+			},
+		},
+	}
+}
+
+/* a secret (string-data) */
+func csiSecret(namespace string, name string, data map[string]string) map[string]interface{} {
+	return map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   map[string]string{"name": name, "namespace": namespace},
+		"type":       "Opaque",
+		"stringData": data,
+	}
+}
+
+/* verify required keys of a credential */
+func verifyCredential(csp app.CSP, credential map[string]string, keys ...string) error {
+	for _, key := range keys {
+		if credential[key] == "" {
+			return errors.New(fmt.Sprintf("Credential key '%s' is empty. (csp=%s)", key, csp))
+		}
+	}
+	return nil
+}
+
+/* aws ebs csi driver */
+type awsEbs struct{}
+
+func (self *awsEbs) CSP() app.CSP { return app.CSP_AWS }
+
+func (self *awsEbs) Name() string { return "ebs.csi.aws.com" }
+
+func (self *awsEbs) Secrets(cloud *CsiCloud) ([]interface{}, error) {
+	if err := verifyCredential(self.CSP(), cloud.Credential, "ClientId", "ClientSecret"); err != nil {
+		return nil, err
+	}
+	return []interface{}{
+		csiSecret("kube-system", "aws-secret", map[string]string{"key_id": cloud.Credential["ClientId"], "access_key": cloud.Credential["ClientSecret"]}),
+	}, nil
+}
+
+func (self *awsEbs) Install(ctx context.Context, provisioner *Provisioner) error {
+	return provisioner.installChart(ctx, "aws-ebs-csi-driver", "aws-ebs-csi-driver", CSI_AWS_EBS_REPO, CSI_AWS_EBS_VERSION, "kube-system")
+}
+
+func (self *awsEbs) Workloads() []CsiWorkload {
+	return []CsiWorkload{{"kube-system", "daemonset/ebs-csi-node"}, {"kube-system", "deployment/ebs-csi-controller"}}
+}
+
+func (self *awsEbs) Parameters() map[string]string { return map[string]string{"type": "gp2"} }
+
+/* gcp persistent disk csi driver */
+type gcpPd struct{}
+
+func (self *gcpPd) CSP() app.CSP { return app.CSP_GCP }
+
+func (self *gcpPd) Name() string { return "pd.csi.storage.gke.io" }
+
+func (self *gcpPd) Secrets(cloud *CsiCloud) ([]interface{}, error) {
+	if err := verifyCredential(self.CSP(), cloud.Credential, "ProjectID", "ClientEmail", "PrivateKey"); err != nil {
+		return nil, err
+	}
+	sa, _ := json.Marshal(map[string]string{
+		"type":         "service_account",
+		"project_id":   cloud.Credential["ProjectID"],
+		"client_email": cloud.Credential["ClientEmail"],
+		"private_key":  cloud.Credential["PrivateKey"],
+		"token_uri":    "https://oauth2.googleapis.com/token",
+	})
+	return []interface{}{
+		map[string]interface{}{"apiVersion": "v1", "kind": "Namespace", "metadata": map[string]string{"name": "gce-pd-csi-driver"}},
+		csiSecret("gce-pd-csi-driver", "cloud-sa", map[string]string{"cloud-sa.json": string(sa)}),
+	}, nil
+}
+
+func (self *gcpPd) Install(ctx context.Context, provisioner *Provisioner) error {
+	_, err := provisioner.Kubectl(ctx, "apply -k '%s'", CSI_GCP_PD_KUSTOMIZE_URL)
+	return err
+}
+
+func (self *gcpPd) Workloads() []CsiWorkload {
+	return []CsiWorkload{{"gce-pd-csi-driver", "daemonset/csi-gce-pd-node"}, {"gce-pd-csi-driver", "deployment/csi-gce-pd-controller"}}
+}
+
+func (self *gcpPd) Parameters() map[string]string { return map[string]string{"type": "pd-standard"} }
+
+/* azure disk csi driver */
+type azureDisk struct{}
+
+func (self *azureDisk) CSP() app.CSP { return app.CSP_AZURE }
+
+func (self *azureDisk) Name() string { return "disk.csi.azure.com" }
+
+func (self *azureDisk) Secrets(cloud *CsiCloud) ([]interface{}, error) {
+	if err := verifyCredential(self.CSP(), cloud.Credential, "ClientId", "ClientSecret", "TenantId", "SubscriptionId"); err != nil {
+		return nil, err
+	}
+	config, _ := json.Marshal(map[string]string{
+		"cloud":           "AzurePublicCloud",
+		"tenantId":        cloud.Credential["TenantId"],
+		"subscriptionId":  cloud.Credential["SubscriptionId"],
+		"aadClientId":     cloud.Credential["ClientId"],
+		"aadClientSecret": cloud.Credential["ClientSecret"],
+		"resourceGroup":   cloud.Region["ResourceGroup"],
+		"location":        cloud.Region["Region"],
+	})
+	return []interface{}{
+		csiSecret("kube-system", "azure-cloud-provider", map[string]string{"cloud-config": string(config)}),
+	}, nil
+}
+
+func (self *azureDisk) Install(ctx context.Context, provisioner *Provisioner) error {
+	return provisioner.installChart(ctx, "azuredisk-csi-driver", "azuredisk-csi-driver", CSI_AZURE_DISK_REPO, CSI_AZURE_DISK_VERSION, "kube-system")
+}
+
+func (self *azureDisk) Workloads() []CsiWorkload {
+	return []CsiWorkload{{"kube-system", "daemonset/csi-azuredisk-node"}, {"kube-system", "deployment/csi-azuredisk-controller"}}
+}
+
+func (self *azureDisk) Parameters() map[string]string {
+	return map[string]string{"skuName": "StandardSSD_LRS"}
+}
+
+/* openstack cinder csi driver */
+type openstackCinder struct{}
+
+func (self *openstackCinder) CSP() app.CSP { return app.CSP_OPENSTACK }
+
+func (self *openstackCinder) Name() string { return "cinder.csi.openstack.org" }
+
+func (self *openstackCinder) Secrets(cloud *CsiCloud) ([]interface{}, error) {
+	if err := verifyCredential(self.CSP(), cloud.Credential, "IdentityEndpoint", "Username", "Password", "DomainName", "ProjectID"); err != nil {
+		return nil, err
+	}
+	config := fmt.Sprintf("[Global]\nauth-url=%q\nusername=%q\npassword=%q\ndomain-name=%q\ntenant-id=%q\nregion=%q\n",
+		cloud.Credential["IdentityEndpoint"], cloud.Credential["Username"], cloud.Credential["Password"],
+		cloud.Credential["DomainName"], cloud.Credential["ProjectID"], cloud.Region["Region"])
+	return []interface{}{
+		csiSecret("kube-system", "cloud-config", map[string]string{"cloud.conf": config}),
+	}, nil
+}
+
+func (self *openstackCinder) Install(ctx context.Context, provisioner *Provisioner) error {
+	return provisioner.installChart(ctx, "openstack-cinder-csi", "openstack-cinder-csi", CSI_OPENSTACK_CINDER_REPO, CSI_OPENSTACK_CINDER_VERSION, "kube-system",
+		"secret.enabled=true", "secret.create=false", "secret.name=cloud-config", "storageClass.enabled=false")
+}
+
+func (self *openstackCinder) Workloads() []CsiWorkload {
+	return []CsiWorkload{{"kube-system", "daemonset/openstack-cinder-csi-nodeplugin"}, {"kube-system", "deployment/openstack-cinder-csi-controllerplugin"}}
+}
+
+func (self *openstackCinder) Parameters() map[string]string { return map[string]string{} }
@@ -0,0 +1,187 @@
+package provision
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+
+	logger "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+const ENCRYPTION_CONFIG_PATH = "/etc/kubernetes/mcks/encryption-config.yaml"
+
+/* EncryptionConfiguration (apiserver.config.k8s.io/v1) */
+type encryptionConfig struct {
+	ApiVersion string               `yaml:"apiVersion"`
+	Kind       string               `yaml:"kind"`
+	Resources  []encryptionResource `yaml:"resources"`
+}
+type encryptionResource struct {
+	Resources []string             `yaml:"resources"`
+	Providers []encryptionProvider `yaml:"providers"`
+}
+type encryptionProvider struct {
+	Aescbc   *encryptionKeys `yaml:"aescbc,omitempty"`
+	Aesgcm   *encryptionKeys `yaml:"aesgcm,omitempty"`
+	Identity *struct{}       `yaml:"identity,omitempty"`
+}
+type encryptionKeys struct {
+	Keys []encryptionKey `yaml:"keys"`
+}
+type encryptionKey struct {
+	Name   string `yaml:"name"`
+	Secret string `yaml:"secret"`
+}
+
+/* keys of an active (first) provider */
+func (self *encryptionResource) keys() *encryptionKeys {
+	if len(self.Providers) == 0 {
+		return nil
+	} else if self.Providers[0].Aescbc != nil {
+		return self.Providers[0].Aescbc
+	}
+	return self.Providers[0].Aesgcm
+}
+
+/* a new EncryptionConfiguration with an MCKS-generated AES key (an identity provider to read unencrypted data) */
+func newEncryptionConfig(encryption app.ClusterConfigEncryptionReq) (*encryptionConfig, error) {
+
+	key, err := newEncryptionKey()
+	if err != nil {
+		return nil, err
+	}
+	provider := encryptionProvider{}
+	keys := &encryptionKeys{Keys: []encryptionKey{key}}
+	if encryption.Provider == app.ENCRYPTION_PROVIDER_AESGCM {
+		provider.Aesgcm = keys
+	} else {
+		provider.Aescbc = keys
+	}
+	resources := encryption.Resources
+	if len(resources) == 0 {
+		resources = []string{"secrets"}
+	}
+
+	return &encryptionConfig{
+		ApiVersion: "apiserver.config.k8s.io/v1",
+		Kind:       "EncryptionConfiguration",
+		Resources:  []encryptionResource{{Resources: resources, Providers: []encryptionProvider{provider, {Identity: &struct{}{}}}}},
+	}, nil
+}
+
+/* a new 32-byte AES key */
+func newEncryptionKey() (encryptionKey, error) {
+
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return encryptionKey{}, errors.New(fmt.Sprintf("Failed to generate an encryption key. (cause='%v')", err))
+	}
+	return encryptionKey{Name: fmt.Sprintf("key-%d", time.Now().Unix()), Secret: base64.StdEncoding.EncodeToString(b)}, nil
+}
+
+/* encryption flags of an api-server */
+func encryptionExtraArgs(encryption *app.ClusterConfigEncryptionReq) map[string]string {
+
+	args := map[string]string{}
+	if encryption != nil {
+		args["encryption-provider-config"] = ENCRYPTION_CONFIG_PATH
+	}
+	return args
+}
+
+/* copy an EncryptionConfiguration to all control-plane nodes */
+func (self *Provisioner) copyEncryptionConfig(ctx context.Context, config *encryptionConfig) error {
+
+	b, err := yaml.Marshal(config)
+	if err != nil {
+		return err
+	}
+	for _, machine := range self.ControlPlaneMachines {
+		if err := machine.copyRootFile(ctx, string(b), ENCRYPTION_CONFIG_PATH, "600"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/* copy an EncryptionConfiguration & restart api-servers one by one */
+func (self *Provisioner) applyEncryptionConfig(ctx context.Context, config *encryptionConfig) error {
+
+	if err := self.copyEncryptionConfig(ctx, config); err != nil {
+		return err
+	}
+	for _, machine := range self.ControlPlaneMachines {
+		machine.executeSSH(ctx, "sudo pkill -x kube-apiserver; true")
+		if err := machine.waitForApiServer(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/* rotate an encryption key (add a new key, promote it to a primary key, rewrite resources & remove old keys) */
+func (self *Provisioner) RotateEncryptionKey(ctx context.Context) error {
+
+	output, err := self.leader.executeSSH(withSensitive(ctx), "sudo cat %s", ENCRYPTION_CONFIG_PATH)
+	if err != nil || strings.TrimSpace(output) == "" {
+		return errors.New(fmt.Sprintf("Failed to get an encryption-config. (cause='%v')", err))
+	}
+	config := &encryptionConfig{}
+	if err := yaml.Unmarshal([]byte(output), config); err != nil {
+		return errors.New(fmt.Sprintf("Failed to parse an encryption-config. (cause='%v')", err))
+	}
+	key, err := newEncryptionKey()
+	if err != nil {
+		return err
+	}
+
+	// 1. add a new key
+	for i := range config.Resources {
+		if keys := config.Resources[i].keys(); keys != nil {
+			keys.Keys = append(keys.Keys, key)
+		}
+	}
+	if err := self.applyEncryptionConfig(ctx, config); err != nil {
+		return err
+	}
+	logger.Infof("[%s] A new encryption key has been added. (key=%s)", self.Cluster.Name, key.Name)
+
+	// 2. promote a new key
+	for i := range config.Resources {
+		if keys := config.Resources[i].keys(); keys != nil {
+			keys.Keys = append([]encryptionKey{key}, keys.Keys[:len(keys.Keys)-1]...)
+		}
+	}
+	if err := self.applyEncryptionConfig(ctx, config); err != nil {
+		return err
+	}
+
+	// 3. rewrite resources
+	for _, resource := range config.Resources {
+		for _, name := range resource.Resources {
+			if _, err := self.leader.executeSSH(ctx, "sudo kubectl get %s --all-namespaces -o json --kubeconfig=/etc/kubernetes/admin.conf | sudo kubectl replace -f - --kubeconfig=/etc/kubernetes/admin.conf", name); err != nil {
+				return errors.New(fmt.Sprintf("Failed to rewrite resources with a new encryption key. (resource=%s, cause='%v')", name, err))
+			}
+		}
+	}
+
+	// 4. remove old keys
+	for i := range config.Resources {
+		if keys := config.Resources[i].keys(); keys != nil {
+			keys.Keys = []encryptionKey{key}
+		}
+	}
+	if err := self.applyEncryptionConfig(ctx, config); err != nil {
+		return err
+	}
+	logger.Infof("[%s] Encryption key rotation has been completed. (key=%s)", self.Cluster.Name, key.Name)
+
+	return nil
+}
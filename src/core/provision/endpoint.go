@@ -0,0 +1,175 @@
+package provision
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cloud-barista/cb-mcks/src/utils/lang"
+
+	"gopkg.in/yaml.v2"
+)
+
+/* update an api-server endpoint (regenerate api-server certificates, manifests, kubeconfigs & node-ips) and returns a new admin kubeconfig */
+func (self *Provisioner) UpdateEndpoint(ctx context.Context, endpoint string, sans []string) (string, error) {
+
+	host := lang.NVL(endpoint, self.leader.nodeIP())
+	server := fmt.Sprintf("https://%s:%d", host, CONTROL_PLANE_ENDPOINT_PORT)
+
+	kubeadmConfig, err := self.getKubeadmConfig(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	// control-plane nodes : regenerate api-server certificates & a manifest, update kubeconfigs & node-ips and restart control-plane components
+	dest := fmt.Sprintf("%s/%s", REMOTE_TARGET_PATH, KUBEADM_CONFIG_FILE)
+	for _, machine := range self.ControlPlaneMachines {
+		config, err := updateKubeadmConfig(kubeadmConfig, host, certSANs(endpoint, sans, self.leader.nodeIP()), machine.nodeIP())
+		if err != nil {
+			return "", err
+		}
+		if err := machine.copyContent(ctx, config, dest); err != nil {
+			return "", err
+		}
+		if _, err := machine.executeSSH(ctx, "sudo mkdir -p %s && sudo cp %s %s", "/etc/kubernetes/mcks", dest, KUBEADM_CONFIG_PATH); err != nil {
+			return "", err
+		}
+		if output, exitCode, err := machine.executeSSHExitCode(ctx, "sudo mv -f /etc/kubernetes/pki/apiserver.crt /etc/kubernetes/pki/apiserver.crt.old && sudo mv -f /etc/kubernetes/pki/apiserver.key /etc/kubernetes/pki/apiserver.key.old && sudo kubeadm init phase certs apiserver --config %s", dest); err != nil || exitCode != 0 {
+			return "", errors.New(fmt.Sprintf("Failed to regenerate api-server certificates. (node=%s, output='%s')", machine.Name, output))
+		}
+		if output, exitCode, err := machine.executeSSHExitCode(ctx, "sudo kubeadm init phase control-plane apiserver --config %s", dest); err != nil || exitCode != 0 {
+			return "", errors.New(fmt.Sprintf("Failed to regenerate an api-server manifest. (node=%s, output='%s')", machine.Name, output))
+		}
+		if err := machine.updateKubeconfigServer(ctx, server, "admin.conf", "controller-manager.conf", "scheduler.conf", "kubelet.conf"); err != nil {
+			return "", err
+		}
+		if err := machine.updateNodeIP(ctx); err != nil {
+			return "", err
+		}
+		machine.executeSSH(ctx, "sudo pkill -x kube-apiserver; sudo pkill -x kube-controller; sudo pkill -x kube-scheduler; true")
+	}
+
+	// worker nodes : update a kubelet kubeconfig & a node-ip
+	for _, machine := range self.WorkerNodeMachines {
+		if err := machine.updateKubeconfigServer(ctx, server, "kubelet.conf"); err != nil {
+			return "", err
+		}
+		if err := machine.updateNodeIP(ctx); err != nil {
+			return "", err
+		}
+	}
+
+	// wait for the api-server
+	if output, _ := self.leader.executeSSH(ctx, "for i in $(seq 1 60); do sudo kubectl get --raw=/healthz --kubeconfig=/etc/kubernetes/admin.conf 2>/dev/null && break; sleep 2; done"); !strings.Contains(output, "ok") {
+		return "", errors.New(fmt.Sprintf("The api-server is not ready. (server=%s)", server))
+	}
+
+	// cluster : kubeadm-config, kube-proxy & cluster-info(join) configmaps
+	if _, err := self.leader.executeSSH(ctx, "sudo kubeadm init phase upload-config kubeadm --config %s", KUBEADM_CONFIG_PATH); err != nil {
+		return "", errors.New(fmt.Sprintf("Failed to upload a kubeadm-config. (cause='%v')", err))
+	}
+	for _, cm := range []string{"-n kube-system configmap/kube-proxy", "-n kube-public configmap/cluster-info"} {
+		if _, err := self.leader.executeSSH(ctx, "sudo kubectl get %s -o yaml --kubeconfig=/etc/kubernetes/admin.conf | sed -E 's#server: https://[^/]+:%d#server: %s#' | sudo kubectl replace -f - --kubeconfig=/etc/kubernetes/admin.conf", cm, CONTROL_PLANE_ENDPOINT_PORT, server); err != nil {
+			return "", errors.New(fmt.Sprintf("Failed to update a configmap. (configmap='%s')", cm))
+		}
+	}
+	if _, err := self.Kubectl(ctx, "rollout restart daemonset/kube-proxy -n kube-system"); err != nil {
+		return "", err
+	}
+
+	return self.leader.executeSSH(withSensitive(ctx), "sudo cat /etc/kubernetes/admin.conf")
+}
+
+/* a kubeadm-config (saved by k8s-init.sh, or a "kubeadm-config" configmap for clusters created before) */
+func (self *Provisioner) getKubeadmConfig(ctx context.Context) (string, error) {
+
+	config, err := self.leader.executeSSH(withSensitive(ctx), "sudo cat %s", KUBEADM_CONFIG_PATH)
+	if err != nil || strings.TrimSpace(config) == "" {
+		if config, err = self.Kubectl(withSensitive(ctx), "get configmap kubeadm-config -n kube-system -o jsonpath='{.data.ClusterConfiguration}'"); err != nil {
+			return "", errors.New(fmt.Sprintf("Failed to get a kubeadm-config. (cause='%v')", err))
+		}
+	}
+	return config, nil
+}
+
+/* replace a server of kubeconfigs (/etc/kubernetes/*.conf) & restart a kubelet */
+func (self *Machine) updateKubeconfigServer(ctx context.Context, server string, files ...string) error {
+
+	paths := []string{}
+	for _, f := range files {
+		paths = append(paths, "/etc/kubernetes/"+f)
+	}
+	if _, err := self.executeSSH(ctx, "sudo sed -i -E 's#server: https://[^/]+:%d#server: %s#' %s && sudo systemctl restart kubelet", CONTROL_PLANE_ENDPOINT_PORT, server, strings.Join(paths, " ")); err != nil {
+		return errors.New(fmt.Sprintf("Failed to update kubeconfigs. (node=%s, cause='%v')", self.Name, err))
+	}
+	return nil
+}
+
+/* update a node-ip (/etc/default/kubelet & a fixed PUBLIC_IP of mcks-bootstrap) and re-run mcks-bootstrap (an ip alias, node annotations & restart a kubelet) */
+func (self *Machine) updateNodeIP(ctx context.Context) error {
+
+	ip := self.nodeIP()
+	if _, err := self.executeSSH(ctx, `sudo sed -i -E 's#-node-ip=[0-9.]+#-node-ip=%s#' /etc/default/kubelet && sudo sed -i -E 's#^PUBLIC_IP="[0-9.]+"$#PUBLIC_IP="%s"#' /lib/systemd/system/mcks-bootstrap`, ip, ip); err != nil {
+		return errors.New(fmt.Sprintf("Failed to update a node-ip. (node=%s, cause='%v')", self.Name, err))
+	}
+	if _, err := self.executeSSH(ctx, "sudo systemctl restart mcks-bootstrap || sudo systemctl restart kubelet"); err != nil {
+		return errors.New(fmt.Sprintf("Failed to restart a kubelet. (node=%s, cause='%v')", self.Name, err))
+	}
+	return nil
+}
+
+/* update a control-plane-endpoint, cert-SANs and an advertise-address of a kubeadm-config (ClusterConfiguration) */
+func updateKubeadmConfig(config string, host string, sans []string, advertiseAddress string) (string, error) {
+
+	return editClusterConfiguration(config, func(clusterConfig yaml.MapSlice) yaml.MapSlice {
+		apiServer, _ := getMapSliceValue(clusterConfig, "apiServer").(yaml.MapSlice)
+		extraArgs, _ := getMapSliceValue(apiServer, "extraArgs").(yaml.MapSlice)
+		extraArgs = setMapSliceValue(extraArgs, "advertise-address", advertiseAddress)
+		apiServer = setMapSliceValue(apiServer, "extraArgs", extraArgs)
+		apiServer = setMapSliceValue(apiServer, "certSANs", sans)
+		clusterConfig = setMapSliceValue(clusterConfig, "apiServer", apiServer)
+		return setMapSliceValue(clusterConfig, "controlPlaneEndpoint", fmt.Sprintf("%s:%d", host, CONTROL_PLANE_ENDPOINT_PORT))
+	})
+}
+
+/* edit a ClusterConfiguration document of a kubeadm-config */
+func editClusterConfiguration(config string, edit func(clusterConfig yaml.MapSlice) yaml.MapSlice) (string, error) {
+
+	docs := strings.Split(config, "\n---\n")
+	for i, doc := range docs {
+		clusterConfig := yaml.MapSlice{}
+		if err := yaml.Unmarshal([]byte(doc), &clusterConfig); err != nil {
+			return "", errors.New(fmt.Sprintf("Failed to parse a kubeadm-config. (cause='%v')", err))
+		}
+		if getMapSliceValue(clusterConfig, "kind") != "ClusterConfiguration" {
+			continue
+		}
+		b, err := yaml.Marshal(edit(clusterConfig))
+		if err != nil {
+			return "", err
+		}
+		docs[i] = string(b)
+		return strings.Join(docs, "\n---\n"), nil
+	}
+	return "", errors.New("Could not be found a ClusterConfiguration in a kubeadm-config")
+}
+
+func getMapSliceValue(ms yaml.MapSlice, key string) interface{} {
+	for _, item := range ms {
+		if item.Key == key {
+			return item.Value
+		}
+	}
+	return nil
+}
+
+func setMapSliceValue(ms yaml.MapSlice, key string, value interface{}) yaml.MapSlice {
+	for i, item := range ms {
+		if item.Key == key {
+			ms[i].Value = value
+			return ms
+		}
+	}
+	return append(ms, yaml.MapItem{Key: key, Value: value})
+}
@@ -0,0 +1,355 @@
+package provision
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bramvdbogaerde/go-scp"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"golang.org/x/crypto/ssh"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+/* an executor of remote commands & file copies on a machine */
+type CommandExecutor interface {
+	Run(ctx context.Context, machine *Machine, command string) (string, string, error)   // run a command and returns outputs (stdout, stderr)
+	Copy(ctx context.Context, machine *Machine, source string, destination string) error // copy a local file to a remote path
+	Dial(ctx context.Context, machine *Machine, timeout time.Duration) error             // verify a tcp connectivity of a ssh port
+}
+
+/* a default executor (machines without an executor use it) */
+var DefaultExecutor CommandExecutor = &SSHExecutor{}
+
+/* a ssh executor (host keys are verified with a pinned host key of a machine) */
+type SSHExecutor struct{}
+
+/* run a command (a remote process is killed and a connection is closed when a context is done) */
+func (self *SSHExecutor) Run(ctx context.Context, machine *Machine, command string) (string, string, error) {
+
+	client, err := self.connect(ctx, machine)
+	if err != nil {
+		return "", "", err
+	}
+	defer client.Close()
+
+	session, err := client.NewSession()
+	if err != nil {
+		return "", "", err
+	}
+	defer session.Close()
+
+	var stdout, stderr bytes.Buffer
+	session.Stdout = &stdout
+	session.Stderr = io.MultiWriter(os.Stderr, &stderr)
+
+	done := make(chan error, 1)
+	go func() {
+		done <- session.Run(command)
+	}()
+	select {
+	case err = <-done:
+	case <-ctx.Done():
+		session.Signal(ssh.SIGKILL)
+		client.Close()
+		<-done
+		err = ctx.Err()
+	}
+
+	return strings.Trim(stdout.String(), "\n"), strings.Trim(stderr.String(), "\n"), err
+}
+
+/* copy a file (a connection is closed when a context is done) */
+func (self *SSHExecutor) Copy(ctx context.Context, machine *Machine, source string, destination string) error {
+
+	client, err := self.connect(ctx, machine)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	scpClient, err := scp.NewClientBySSH(client)
+	if err != nil {
+		return err
+	}
+	defer scpClient.Close()
+
+	file, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	done := make(chan error, 1)
+	go func() {
+		done <- scpClient.CopyFile(file, destination, "0755")
+	}()
+	select {
+	case err = <-done:
+		return err
+	case <-ctx.Done():
+		client.Close()
+		<-done
+		return ctx.Err()
+	}
+}
+
+func (self *SSHExecutor) Dial(ctx context.Context, machine *Machine, timeout time.Duration) error {
+
+	var conn net.Conn
+	var err error
+	if machine.Bastion == nil {
+		conn, err = dialContext(ctx, machine.address(), timeout)
+	} else {
+		var bastion *ssh.Client
+		if bastion, err = self.connectBastion(ctx, machine, timeout); err != nil {
+			return err
+		}
+		defer bastion.Close()
+		conn, err = bastion.Dial("tcp", machine.address())
+	}
+	if err != nil {
+		return err
+	}
+	if conn != nil {
+		defer conn.Close()
+		return nil
+	}
+
+	return errors.New("Failed to validate connectivity.")
+}
+
+/* connect to a machine */
+func (self *SSHExecutor) connect(ctx context.Context, machine *Machine) (*ssh.Client, error) {
+
+	signer, err := ssh.ParsePrivateKey([]byte(machine.Credential))
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to parse a private key. (node=%s, cause='%v')", machine.Name, err))
+	}
+	config := &ssh.ClientConfig{
+		User: machine.Username,
+		Auth: []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			return machine.verifyHostKey(key)
+		},
+		Timeout: time.Second * time.Duration(machine.SSH.DialTimeout),
+	}
+	if machine.Bastion == nil {
+		conn, err := dialContext(ctx, machine.address(), config.Timeout)
+		if err != nil {
+			return nil, err
+		}
+		client, err := newClient(ctx, conn, machine.address(), config)
+		if err != nil {
+			return nil, err
+		}
+		keepalive(client, machine.SSH.Keepalive)
+		return client, nil
+	}
+
+	// tunnel through a bastion (the bastion connection is closed when the machine connection is closed)
+	bastion, err := self.connectBastion(ctx, machine, config.Timeout)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := bastion.Dial("tcp", machine.address())
+	if err != nil {
+		bastion.Close()
+		return nil, errors.New(fmt.Sprintf("Failed to dial via a bastion. (node=%s, bastion=%s, cause='%v')", machine.Name, machine.bastionAddress(), err))
+	}
+	client, err := newClient(ctx, conn, machine.address(), config)
+	if err != nil {
+		bastion.Close()
+		return nil, err
+	}
+	go func() {
+		client.Wait()
+		bastion.Close()
+	}()
+	keepalive(bastion, machine.SSH.Keepalive)
+	keepalive(client, machine.SSH.Keepalive)
+	return client, nil
+}
+
+/* dial a tcp address (bounded by a timeout and a context) */
+func dialContext(ctx context.Context, address string, timeout time.Duration) (net.Conn, error) {
+
+	dialer := net.Dialer{Timeout: timeout}
+	return dialer.DialContext(ctx, "tcp", address)
+}
+
+/* a ssh client over a connection (a handshake is bounded by a timeout and a deadline of a context) */
+func newClient(ctx context.Context, conn net.Conn, address string, config *ssh.ClientConfig) (*ssh.Client, error) {
+
+	deadline, exists := ctx.Deadline()
+	if config.Timeout > 0 && (!exists || time.Now().Add(config.Timeout).Before(deadline)) {
+		deadline, exists = time.Now().Add(config.Timeout), true
+	}
+	if exists {
+		conn.SetDeadline(deadline) // not supported by a tunneled connection (ignored)
+	}
+	c, chans, reqs, err := ssh.NewClientConn(conn, address, config)
+	if err != nil {
+		conn.Close()
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	return ssh.NewClient(c, chans, reqs), nil
+}
+
+/* send keepalive requests periodically until a connection is closed (interval : seconds, <= 0 : disabled) */
+func keepalive(client *ssh.Client, interval int) {
+
+	if interval <= 0 {
+		return
+	}
+	done := make(chan struct{})
+	go func() {
+		client.Wait()
+		close(done)
+	}()
+	go func() {
+		ticker := time.NewTicker(time.Second * time.Duration(interval))
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				if _, _, err := client.SendRequest("keepalive@openssh.com", true, nil); err != nil {
+					return
+				}
+			}
+		}
+	}()
+}
+
+/* connect to a bastion of a machine */
+func (self *SSHExecutor) connectBastion(ctx context.Context, machine *Machine, timeout time.Duration) (*ssh.Client, error) {
+
+	bastion := machine.Bastion
+	signer, err := ssh.ParsePrivateKey([]byte(bastion.PrivateKey))
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to parse a private key of a bastion. (connection=%s, cause='%v')", bastion.Connection, err))
+	}
+	config := &ssh.ClientConfig{
+		User: bastion.Username,
+		Auth: []ssh.AuthMethod{ssh.PublicKeys(signer)},
+		HostKeyCallback: func(hostname string, remote net.Addr, key ssh.PublicKey) error {
+			return verifyBastionHostKey(bastion, key)
+		},
+		Timeout: timeout,
+	}
+	conn, err := dialContext(ctx, bastion.Address(), timeout)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to connect to a bastion. (connection=%s, bastion=%s, cause='%v')", bastion.Connection, bastion.Address(), err))
+	}
+	client, err := newClient(ctx, conn, bastion.Address(), config)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to connect to a bastion. (connection=%s, bastion=%s, cause='%v')", bastion.Connection, bastion.Address(), err))
+	}
+	return client, nil
+}
+
+/* a ssh address of a machine (a private-ip via a bastion) */
+func (self *Machine) address() string {
+	port := self.SSH.Port
+	if port == 0 {
+		port = 22
+	}
+	if self.Bastion != nil {
+		return fmt.Sprintf("%s:%d", self.PrivateIP, port)
+	}
+	return fmt.Sprintf("%s:%d", self.PublicIP, port)
+}
+
+/* a ssh address of a bastion of a machine (empty : no bastion) */
+func (self *Machine) bastionAddress() string {
+	if self.Bastion != nil {
+		return self.Bastion.Address()
+	}
+	return ""
+}
+
+/* an ip address of a node (kubelet node-ip, an advertise-address : a private-ip if a node is reachable via a bastion only) */
+func (self *Machine) nodeIP() string {
+	if self.Bastion != nil || self.PublicIP == "" {
+		return self.PrivateIP
+	}
+	return self.PublicIP
+}
+
+/* a type of a node-ip (public, private) */
+func (self *Machine) nodeIPType() string {
+	if self.Bastion != nil || self.PublicIP == "" {
+		return "private"
+	}
+	return "public"
+}
+
+/* an executor of a machine (default executor if not injected) */
+func (self *Machine) executor() CommandExecutor {
+	if self.Executor != nil {
+		return self.Executor
+	}
+	return DefaultExecutor
+}
+
+var hostKeyMutex sync.Mutex
+
+/* verify a host key with a pinned host key (trust-on-first-use : a host key is pinned on the first contact) */
+func (self *Machine) verifyHostKey(key ssh.PublicKey) error {
+	hostKeyMutex.Lock()
+	defer hostKeyMutex.Unlock()
+
+	if self.HostKey == "" {
+		self.HostKey = marshalHostKey(key)
+		logger.Infof("[%s] A host key has been pinned on the first contact. (server=%s, fingerprint=%s)", self.Name, self.address(), ssh.FingerprintSHA256(key))
+		return nil
+	}
+
+	pinned, _, _, _, err := ssh.ParseAuthorizedKey([]byte(self.HostKey))
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to parse a pinned host key. (node=%s, cause='%v')", self.Name, err))
+	}
+	if !bytes.Equal(pinned.Marshal(), key.Marshal()) {
+		logger.Warnf("[%s] Host key verification failed. (server=%s, pinned=%s, received=%s)", self.Name, self.address(), ssh.FingerprintSHA256(pinned), ssh.FingerprintSHA256(key))
+		return errors.New(fmt.Sprintf("Host key verification failed. (node=%s, pinned=%s, received=%s)", self.Name, ssh.FingerprintSHA256(pinned), ssh.FingerprintSHA256(key)))
+	}
+	return nil
+}
+
+/* verify a host key of a bastion (trust-on-first-use as same as machines) */
+func verifyBastionHostKey(bastion *model.Bastion, key ssh.PublicKey) error {
+	hostKeyMutex.Lock()
+	defer hostKeyMutex.Unlock()
+
+	if bastion.HostKey == "" {
+		bastion.HostKey = marshalHostKey(key)
+		logger.Infof("[%s] A host key of a bastion has been pinned on the first contact. (server=%s, fingerprint=%s)", bastion.Connection, bastion.Address(), ssh.FingerprintSHA256(key))
+		return nil
+	}
+
+	pinned, _, _, _, err := ssh.ParseAuthorizedKey([]byte(bastion.HostKey))
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to parse a pinned host key of a bastion. (connection=%s, cause='%v')", bastion.Connection, err))
+	}
+	if !bytes.Equal(pinned.Marshal(), key.Marshal()) {
+		logger.Warnf("[%s] Host key verification of a bastion failed. (server=%s, pinned=%s, received=%s)", bastion.Connection, bastion.Address(), ssh.FingerprintSHA256(pinned), ssh.FingerprintSHA256(key))
+		return errors.New(fmt.Sprintf("Host key verification of a bastion failed. (connection=%s, pinned=%s, received=%s)", bastion.Connection, ssh.FingerprintSHA256(pinned), ssh.FingerprintSHA256(key)))
+	}
+	return nil
+}
+
+/* a host key in an authorized-keys format (e.g. "ssh-ed25519 AAAA...") */
+func marshalHostKey(key ssh.PublicKey) string {
+	return strings.TrimSpace(string(ssh.MarshalAuthorizedKey(key)))
+}
@@ -0,0 +1,248 @@
+package provision
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+const (
+	FAKE_JOIN_TOKEN             = "abcdef.0123456789abcdef"
+	FAKE_DISCOVERY_HASH         = "sha256:0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	FAKE_CERTIFICATE_KEY        = "0123456789abcdef0123456789abcdef0123456789abcdef0123456789abcdef"
+	FAKE_KUBECONFIG             = "apiVersion: v1\nkind: Config\nclusters:\n- cluster:\n    server: https://127.0.0.1:9998\n  name: kubernetes\n"
+	FAKE_CONTROL_PLANE_ENDPOINT = "127.0.0.1:9998"
+)
+
+/* a fake executor - records commands & copies and returns scripted outputs (tests) */
+type FakeExecutor struct {
+	mutex       sync.Mutex
+	rules       []*FakeRule
+	Commands    []FakeCommand
+	Copies      []FakeCopy
+	Unreachable map[string]bool   // machine names which are failed to dial
+	HostKeys    map[string]string // host keys which machines present (authorized-keys format, verified if exists)
+	Dials       []FakeDial
+}
+
+/* a scripted output of commands which contain a pattern */
+type FakeRule struct {
+	pattern string
+	node    string // empty : all machines
+	output  string
+	err     error
+	times   int  // 0 : unlimited
+	hang    bool // block until a context is done (e.g. a hung apt-get)
+}
+
+type FakeCommand struct {
+	Node    string
+	Command string
+}
+
+type FakeDial struct {
+	Node    string
+	Address string
+	Timeout time.Duration
+}
+
+type FakeCopy struct {
+	Node        string
+	Source      string
+	Destination string
+	Content     string
+}
+
+/* new a fake executor with default outputs of a successful provisioning (bootstrap, kubeadm init & join, admin.conf, healthz) */
+func NewFakeExecutor() *FakeExecutor {
+
+	executor := &FakeExecutor{Unreachable: map[string]bool{}, HostKeys: map[string]string{}}
+	executor.On("bootstrap.sh").Return("kubectl set on hold")
+	executor.On("k8s-init.sh").Return(FakeKubeadmInitOutput())
+	executor.On("kubeadm join").Return("This node has joined the cluster")
+	executor.On("kubeadm token create --print-join-command").Return(fmt.Sprintf("kubeadm join %s --token %s --discovery-token-ca-cert-hash %s", FAKE_CONTROL_PLANE_ENDPOINT, FAKE_JOIN_TOKEN, FAKE_DISCOVERY_HASH))
+	executor.On("cat /etc/kubernetes/admin.conf").Return(FAKE_KUBECONFIG)
+	executor.On("healthz").Return("ok")
+	return executor
+}
+
+/* a banner of 'kubeadm init' */
+func FakeKubeadmInitOutput() string {
+	return fmt.Sprintf(`[init] Using Kubernetes version: v1.23.14
+[addons] Applied essential addon: kube-proxy
+
+Your Kubernetes control-plane has initialized successfully!
+
+You can now join any number of the control-plane node running the following command on each as root:
+
+  kubeadm join %[1]s --token %[2]s \
+	--discovery-token-ca-cert-hash %[3]s \
+	--control-plane --certificate-key %[4]s
+
+Then you can join any number of worker nodes by running the following on each as root:
+
+kubeadm join %[1]s --token %[2]s \
+	--discovery-token-ca-cert-hash %[3]s
+`, FAKE_CONTROL_PLANE_ENDPOINT, FAKE_JOIN_TOKEN, FAKE_DISCOVERY_HASH, FAKE_CERTIFICATE_KEY)
+}
+
+/* add a rule (later rules take precedence over earlier ones) */
+func (self *FakeExecutor) On(pattern string) *FakeRule {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	rule := &FakeRule{pattern: pattern}
+	self.rules = append(self.rules, rule)
+	return rule
+}
+
+/* apply a rule to a machine only (a name or a prefix of names) */
+func (self *FakeRule) Node(name string) *FakeRule {
+	self.node = name
+	return self
+}
+
+/* returns an output */
+func (self *FakeRule) Return(output string) *FakeRule {
+	self.output = output
+	return self
+}
+
+/* returns an error */
+func (self *FakeRule) Fail(err error) *FakeRule {
+	self.err = err
+	return self
+}
+
+/* block until a context is done (a step timeout) */
+func (self *FakeRule) Hang() *FakeRule {
+	self.hang = true
+	return self
+}
+
+/* apply a rule n times only (e.g. a command succeeds on a retry) */
+func (self *FakeRule) Times(n int) *FakeRule {
+	self.times = n
+	return self
+}
+
+func (self *FakeExecutor) Run(ctx context.Context, machine *Machine, command string) (string, string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", "", err
+	}
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if err := self.verifyHostKey(machine); err != nil {
+		return "", "", err
+	}
+	self.Commands = append(self.Commands, FakeCommand{Node: machine.Name, Command: command})
+
+	output, err, hang := "", error(nil), false
+	for i := len(self.rules) - 1; i >= 0; i-- {
+		rule := self.rules[i]
+		if rule.times < 0 {
+			continue
+		}
+		if strings.Contains(command, rule.pattern) && (rule.node == "" || strings.HasPrefix(machine.Name, rule.node)) {
+			output, err, hang = rule.output, rule.err, rule.hang
+			if rule.times > 0 {
+				if rule.times--; rule.times == 0 {
+					rule.times = -1 // exhausted
+				}
+			}
+			break
+		}
+	}
+	if hang {
+		self.mutex.Unlock()
+		<-ctx.Done()
+		self.mutex.Lock()
+		return "", "", ctx.Err()
+	}
+	if err == nil && strings.Contains(command, "echo "+exitCodePrefix) {
+		output = fmt.Sprintf("%s\n%s0", output, exitCodePrefix)
+	}
+	return output, "", err
+}
+
+func (self *FakeExecutor) Copy(ctx context.Context, machine *Machine, source string, destination string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	content, err := ioutil.ReadFile(source)
+	if err != nil {
+		return err
+	}
+
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	if err := self.verifyHostKey(machine); err != nil {
+		return err
+	}
+	self.Copies = append(self.Copies, FakeCopy{Node: machine.Name, Source: source, Destination: destination, Content: string(content)})
+	return nil
+}
+
+func (self *FakeExecutor) Dial(ctx context.Context, machine *Machine, timeout time.Duration) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	self.Dials = append(self.Dials, FakeDial{Node: machine.Name, Address: machine.address(), Timeout: timeout})
+	if self.Unreachable[machine.Name] {
+		return errors.New(fmt.Sprintf("dial tcp %s: i/o timeout", machine.address()))
+	}
+	return nil
+}
+
+/* verify a host key which a machine presents */
+func (self *FakeExecutor) verifyHostKey(machine *Machine) error {
+
+	if hostKey, exists := self.HostKeys[machine.Name]; exists {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(hostKey))
+		if err != nil {
+			return err
+		}
+		return machine.verifyHostKey(key)
+	}
+	return nil
+}
+
+/* commands executed on a machine (in order) */
+func (self *FakeExecutor) CommandsOf(node string) []string {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	commands := []string{}
+	for _, c := range self.Commands {
+		if c.Node == node {
+			commands = append(commands, c.Command)
+		}
+	}
+	return commands
+}
+
+/* executed commands which contain a pattern */
+func (self *FakeExecutor) Find(pattern string) []FakeCommand {
+	self.mutex.Lock()
+	defer self.mutex.Unlock()
+
+	commands := []FakeCommand{}
+	for _, c := range self.Commands {
+		if strings.Contains(c.Command, pattern) {
+			commands = append(commands, c)
+		}
+	}
+	return commands
+}
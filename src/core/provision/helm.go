@@ -0,0 +1,163 @@
+package provision
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	HELM_VERSION = "v3.8.2"
+	HELM_URL     = "https://get.helm.sh/helm-%s-linux-amd64.tar.gz"
+)
+
+/* helm release (helm status/install/upgrade -o json) */
+type helmRelease struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+	Version   int    `json:"version"`
+	Info      struct {
+		Status       string `json:"status"`
+		LastDeployed string `json:"last_deployed"`
+	} `json:"info"`
+	Chart struct {
+		Metadata struct {
+			Name       string `json:"name"`
+			Version    string `json:"version"`
+			AppVersion string `json:"appVersion"`
+		} `json:"metadata"`
+	} `json:"chart"`
+}
+
+/* install a helm binary on the control-plane leader (if not installed) */
+func (self *Provisioner) InstallHelm(ctx context.Context) error {
+
+	if _, err := self.leader.executeSSH(ctx, "which helm"); err == nil {
+		return nil
+	}
+	url := fmt.Sprintf(HELM_URL, HELM_VERSION)
+	if _, err := self.leader.executeSSH(ctx, "curl -fsSL %s | sudo tar -xz -C /usr/local/bin --strip-components=1 linux-amd64/helm", url); err != nil {
+		return errors.New(fmt.Sprintf("Failed to install helm. (node=%s, url=%s)", self.leader.Name, url))
+	}
+	logger.Infof("[%s.%s] Helm installation has been completed. (version=%s)", self.Cluster.Namespace, self.Cluster.Name, HELM_VERSION)
+
+	return nil
+}
+
+/* execute helm */
+func (self *Provisioner) Helm(ctx context.Context, format string, a ...interface{}) (string, error) {
+
+	command := fmt.Sprintf(format, a...)
+	output, exitCode, err := self.leader.executeSSHExitCode(ctx, "sudo helm %s --kubeconfig=/etc/kubernetes/admin.conf", command)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Failed to helm. (command='%s')", command))
+	} else if exitCode != 0 {
+		return "", errors.New(fmt.Sprintf("Failed to helm. (command='%s', output='%s')", command, output))
+	}
+	return output, nil
+}
+
+/* install or upgrade a release */
+func (self *Provisioner) InstallRelease(ctx context.Context, release *model.Release, upgrade bool) error {
+
+	if err := self.InstallHelm(ctx); err != nil {
+		return err
+	}
+
+	command := fmt.Sprintf("install %s %s --namespace %s --create-namespace", release.Name, release.Chart, release.Namespace)
+	if upgrade {
+		command = fmt.Sprintf("upgrade %s %s --namespace %s", release.Name, release.Chart, release.Namespace)
+	}
+	if release.Repo != "" {
+		command += fmt.Sprintf(" --repo %s", release.Repo)
+	}
+	if release.ChartVersion != "" {
+		command += fmt.Sprintf(" --version %s", release.ChartVersion)
+	}
+
+	// values (copy a values file to the control-plane leader)
+	if release.Values != "" {
+		f, err := ioutil.TempFile("", "mcks-values-*.yaml")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(f.Name())
+		if _, err := f.WriteString(release.Values); err != nil {
+			f.Close()
+			return err
+		}
+		f.Close()
+
+		dest := fmt.Sprintf("%s/releases/%s-values.yaml", REMOTE_TARGET_PATH, release.Name)
+		if _, err := self.leader.executeSSH(ctx, "mkdir -p %s/releases", REMOTE_TARGET_PATH); err != nil {
+			return err
+		}
+		if err := self.leader.executeSCP(ctx, f.Name(), dest); err != nil {
+			return err
+		}
+		defer self.leader.executeSSH(ctx, "rm -f %s", dest)
+		command += fmt.Sprintf(" -f %s", dest)
+	} else if upgrade {
+		command += " --reuse-values"
+	}
+
+	output, err := self.Helm(ctx, "%s -o json", command)
+	if err != nil {
+		return err
+	}
+	return bindRelease(release, output)
+}
+
+/* rollback a release to a revision */
+func (self *Provisioner) RollbackRelease(ctx context.Context, release *model.Release, revision int) error {
+
+	if err := self.InstallHelm(ctx); err != nil {
+		return err
+	}
+	if _, err := self.Helm(ctx, "rollback %s %d --namespace %s", release.Name, revision, release.Namespace); err != nil {
+		return err
+	}
+	output, err := self.Helm(ctx, "status %s --namespace %s -o json", release.Name, release.Namespace)
+	if err != nil {
+		return err
+	}
+	return bindRelease(release, output)
+}
+
+/* uninstall a release */
+func (self *Provisioner) UninstallRelease(ctx context.Context, release *model.Release) error {
+
+	if err := self.InstallHelm(ctx); err != nil {
+		return err
+	}
+	if _, err := self.Helm(ctx, "uninstall %s --namespace %s", release.Name, release.Namespace); err != nil {
+		return err
+	}
+	return nil
+}
+
+/* set release-entity fields from a helm output (json) */
+func bindRelease(release *model.Release, output string) error {
+
+	// skip warnings (stderr) before a json output
+	if idx := strings.Index(output, "{"); idx > 0 {
+		output = output[idx:]
+	}
+	r := helmRelease{}
+	if err := json.Unmarshal([]byte(output), &r); err != nil {
+		return errors.New(fmt.Sprintf("Failed to parse a helm release. (release=%s, cause='%v')", release.Name, err))
+	}
+	release.Revision = r.Version
+	release.Status = r.Info.Status
+	release.ChartVersion = r.Chart.Metadata.Version
+	release.AppVersion = r.Chart.Metadata.AppVersion
+	return nil
+}
@@ -0,0 +1,191 @@
+package provision
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/utils/lang"
+)
+
+/* data of a kubeadm-config template (src/scripts/kubeadm-config.yaml) */
+type kubeadmConfig struct {
+	ApiVersion                 string
+	ControlPlaneEndpoint       string
+	PodSubnet                  string
+	ServiceSubnet              string
+	DnsDomain                  string
+	ApiServerExtraArgs         map[string]string
+	ApiServerExtraVolumes      []kubeadmVolume
+	ControllerManagerExtraArgs map[string]string
+	SchedulerExtraArgs         map[string]string
+	CertSANs                   []string
+	EtcdDataDir                string
+	EtcdExtraArgs              map[string]string
+	Kubelet                    *app.ClusterConfigKubeletReq
+	FeatureGates               map[string]bool
+}
+
+/* a host-path volume of a control-plane component */
+type kubeadmVolume struct {
+	Name      string
+	HostPath  string
+	MountPath string
+	ReadOnly  bool
+	PathType  string
+}
+
+/* render a kubeadm-config and copy it to the control-plane leader (with an OIDC CA, an audit-policy & an encryption-config to all control-plane nodes) */
+func (self *Provisioner) copyKubeadmConfig(ctx context.Context, req app.ClusterConfigKubernetesReq) error {
+
+	config, err := renderKubeadmConfig(req, self.Cluster.Version, self.leader.nodeIP())
+	if err != nil {
+		return err
+	}
+
+	if req.Oidc != nil && req.Oidc.CA != "" {
+		for _, machine := range self.ControlPlaneMachines {
+			if err := machine.copyOidcCA(ctx, req.Oidc.CA); err != nil {
+				return err
+			}
+		}
+	}
+
+	if req.Audit != nil {
+		policy, err := renderAuditPolicy(*req.Audit)
+		if err != nil {
+			return err
+		}
+		for _, machine := range self.ControlPlaneMachines {
+			if err := machine.copyRootFile(ctx, policy, AUDIT_POLICY_PATH, "644"); err != nil {
+				return err
+			}
+		}
+	}
+	if req.Encryption != nil {
+		encryptionConfig, err := newEncryptionConfig(*req.Encryption)
+		if err != nil {
+			return err
+		}
+		if err := self.copyEncryptionConfig(ctx, encryptionConfig); err != nil {
+			return err
+		}
+	}
+
+	return self.leader.copyContent(ctx, config, fmt.Sprintf("%s/%s", REMOTE_TARGET_PATH, KUBEADM_CONFIG_FILE))
+}
+
+/* render a kubeadm-config (v1beta2 or v1beta3 depending on a kubernetes version) */
+func renderKubeadmConfig(req app.ClusterConfigKubernetesReq, k8sVersion string, publicIP string) (string, error) {
+
+	path := fmt.Sprintf("%s/src/scripts/%s", *app.Config.AppRootPath, KUBEADM_CONFIG_FILE)
+	tpl, err := template.New(filepath.Base(path)).Funcs(template.FuncMap{"quote": quote}).ParseFiles(path)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Failed to parse a kubeadm-config template. (path=%s, cause='%v')", path, err))
+	}
+
+	featureGates := ""
+	if len(req.FeatureGates) > 0 {
+		gates := []string{}
+		for name, enabled := range req.FeatureGates {
+			gates = append(gates, fmt.Sprintf("%s=%t", name, enabled))
+		}
+		sort.Strings(gates)
+		featureGates = strings.Join(gates, ",")
+	}
+
+	data := kubeadmConfig{
+		ApiVersion:                 kubeadmApiVersion(k8sVersion),
+		ControlPlaneEndpoint:       fmt.Sprintf("%s:%d", lang.NVL(req.Endpoint, publicIP), CONTROL_PLANE_ENDPOINT_PORT),
+		PodSubnet:                  lang.NVL(req.PodCidr, app.POD_CIDR),
+		ServiceSubnet:              lang.NVL(req.ServiceCidr, app.SERVICE_CIDR),
+		DnsDomain:                  lang.NVL(req.ServiceDnsDomain, app.SERVICE_DOMAIN),
+		ApiServerExtraArgs:         extraArgs(req.ApiServer, featureGates, map[string]string{"authorization-mode": "Node,RBAC"}),
+		ControllerManagerExtraArgs: extraArgs(req.ControllerManager, featureGates, nil),
+		SchedulerExtraArgs:         extraArgs(req.Scheduler, featureGates, nil),
+		CertSANs:                   certSANs(req.Endpoint, req.CertSANs, publicIP),
+		EtcdDataDir:                "/var/lib/etcd",
+		Kubelet:                    req.Kubelet,
+		FeatureGates:               req.FeatureGates,
+	}
+	data.ApiServerExtraArgs["advertise-address"] = publicIP
+	for _, args := range []map[string]string{oidcExtraArgs(req.Oidc), auditExtraArgs(req.Audit), encryptionExtraArgs(req.Encryption)} {
+		for key, value := range args {
+			data.ApiServerExtraArgs[key] = value
+		}
+	}
+	if req.Audit != nil || req.Encryption != nil {
+		data.ApiServerExtraVolumes = append(data.ApiServerExtraVolumes, kubeadmVolume{Name: "mcks-config", HostPath: "/etc/kubernetes/mcks", MountPath: "/etc/kubernetes/mcks", ReadOnly: true, PathType: "DirectoryOrCreate"})
+	}
+	if req.Audit != nil {
+		data.ApiServerExtraVolumes = append(data.ApiServerExtraVolumes, kubeadmVolume{Name: "audit-log", HostPath: AUDIT_LOG_DIR, MountPath: AUDIT_LOG_DIR, PathType: "DirectoryOrCreate"})
+	}
+	if req.Etcd != nil {
+		data.EtcdDataDir = lang.NVL(req.Etcd.DataDir, data.EtcdDataDir)
+		data.EtcdExtraArgs = req.Etcd.ExtraArgs
+	}
+	if data.Kubelet == nil && len(req.FeatureGates) > 0 {
+		data.Kubelet = &app.ClusterConfigKubeletReq{} // kubelet feature-gates
+	}
+
+	var out bytes.Buffer
+	if err := tpl.Execute(&out, data); err != nil {
+		return "", errors.New(fmt.Sprintf("Failed to render a kubeadm-config. (cause='%v')", err))
+	}
+	return out.String(), nil
+}
+
+/* kubeadm api version (v1beta3 is supported since kubernetes 1.22) */
+func kubeadmApiVersion(k8sVersion string) string {
+	versions := strings.Split(k8sVersion, ".")
+	if len(versions) > 1 {
+		if minor, err := strconv.Atoi(versions[1]); err == nil && minor >= 22 {
+			return "v1beta3"
+		}
+	}
+	return "v1beta2"
+}
+
+/* merge extra-args (defaults < user extra-args < feature-gates) */
+func extraArgs(component *app.ClusterConfigComponentReq, featureGates string, defaults map[string]string) map[string]string {
+	args := map[string]string{}
+	for key, value := range defaults {
+		args[key] = value
+	}
+	if component != nil {
+		for key, value := range component.ExtraArgs {
+			args[key] = value
+		}
+	}
+	if featureGates != "" {
+		args["feature-gates"] = featureGates
+	}
+	return args
+}
+
+/* cert-SANs (an endpoint name, extra SANs and a public-ip of the leader) */
+func certSANs(endpoint string, sans []string, publicIP string) []string {
+	result := []string{}
+	exists := map[string]bool{}
+	for _, san := range append(append([]string{endpoint}, sans...), publicIP) {
+		if san != "" && !exists[san] {
+			exists[san] = true
+			result = append(result, san)
+		}
+	}
+	return result
+}
+
+/* a double-quoted yaml string */
+func quote(value string) string {
+	b, _ := json.Marshal(value)
+	return string(b)
+}
@@ -0,0 +1,75 @@
+package provision
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"github.com/cloud-barista/cb-mcks/src/utils/lang"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+type stepKey struct{}
+type sensitiveKey struct{}
+
+// secrets of join commands (a bootstrap token, a certificate key)
+var secretRegex = regexp.MustCompile(`(--token|--certificate-key)(\s+|=)[^\s\\]+`)
+
+/* a context of a provisioning step (outputs of remote commands are recorded to node logs of a step) */
+func WithStep(ctx context.Context, step app.Step) context.Context {
+	return context.WithValue(ctx, stepKey{}, step)
+}
+
+/* a provisioning step of a context (STEP_OTHER if not specified) */
+func stepOf(ctx context.Context) app.Step {
+	if step, ok := ctx.Value(stepKey{}).(app.Step); ok {
+		return step
+	}
+	return app.STEP_OTHER
+}
+
+/* a context of remote commands which print secrets (an admin kubeconfig, encryption keys, ...), outputs are not recorded to node logs */
+func withSensitive(ctx context.Context) context.Context {
+	return context.WithValue(ctx, sensitiveKey{}, true)
+}
+
+/* whether outputs of a context are sensitive */
+func isSensitive(ctx context.Context) bool {
+	sensitive, _ := ctx.Value(sensitiveKey{}).(bool)
+	return sensitive
+}
+
+/* mask secrets of join commands */
+func maskSecrets(text string) string {
+	return secretRegex.ReplaceAllString(text, "$1$2******")
+}
+
+/* record a remote command & outputs to a node log of a step (only a command if sensitive, a failure of recording does not fail a command) */
+func (self *Machine) recordLog(ctx context.Context, command string, stdout string, stderr string, err error) {
+
+	if self.Namespace == "" || self.ClusterName == "" {
+		return
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "[%s] $ %s\n", lang.GetNowUTC(), command)
+	if isSensitive(ctx) {
+		stdout = ""
+	}
+	if stdout != "" {
+		b.WriteString(stdout + "\n")
+	}
+	if stderr != "" {
+		b.WriteString(stderr + "\n")
+	}
+	if err != nil {
+		fmt.Fprintf(&b, "[error] %v\n", err)
+	}
+	if e := model.AppendNodeLog(self.Namespace, self.ClusterName, self.Name, stepOf(ctx), maskSecrets(b.String()), *app.Config.NodeLogMaxSize); e != nil {
+		logger.Warnf("[%s] Failed to record a node log. (step=%s, cause='%v')", self.Name, stepOf(ctx), e)
+	}
+}
@@ -1,55 +1,67 @@
 package provision
 
 import (
+	"context"
 	"errors"
 	"fmt"
-	"net"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/cloud-barista/cb-mcks/src/core/app"
 	"github.com/cloud-barista/cb-mcks/src/core/model"
-	ssh "github.com/cloud-barista/cb-spider/cloud-control-manager/vm-ssh"
 
 	logger "github.com/sirupsen/logrus"
 )
 
 /* ssh execution */
-func (self *Machine) executeSSH(format string, a ...interface{}) (string, error) {
+func (self *Machine) executeSSH(ctx context.Context, format string, a ...interface{}) (string, error) {
 
-	address := fmt.Sprintf("%s:22", self.PublicIP)
+	address := self.address()
 	command := fmt.Sprintf(format, a...)
 
 	logger.Infof("[%s] SSH executing. (server=%s, command='%s')", self.Name, address, command)
-	output, err := ssh.SSHRun(
-		ssh.SSHInfo{
-			UserName:   self.Username,
-			PrivateKey: []byte(self.Credential),
-			ServerPort: address,
-		}, command)
-	if err != nil {
+	output, stderr, err := self.executor().Run(ctx, self, command)
+	if err != nil && isSensitive(ctx) {
+		logger.Warnf("[%s] Failed to run SSH command. (server=%s, cause='%v', command='%s')", self.Name, address, err, command)
+	} else if err != nil {
 		logger.Warnf("[%s] Failed to run SSH command. (server=%s, cause='%v', command='%s', output='%s')", self.Name, address, err, command, output)
 	}
+	self.recordLog(ctx, command, output, stderr, err)
 	return output, err
 }
 
+/* ssh execution with combined outputs (stdout, stderr) and a exit code of a command */
+func (self *Machine) executeSSHExitCode(ctx context.Context, format string, a ...interface{}) (string, int, error) {
+
+	output, err := self.executeSSH(ctx, format+" 2>&1; echo %s$?", append(a, exitCodePrefix)...)
+	if err != nil {
+		return output, -1, err
+	}
+
+	exitCode := 0
+	if idx := strings.LastIndex(output, exitCodePrefix); idx >= 0 {
+		exitCode, _ = strconv.Atoi(strings.TrimSpace(output[idx+len(exitCodePrefix):]))
+		output = strings.TrimSpace(output[:idx])
+	}
+	return output, exitCode, nil
+}
+
 /* scp execution */
-func (self *Machine) executeSCP(source string, destination string) error {
+func (self *Machine) executeSCP(ctx context.Context, source string, destination string) error {
 
 	//validate files exist
 	if _, err := os.Stat(source); err != nil {
 		return errors.New(fmt.Sprintf("SCP source file does not exist (%s)", source))
 	}
 
-	address := fmt.Sprintf("%s:22", self.PublicIP)
+	address := self.address()
 
-	err := ssh.SSHCopy(
-		ssh.SSHInfo{
-			UserName:   self.Username,
-			PrivateKey: []byte(self.Credential),
-			ServerPort: address,
-		}, source, destination)
+	err := self.executor().Copy(ctx, self, source, destination)
+	self.recordLog(ctx, fmt.Sprintf("scp %s %s", filepath.Base(source), destination), "", "", err)
 	if err != nil {
 		logger.Warnf("[%s] Failed to copy files. (server=%s, destination='%s', cause='%v')", self.Name, address, destination, err)
 	} else {
@@ -58,53 +70,93 @@ func (self *Machine) executeSCP(source string, destination string) error {
 	return err
 }
 
-/* ssh onnectivity test */
-func (self *Machine) checkConnectivity() error {
+/* copy a content to a remote file (scp via a local temporary file) */
+func (self *Machine) copyContent(ctx context.Context, content string, destination string) error {
 
-	address := fmt.Sprintf("%s:22", self.PublicIP)
-	timeout := time.Second * time.Duration(10)
-	conn, err := net.DialTimeout("tcp", address, timeout)
+	f, err := ioutil.TempFile("", "mcks-*-"+filepath.Base(destination))
 	if err != nil {
 		return err
 	}
-	if conn != nil {
-		defer conn.Close()
-		return nil
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(content); err != nil {
+		f.Close()
+		return err
+	}
+	f.Close()
+
+	return self.executeSCP(ctx, f.Name(), destination)
+}
+
+/* copy a content to a remote file owned by root (e.g. files in /etc/kubernetes) */
+func (self *Machine) copyRootFile(ctx context.Context, content string, path string, mode string) error {
+
+	source := fmt.Sprintf("%s/%s", REMOTE_TARGET_PATH, filepath.Base(path))
+	if err := self.copyContent(ctx, content, source); err != nil {
+		return err
+	}
+	if _, err := self.executeSSH(ctx, "sudo install -D -m %s -o root -g root %s %s && rm -f %s", mode, source, path, source); err != nil {
+		return errors.New(fmt.Sprintf("Failed to copy a file. (node=%s, path=%s, cause='%v')", self.Name, path, err))
+	}
+	return nil
+}
+
+/* wait for a local api-server of a control-plane node */
+func (self *Machine) waitForApiServer(ctx context.Context) error {
+
+	if output, _ := self.executeSSH(ctx, "sleep 5; for i in $(seq 1 60); do curl -sk https://127.0.0.1:6443/healthz 2>/dev/null && break; sleep 2; done"); !strings.Contains(output, "ok") {
+		return errors.New(fmt.Sprintf("The api-server is not ready. (node=%s)", self.Name))
 	}
+	return nil
+}
+
+/* ssh onnectivity test */
+func (self *Machine) checkConnectivity(ctx context.Context) error {
 
-	return errors.New("Failed to validate connectivity.")
+	return self.executor().Dial(ctx, self, time.Second*time.Duration(self.SSH.DialTimeout))
 }
 
 /* ssh connect test */
-func (self *Machine) ConnectionTest() error {
+func (self *Machine) ConnectionTest(ctx context.Context) error {
 
-	retryCheck := 15
+	retryCheck := self.SSH.Retries
+	if retryCheck < 1 {
+		retryCheck = 1
+	}
+	interval := time.Second * time.Duration(self.SSH.RetryInterval)
 	for i := 0; i < retryCheck; i++ {
-		err := self.checkConnectivity()
+		err := self.checkConnectivity(ctx)
 		if err == nil {
 			// verify SSH connect
-			if _, err := self.executeSSH("/bin/hostname"); err == nil {
+			if _, err := self.executeSSH(ctx, "/bin/hostname"); err == nil {
 				break
 			} else {
-				logger.Infof("[%s] Failed to validate SSH connection. (ip=%s, retry=%d)", self.Name, self.PublicIP, i)
+				logger.Infof("[%s] Failed to validate SSH connection. (server=%s, retry=%d)", self.Name, self.address(), i)
 			}
 		} else {
-			logger.Infof("[%s] Dial timeout. (dial=tcp://%s:22, retry=%d)", self.Name, self.PublicIP, i)
+			logger.Infof("[%s] Dial timeout. (dial=tcp://%s, bastion=%s, retry=%d)", self.Name, self.address(), self.bastionAddress(), i)
 		}
 		if i == retryCheck-1 {
-			return errors.New(fmt.Sprintf("SSH connection retry count has exceeded. (node=%s, ip=%s)", self.Name, self.PublicIP))
+			return errors.New(fmt.Sprintf("SSH connection retry count has exceeded. (node=%s, server=%s)", self.Name, self.address()))
+		}
+		// exponential backoff
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(interval):
+		}
+		if interval = interval * 2; interval > time.Second*time.Duration(self.SSH.MaxRetryInterval) {
+			interval = time.Second * time.Duration(self.SSH.MaxRetryInterval)
 		}
-		time.Sleep(2 * time.Second)
 	}
 	return nil
 }
 
 /* bootstrap */
-func (self *Machine) bootstrap(networkCni app.NetworkCni, k8sVersion string) error {
+func (self *Machine) bootstrap(ctx context.Context, networkCni NetworkCni, k8sVersion string) error {
 
 	//verfiy
-	if self.CSP == "" || self.Region == "" || self.Name == "" || self.PublicIP == "" {
-		return errors.New(fmt.Sprintf("There are mandatory fields. (node=%s, role=%s, csp=%s, region=%s, publicip=%s)", self.Name, self.Role, self.CSP, self.Region, self.PublicIP))
+	if self.CSP == "" || self.Region == "" || self.Name == "" || self.nodeIP() == "" {
+		return errors.New(fmt.Sprintf("There are mandatory fields. (node=%s, role=%s, csp=%s, region=%s, publicip=%s, privateip=%s)", self.Name, self.Role, self.CSP, self.Region, self.PublicIP, self.PrivateIP))
 	}
 
 	// 1. copy files
@@ -115,13 +167,14 @@ func (self *Machine) bootstrap(networkCni app.NetworkCni, k8sVersion string) err
 	//  - list-up for control-plane
 	if self.Role == app.CONTROL_PLANE {
 		sourceFiles = append(sourceFiles, "haproxy.sh", "k8s-init.sh")
-		if _, err := self.executeSSH("mkdir -p %s/addons/%s", REMOTE_TARGET_PATH, networkCni); err != nil {
-			return errors.New(fmt.Sprintf("Failed to create a addon directory. (node=%s, path='%s')", self.Name, "addons/"+networkCni))
-		}
-		if networkCni == app.NETWORKCNI_CANAL {
-			sourceFiles = append(sourceFiles, CNI_CANAL_FILE)
-		} else {
-			sourceFiles = append(sourceFiles, CNI_KILO_CRDS_FILE, CNI_KILO_KUBEADM_FILE, CNI_KILO_FLANNEL_FILE)
+		for _, manifest := range networkCni.Manifests() {
+			if isRemoteManifest(manifest) {
+				continue
+			}
+			if _, err := self.executeSSH(ctx, "mkdir -p %s/%s", REMOTE_TARGET_PATH, filepath.Dir(manifest)); err != nil {
+				return errors.New(fmt.Sprintf("Failed to create a addon directory. (node=%s, path='%s')", self.Name, filepath.Dir(manifest)))
+			}
+			sourceFiles = append(sourceFiles, manifest)
 		}
 	}
 
@@ -129,33 +182,38 @@ func (self *Machine) bootstrap(networkCni app.NetworkCni, k8sVersion string) err
 	for _, f := range sourceFiles {
 		src := fmt.Sprintf("%s/%s", sourcePath, f)
 		dest := fmt.Sprintf("%s/%s", REMOTE_TARGET_PATH, f)
-		if err := self.executeSCP(src, dest); err != nil {
+		if err := self.executeSCP(ctx, src, dest); err != nil {
 			return errors.New(fmt.Sprintf("Failed to copy bootstrap files. (node=%s, destination='%s', cause='%v')", self.Name, dest, err))
 		}
 	}
 
 	// 2. execute bootstrap.sh
-	if output, err := self.executeSSH(REMOTE_TARGET_PATH+"/bootstrap.sh %s %s %s %s %s", k8sVersion, self.CSP, self.Name, self.PublicIP, networkCni); err != nil {
+	if output, err := self.executeSSH(ctx, REMOTE_TARGET_PATH+"/bootstrap.sh %s %s %s %s %s %s '%s'", k8sVersion, self.CSP, self.Name, self.nodeIP(), networkCni.Name(), self.nodeIPType(), networkCni.PublicIPAnnotation()); err != nil {
 		return errors.New(fmt.Sprintf("Failed to execute bootstrap.sh (node=%s)", self.Name))
 	} else if !strings.Contains(output, "kubectl set on hold") {
 		return errors.New(fmt.Sprintf("Failed to execute bootstrap.sh shell. (node=%s, cause='kubectl not set on hold')", self.Name))
 	}
 
+	// 3. network-cni bootstrap hook
+	if err := networkCni.Bootstrap(ctx, self); err != nil {
+		return err
+	}
+
 	return nil
 
 }
 
 /* control-plane join */
-func (self *ControlPlaneMachine) JoinControlPlane(CPJoinCmd *string) error {
+func (self *ControlPlaneMachine) JoinControlPlane(ctx context.Context, CPJoinCmd *string) error {
 
 	if *CPJoinCmd == "" {
 		return errors.New("Control-plane-join-command is a mandatory parameter.")
 	}
 
-	if output, err := self.executeSSH("sudo %s", *CPJoinCmd); err != nil {
+	if output, err := self.executeSSH(ctx, "sudo %s", *CPJoinCmd); err != nil {
 		return errors.New(fmt.Sprintf("Failed to join control-plane. (node=%s)", self.Name))
 	} else if strings.Contains(output, "This node has joined the cluster") {
-		if _, err = self.executeSSH("sudo systemctl restart mcks-bootstrap"); err != nil {
+		if _, err = self.executeSSH(ctx, "sudo systemctl restart mcks-bootstrap"); err != nil {
 			logger.Warnf("[%s] mcks-bootstrap restart error (command='sudo systemctl restart mcks-bootstrap' cause='%v')", self.Name, err)
 		}
 	} else {
@@ -166,16 +224,16 @@ func (self *ControlPlaneMachine) JoinControlPlane(CPJoinCmd *string) error {
 }
 
 /* woker node join */
-func (self *WorkerNodeMachine) JoinWorker(workerJoinCmd *string) error {
+func (self *WorkerNodeMachine) JoinWorker(ctx context.Context, workerJoinCmd *string) error {
 
 	if *workerJoinCmd == "" {
 		return errors.New("Worker-join-command is a mandatory parameter.")
 	}
 
-	if output, err := self.executeSSH("sudo %s", *workerJoinCmd); err != nil {
+	if output, err := self.executeSSH(ctx, "sudo %s", *workerJoinCmd); err != nil {
 		return errors.New(fmt.Sprintf("Failed to join worker-node. (node=%s)", self.Name))
 	} else if strings.Contains(output, "This node has joined the cluster") {
-		if _, err = self.executeSSH("sudo systemctl restart mcks-bootstrap"); err != nil {
+		if _, err = self.executeSSH(ctx, "sudo systemctl restart mcks-bootstrap"); err != nil {
 			logger.Warnf("[%s] mcks-bootstrap restart error (command='sudo systemctl restart mcks-bootstrap', cause='%v')", self.Name, err)
 		}
 	} else {
@@ -189,15 +247,26 @@ func (self *WorkerNodeMachine) JoinWorker(workerJoinCmd *string) error {
 /* new instance of node-entity */
 func (self *Machine) NewNode() *model.Node {
 
+	ssh := self.SSH
+	ssh.Username = self.Username // a bound username (a node-set username or a VM user account)
 	return &model.Node{
-		Model:       model.Model{Kind: app.KIND_NODE, Name: self.Name},
-		Credential:  self.Credential,
-		Role:        self.Role,
-		Spec:        self.Spec,
-		Csp:         self.CSP,
-		PublicIP:    self.PublicIP,
-		CspLabel:    fmt.Sprintf("%s=%s", app.LABEL_KEY_CSP, string(self.CSP)),
-		RegionLabel: fmt.Sprintf("%s=%s", app.LABEL_KEY_REGION, self.Region),
-		ZoneLabel:   fmt.Sprintf("%s=%s", app.LABEL_KEY_ZONE, self.Zone),
+		Model:             model.Model{Kind: app.KIND_NODE, Name: self.Name},
+		Credential:        self.Credential,
+		Role:              self.Role,
+		Spec:              self.Spec,
+		Csp:               self.CSP,
+		PublicIP:          self.PublicIP,
+		PrivateIP:         self.PrivateIP,
+		Connection:        self.Connection,
+		CspLabel:          fmt.Sprintf("%s=%s", app.LABEL_KEY_CSP, string(self.CSP)),
+		RegionLabel:       fmt.Sprintf("%s=%s", app.LABEL_KEY_REGION, self.Region),
+		ZoneLabel:         fmt.Sprintf("%s=%s", app.LABEL_KEY_ZONE, self.Zone),
+		InstanceTypeLabel: fmt.Sprintf("%s=%s", app.LABEL_KEY_INSTANCE_TYPE, labelValue(self.Spec)),
+		ConnectionLabel:   fmt.Sprintf("%s=%s", app.LABEL_KEY_CONNECTION, labelValue(self.Connection)),
+		ProviderId:        self.ProviderId,
+		HostKey:           self.HostKey,
+		SSH:               &ssh,
+		Labels:            self.Labels,
+		Taints:            self.Taints,
 	}
 }
@@ -0,0 +1,121 @@
+package provision
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	MANIFEST_FIELD_MANAGER = "mcks"
+)
+
+var (
+	manifestResultRegex = regexp.MustCompile(`^([A-Za-z0-9.\-]+)/(\S+) (\S+)$`)
+)
+
+/* apply manifests (server-side apply) on the control-plane leader and returns per-object results */
+func (self *Provisioner) ApplyManifest(ctx context.Context, manifest string, pruneSelector string, forceConflicts bool) ([]model.ManifestObject, error) {
+
+	// validate yaml documents
+	if err := verifyManifest(manifest); err != nil {
+		return nil, err
+	}
+
+	// copy a manifest file to the control-plane leader
+	f, err := ioutil.TempFile("", "mcks-manifest-*.yaml")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.WriteString(manifest); err != nil {
+		f.Close()
+		return nil, err
+	}
+	f.Close()
+
+	dest := fmt.Sprintf("%s/manifests/%s", REMOTE_TARGET_PATH, filepath.Base(f.Name()))
+	if _, err := self.leader.executeSSH(ctx, "mkdir -p %s/manifests", REMOTE_TARGET_PATH); err != nil {
+		return nil, err
+	}
+	if err := self.leader.executeSCP(ctx, f.Name(), dest); err != nil {
+		return nil, err
+	}
+	defer self.leader.executeSSH(ctx, "rm -f %s", dest)
+
+	// apply (outputs contain errors because the each object results are required in failure)
+	command := fmt.Sprintf("apply --server-side --field-manager=%s -f %s", MANIFEST_FIELD_MANAGER, dest)
+	if forceConflicts {
+		command += " --force-conflicts"
+	}
+	if pruneSelector != "" {
+		command += fmt.Sprintf(" --prune -l '%s'", pruneSelector)
+	}
+	output, exitCode, err := self.leader.executeSSHExitCode(ctx, "sudo kubectl %s --kubeconfig=/etc/kubernetes/admin.conf", command)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to kubectl. (command='%s')", command))
+	}
+
+	objects := parseManifestResult(output)
+	if exitCode != 0 && len(objects) == 0 {
+		return nil, errors.New(fmt.Sprintf("Failed to apply manifests. (exit-code=%d)", exitCode))
+	}
+	return objects, nil
+}
+
+/* verify manifests are kubernetes objects (multiple yaml documents) */
+func verifyManifest(manifest string) error {
+
+	count := 0
+	decoder := yaml.NewDecoder(bytes.NewBufferString(manifest))
+	for {
+		obj := map[string]interface{}{}
+		if err := decoder.Decode(&obj); err == io.EOF {
+			break
+		} else if err != nil {
+			return errors.New(fmt.Sprintf("Invalid manifest. (document=%d, cause='%v')", count+1, err))
+		}
+		if len(obj) == 0 {
+			continue // empty document
+		}
+		count++
+		if obj["apiVersion"] == nil || obj["kind"] == nil {
+			return errors.New(fmt.Sprintf("Invalid manifest. (document=%d, cause='apiVersion and kind are required')", count))
+		}
+	}
+	if count == 0 {
+		return errors.New("Manifest has no kubernetes objects")
+	}
+	return nil
+}
+
+/* parse kubectl apply outputs ("<resource>/<name> <action>" or errors) */
+func parseManifestResult(output string) []model.ManifestObject {
+
+	objects := []model.ManifestObject{}
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+		if match := manifestResultRegex.FindStringSubmatch(line); match != nil {
+			objects = append(objects, model.ManifestObject{Resource: match[1], Name: match[2], Action: match[3]})
+		} else if strings.HasPrefix(line, "Warning:") {
+			objects = append(objects, model.ManifestObject{Action: "warning", Message: line})
+		} else {
+			objects = append(objects, model.ManifestObject{Action: "failed", Message: line})
+		}
+	}
+	return objects
+}
@@ -0,0 +1,148 @@
+package provision
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	OIDC_CA_PATH            = "/etc/kubernetes/pki/oidc-ca.crt" // "/etc/kubernetes/pki" is mounted to an api-server pod
+	KUBE_APISERVER_MANIFEST = "/etc/kubernetes/manifests/kube-apiserver.yaml"
+)
+
+/* OIDC flags of an api-server */
+func oidcExtraArgs(oidc *app.ClusterConfigOidcReq) map[string]string {
+
+	args := map[string]string{}
+	if oidc == nil {
+		return args
+	}
+	args["oidc-issuer-url"] = oidc.IssuerUrl
+	args["oidc-client-id"] = oidc.ClientId
+	for key, value := range map[string]string{
+		"oidc-username-claim":  oidc.UsernameClaim,
+		"oidc-username-prefix": oidc.UsernamePrefix,
+		"oidc-groups-claim":    oidc.GroupsClaim,
+		"oidc-groups-prefix":   oidc.GroupsPrefix,
+	} {
+		if value != "" {
+			args[key] = value
+		}
+	}
+	if oidc.CA != "" {
+		args["oidc-ca-file"] = OIDC_CA_PATH
+	}
+	return args
+}
+
+/* copy an OIDC issuer CA certificate */
+func (self *Machine) copyOidcCA(ctx context.Context, ca string) error {
+	return self.copyRootFile(ctx, ca, OIDC_CA_PATH, "644")
+}
+
+/* update OIDC flags of api-servers (static pod manifests of control-plane nodes & a kubeadm-config), a nil oidc disables OIDC */
+func (self *Provisioner) UpdateOidc(ctx context.Context, oidc *app.ClusterConfigOidcReq) error {
+
+	args := oidcExtraArgs(oidc)
+
+	// kubeadm-config : used by a joining control-plane node
+	config, err := self.getKubeadmConfig(ctx)
+	if err != nil {
+		return err
+	}
+	config, err = editClusterConfiguration(config, func(clusterConfig yaml.MapSlice) yaml.MapSlice {
+		apiServer, _ := getMapSliceValue(clusterConfig, "apiServer").(yaml.MapSlice)
+		extraArgs, _ := getMapSliceValue(apiServer, "extraArgs").(yaml.MapSlice)
+		updated := yaml.MapSlice{}
+		for _, item := range extraArgs {
+			if key, _ := item.Key.(string); !strings.HasPrefix(key, "oidc-") {
+				updated = append(updated, item)
+			}
+		}
+		for _, key := range sortedKeys(args) {
+			updated = append(updated, yaml.MapItem{Key: key, Value: args[key]})
+		}
+		apiServer = setMapSliceValue(apiServer, "extraArgs", updated)
+		return setMapSliceValue(clusterConfig, "apiServer", apiServer)
+	})
+	if err != nil {
+		return err
+	}
+
+	// control-plane nodes : an OIDC CA & a static pod manifest of an api-server (one by one)
+	for _, machine := range self.ControlPlaneMachines {
+		if oidc != nil && oidc.CA != "" {
+			if err := machine.copyOidcCA(ctx, oidc.CA); err != nil {
+				return err
+			}
+		}
+		if err := machine.copyRootFile(ctx, config, KUBEADM_CONFIG_PATH, "600"); err != nil {
+			return err
+		}
+
+		manifest, err := machine.executeSSH(ctx, "sudo cat %s", KUBE_APISERVER_MANIFEST)
+		if err != nil {
+			return errors.New(fmt.Sprintf("Failed to get an api-server manifest. (node=%s, cause='%v')", machine.Name, err))
+		}
+		manifest, err = updateOidcFlags(manifest, args)
+		if err != nil {
+			return errors.New(fmt.Sprintf("%v (node=%s)", err, machine.Name))
+		}
+		// a backup must not be placed in a manifests directory (kubelet runs all manifests)
+		if _, err := machine.executeSSH(ctx, "sudo cp %s /etc/kubernetes/mcks/kube-apiserver.yaml.bak", KUBE_APISERVER_MANIFEST); err != nil {
+			return errors.New(fmt.Sprintf("Failed to backup an api-server manifest. (node=%s, cause='%v')", machine.Name, err))
+		}
+		if err := machine.copyRootFile(ctx, manifest, KUBE_APISERVER_MANIFEST, "600"); err != nil {
+			return err
+		}
+		if err := machine.waitForApiServer(ctx); err != nil {
+			return err
+		}
+	}
+
+	if _, err := self.leader.executeSSH(ctx, "sudo kubeadm init phase upload-config kubeadm --config %s", KUBEADM_CONFIG_PATH); err != nil {
+		return errors.New(fmt.Sprintf("Failed to upload a kubeadm-config. (cause='%v')", err))
+	}
+	return nil
+}
+
+/* replace "--oidc-*" flags of an api-server command in a static pod manifest */
+func updateOidcFlags(manifest string, args map[string]string) (string, error) {
+
+	lines := []string{}
+	found := false
+	for _, line := range strings.Split(manifest, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "- --oidc-") || strings.HasPrefix(trimmed, "- \"--oidc-") {
+			continue
+		}
+		lines = append(lines, line)
+		if trimmed == "- kube-apiserver" {
+			found = true
+			indent := line[:strings.Index(line, "-")]
+			for _, key := range sortedKeys(args) {
+				lines = append(lines, fmt.Sprintf("%s- %s", indent, quote(fmt.Sprintf("--%s=%s", key, args[key])))) // e.g. a prefix "oidc:"
+			}
+		}
+	}
+	if !found {
+		return "", errors.New("Could not be found a 'kube-apiserver' command in an api-server manifest")
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := []string{}
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
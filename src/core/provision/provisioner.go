@@ -6,7 +6,9 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/cloud-barista/cb-mcks/src/core/app"
 	"github.com/cloud-barista/cb-mcks/src/core/model"
@@ -14,6 +16,8 @@ import (
 	"github.com/cloud-barista/cb-mcks/src/utils/lang"
 
 	"golang.org/x/sync/errgroup"
+
+	logger "github.com/sirupsen/logrus"
 )
 
 /* new a instance of provider */
@@ -26,11 +30,20 @@ func NewProvisioner(cluster *model.Cluster) *Provisioner {
 	if cluster.CpLeader != "" {
 		for _, node := range cluster.Nodes {
 			if node.Name == cluster.CpLeader {
+				ssh := app.SSHReqDef(node.SSH)
 				provisioner.leader = &ControlPlaneMachine{Machine: &Machine{
-					Name:       node.Name,
-					PublicIP:   node.PublicIP,
-					Username:   tumblebug.VM_USER_ACCOUNT,
-					Credential: node.Credential,
+					Name:        node.Name,
+					Namespace:   cluster.Namespace,
+					ClusterName: cluster.Name,
+					PublicIP:    node.PublicIP,
+					PrivateIP:   node.PrivateIP,
+					Username:    lang.NVL(ssh.Username, *app.Config.SSHUsername),
+					Connection:  node.Connection,
+					Credential:  node.Credential,
+					HostKey:     node.HostKey,
+					Bastion:     provisioner.getBastion(node.Connection),
+					SSH:         ssh,
+					Executor:    provisioner.Executor,
 				}}
 			}
 		}
@@ -39,16 +52,22 @@ func NewProvisioner(cluster *model.Cluster) *Provisioner {
 }
 
 /* append a control-plane-machine */
-func (self *Provisioner) AppendControlPlaneMachine(name string, csp app.CSP, region string, zone string, credential string) {
+func (self *Provisioner) AppendControlPlaneMachine(name string, csp app.CSP, region string, zone string, credential string, labels map[string]string, taints []app.NodeTaintReq, ssh *app.SSHReq) {
 
 	machine := &ControlPlaneMachine{
 		Machine: &Machine{
-			Name:       name,
-			CSP:        csp,
-			Role:       app.CONTROL_PLANE,
-			Region:     region,
-			Zone:       zone,
-			Credential: credential,
+			Name:        name,
+			Namespace:   self.Cluster.Namespace,
+			ClusterName: self.Cluster.Name,
+			CSP:         csp,
+			Role:        app.CONTROL_PLANE,
+			Region:      region,
+			Zone:        zone,
+			Credential:  credential,
+			Labels:      labels,
+			Taints:      taints,
+			SSH:         app.SSHReqDef(ssh),
+			Executor:    self.Executor,
 		},
 	}
 	self.ControlPlaneMachines[name] = machine
@@ -59,19 +78,37 @@ func (self *Provisioner) AppendControlPlaneMachine(name string, csp app.CSP, reg
 }
 
 /* append a worker-node-machine */
-func (self *Provisioner) AppendWorkerNodeMachine(name string, csp app.CSP, region string, zone string, credential string) {
+func (self *Provisioner) AppendWorkerNodeMachine(name string, csp app.CSP, region string, zone string, credential string, labels map[string]string, taints []app.NodeTaintReq, ssh *app.SSHReq) {
 	self.WorkerNodeMachines[name] = &WorkerNodeMachine{
 		Machine: &Machine{
-			Name:       name,
-			CSP:        csp,
-			Role:       app.WORKER,
-			Region:     region,
-			Zone:       zone,
-			Credential: credential,
+			Name:        name,
+			Namespace:   self.Cluster.Namespace,
+			ClusterName: self.Cluster.Name,
+			CSP:         csp,
+			Role:        app.WORKER,
+			Region:      region,
+			Zone:        zone,
+			Credential:  credential,
+			Labels:      labels,
+			Taints:      taints,
+			SSH:         app.SSHReqDef(ssh),
+			Executor:    self.Executor,
 		},
 	}
 }
 
+/* set an executor of all machines (e.g. a fake executor) */
+func (self *Provisioner) SetExecutor(executor CommandExecutor) {
+
+	self.Executor = executor
+	if self.leader != nil {
+		self.leader.Executor = executor
+	}
+	for _, machine := range self.GetMachinesAll() {
+		machine.Executor = executor
+	}
+}
+
 /* set fileds each machines (public-ip, region, zone, spec, username) */
 func (self *Provisioner) BindVM(vms []tumblebug.VM) ([]*model.Node, error) {
 
@@ -85,13 +122,17 @@ func (self *Provisioner) BindVM(vms []tumblebug.VM) ([]*model.Node, error) {
 				status.Message = vm.SystemMessage
 			}
 			return nil, errors.New(fmt.Sprintf("Failed to create a vm (status=%s, cause='%s')", vm.Status, status.Message))
-		} else if vm.PublicIP == "" {
-			return nil, errors.New(fmt.Sprintf("Failed to create a vm (status=%s, cause='unbounded public-ip')", vm.Status))
+		}
+		bastion := self.getBastion(vm.Config)
+		if vm.PublicIP == "" && bastion == nil {
+			return nil, errors.New(fmt.Sprintf("Failed to create a vm (status=%s, cause='unbounded public-ip and no bastion of a connection %s')", vm.Status, vm.Config))
+		} else if vm.PrivateIP == "" && bastion != nil {
+			return nil, errors.New(fmt.Sprintf("Failed to create a vm (status=%s, cause='unbounded private-ip')", vm.Status))
 		}
 
 		var machine *Machine
 
-		if self.leader.Name == vm.Name {
+		if self.leader != nil && self.leader.Name == vm.Name {
 			machine = self.leader.Machine
 		} else {
 			_, exists := self.ControlPlaneMachines[vm.Name]
@@ -107,10 +148,17 @@ func (self *Provisioner) BindVM(vms []tumblebug.VM) ([]*model.Node, error) {
 		if machine != nil {
 			machine.PublicIP = vm.PublicIP
 			machine.PrivateIP = vm.PrivateIP
-			machine.Username = vm.UserAccount
+			machine.Username = lang.NVL(machine.SSH.Username, lang.NVL(vm.UserAccount, *app.Config.SSHUsername))
 			machine.Region = lang.NVL(vm.Region.Region, machine.Region) // region, zone 공백인 경우가 간혹 있음
 			machine.Zone = lang.NVL(vm.Region.Zone, machine.Zone)
 			machine.Spec = vm.CspViewVmDetail.VMSpecName
+			machine.Connection = vm.Config
+			machine.Bastion = bastion
+			machine.ProviderId = fmt.Sprintf("tumblebug://%s/%s/%s", self.Cluster.Namespace, self.Cluster.MCIS, lang.NVL(vm.Id, vm.Name))
+			machine.CspVMId = vm.CspViewVmDetail.IId.SystemId
+			if machine.HostKey == "" && vm.SSHHostKeyInfo.PublicKey != "" {
+				machine.HostKey = vmHostKey(vm)
+			}
 			nodes = append(nodes, machine.NewNode())
 		} else {
 			return nil, errors.New(fmt.Sprintf("Can't be found node by name '%s'", vm.Name))
@@ -120,19 +168,88 @@ func (self *Provisioner) BindVM(vms []tumblebug.VM) ([]*model.Node, error) {
 	return nodes, nil
 }
 
+/* get a bastion of a connection (nil : no bastion, bastions are cached by a provisioner) */
+func (self *Provisioner) getBastion(connection string) *model.Bastion {
+
+	if connection == "" {
+		return nil
+	}
+	if self.bastions == nil {
+		self.bastions = make(map[string]*model.Bastion)
+	}
+	if bastion, cached := self.bastions[connection]; cached {
+		return bastion
+	}
+	bastion := model.NewBastion(connection)
+	if exists, err := bastion.Select(); err != nil || !exists {
+		bastion = nil
+	}
+	self.bastions[connection] = bastion
+	return bastion
+}
+
+/* pin host keys of nodes to machines */
+func (self *Provisioner) PinHostKeys(nodes []*model.Node) {
+
+	for _, node := range nodes {
+		if machine := self.GetMachine(node.Name); machine != nil && node.HostKey != "" {
+			machine.HostKey = node.HostKey
+		}
+	}
+}
+
+/* record host keys of machines (pinned on the first contact) to nodes and save host keys of bastions */
+func (self *Provisioner) RecordHostKeys(nodes []*model.Node) {
+
+	for _, node := range nodes {
+		if machine := self.GetMachine(node.Name); machine != nil && machine.HostKey != "" {
+			node.HostKey = machine.HostKey
+		}
+	}
+	for _, bastion := range self.bastions {
+		if bastion != nil && bastion.HostKey != "" {
+			if err := bastion.PutStore(); err != nil {
+				logger.Warnf("[%s] Failed to save a host key of a bastion. (cause='%v')", bastion.Connection, err)
+			}
+		}
+	}
+}
+
+/* reset a pinned host key of a machine and pin a host key of a new contact (returns a new host key) */
+func (self *Provisioner) ResetHostKey(ctx context.Context, nodeName string) (string, error) {
+
+	machine := self.GetMachine(nodeName)
+	if machine == nil {
+		return "", errors.New(fmt.Sprintf("Can't be found node by name '%s'", nodeName))
+	}
+	machine.HostKey = ""
+	if err := machine.ConnectionTest(ctx); err != nil {
+		return "", err
+	}
+	return machine.HostKey, nil
+}
+
 /* bootstrap */
-func (self *Provisioner) Bootstrap() error {
+func (self *Provisioner) Bootstrap(ctx context.Context) error {
 
-	// bootstrap
-	eg, _ := errgroup.WithContext(context.Background())
+	networkCni, err := GetNetworkCni(self.Cluster)
+	if err != nil {
+		return err
+	}
+
+	// bootstrap (bounded concurrency, the others are cancelled if one of machines is failed)
+	eg, ctx := errgroup.WithContext(ctx)
+	limit := make(chan struct{}, concurrency())
 
 	for _, m := range self.GetMachinesAll() {
 		machine := m
 		eg.Go(func() error {
-			if err := machine.ConnectionTest(); err != nil {
+			limit <- struct{}{}
+			defer func() { <-limit }()
+			if err := machine.ConnectionTest(ctx); err != nil {
 				return err
 			}
-			if err := machine.bootstrap(self.Cluster.NetworkCni, self.Cluster.Version); err != nil {
+			if err := machine.bootstrap(ctx, networkCni, self.Cluster.Version); err != nil {
 				return err
 			}
 			return nil
@@ -146,16 +263,16 @@ func (self *Provisioner) Bootstrap() error {
 }
 
 /* setup haproxy */
-func (self *Provisioner) InstallHAProxy() error {
+func (self *Provisioner) InstallHAProxy(ctx context.Context) error {
 
 	var servers string
 	for _, machine := range self.ControlPlaneMachines {
 		servers += fmt.Sprintf("  server  %s  %s:6443  check\\n", machine.Name, machine.PrivateIP)
 	}
-	if output, err := self.leader.executeSSH("sudo sed 's/^{{SERVERS}}/%s/g' %s/%s", servers, REMOTE_TARGET_PATH, "haproxy.sh"); err != nil {
+	if output, err := self.leader.executeSSH(ctx, "sudo sed 's/^{{SERVERS}}/%s/g' %s/%s", servers, REMOTE_TARGET_PATH, "haproxy.sh"); err != nil {
 		return err
 	} else {
-		if _, err = self.leader.executeSSH(output); err != nil {
+		if _, err = self.leader.executeSSH(ctx, output); err != nil {
 			return err
 		}
 	}
@@ -164,11 +281,14 @@ func (self *Provisioner) InstallHAProxy() error {
 }
 
 // coantrol-plane init
-func (self *Provisioner) InitControlPlane(kubernetesConfigReq app.ClusterConfigKubernetesReq) ([]string, string, error) {
+func (self *Provisioner) InitControlPlane(ctx context.Context, kubernetesConfigReq app.ClusterConfigKubernetesReq) ([]string, string, error) {
 
 	var joinCmd []string
 
-	if output, err := self.leader.executeSSH("cd %s;./%s %s %s %s %s", REMOTE_TARGET_PATH, "k8s-init.sh", kubernetesConfigReq.PodCidr, kubernetesConfigReq.ServiceCidr, kubernetesConfigReq.ServiceDnsDomain, self.leader.PublicIP); err != nil {
+	if err := self.copyKubeadmConfig(ctx, kubernetesConfigReq); err != nil {
+		return nil, "", errors.New(fmt.Sprintf("Failed to copy a kubeadm-config. (cause='%v')", err))
+	}
+	if output, err := self.leader.executeSSH(ctx, "cd %s;./%s", REMOTE_TARGET_PATH, "k8s-init.sh"); err != nil {
 		return nil, "", errors.New("Failed to initialize control-plane. (k8s-init.sh)")
 	} else if strings.Contains(output, "Your Kubernetes control-plane has initialized successfully") {
 		joinCmd = getJoinCmd(output)
@@ -176,56 +296,157 @@ func (self *Provisioner) InitControlPlane(kubernetesConfigReq app.ClusterConfigK
 		return nil, "", errors.New("to initialize control-plane (the output not contains 'Your Kubernetes control-plane has initialized successfully')")
 	}
 
-	ouput, _ := self.leader.executeSSH("sudo cat /etc/kubernetes/admin.conf")
+	ouput, _ := self.leader.executeSSH(withSensitive(ctx), "sudo cat /etc/kubernetes/admin.conf")
 
 	return joinCmd, ouput, nil
 }
 
+/* control-plane join (one at a time in an order of node indexes : etcd members must be added one by one) */
+func (self *Provisioner) JoinControlPlanes(ctx context.Context, CPJoinCmd string) error {
+
+	machines := []*ControlPlaneMachine{}
+	for _, machine := range self.ControlPlaneMachines {
+		if self.leader == nil || machine.Name != self.leader.Name {
+			machines = append(machines, machine)
+		}
+	}
+	sort.Slice(machines, func(i, j int) bool {
+		return lang.GetNodeNameIndex(machines[i].Name) < lang.GetNodeNameIndex(machines[j].Name)
+	})
+	for _, machine := range machines {
+		if err := machine.JoinControlPlane(ctx, &CPJoinCmd); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/* worker-node join in parallel (bounded concurrency, a failed join is reset & retried) and returns failed nodes (node name : cause) */
+func (self *Provisioner) JoinWorkers(ctx context.Context, workerJoinCmd string) map[string]error {
+
+	failed := map[string]error{}
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	limit := make(chan struct{}, concurrency())
+
+	for _, m := range self.WorkerNodeMachines {
+		machine := m
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			limit <- struct{}{}
+			defer func() { <-limit }()
+
+			err := machine.JoinWorker(ctx, &workerJoinCmd)
+			for i := 0; err != nil && i < *app.Config.JoinRetries; i++ {
+				logger.Warnf("[%s] Retry to join a worker-node. (retry=%d, cause='%v')", machine.Name, i+1, err)
+				if _, e := machine.executeSSH(ctx, "sudo kubeadm reset -f"); e != nil {
+					logger.Warnf("[%s] Failed to reset a node. (cause='%v')", machine.Name, e)
+				}
+				err = machine.JoinWorker(ctx, &workerJoinCmd)
+			}
+			if err != nil {
+				mutex.Lock()
+				failed[machine.Name] = err
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	return failed
+}
+
+/* remove a node which is failed to join (a VM is deleted and a machine is excluded from a provisioner) */
+func (self *Provisioner) RemoveFailedNode(ctx context.Context, nodeName string) error {
+
+	if _, err := self.Kubectl(ctx, "delete node %s --ignore-not-found", nodeName); err != nil {
+		logger.Warnf("[%s] Failed to delete a node. (cause='%v')", nodeName, err)
+	}
+	vm := tumblebug.NewVM(self.Cluster.Namespace, nodeName, self.Cluster.MCIS)
+	if _, err := vm.DELETE(); err != nil {
+		return errors.New(fmt.Sprintf("Failed to remove a VM (vm=%s, cause='%v')", vm.Name, err))
+	}
+	delete(self.WorkerNodeMachines, nodeName)
+
+	return nil
+}
+
 /* install network-cni */
-func (self *Provisioner) InstallNetworkCni() error {
+func (self *Provisioner) InstallNetworkCni(ctx context.Context) error {
 
-	cniYamls := []string{}
-	if self.Cluster.NetworkCni == app.NETWORKCNI_CANAL {
-		cniYamls = append(cniYamls, CNI_CANAL_FILE)
-	} else {
-		cniYamls = append(cniYamls, CNI_KILO_FLANNEL_FILE)
-		cniYamls = append(cniYamls, CNI_KILO_CRDS_FILE)
-		cniYamls = append(cniYamls, CNI_KILO_KUBEADM_FILE)
+	networkCni, err := GetNetworkCni(self.Cluster)
+	if err != nil {
+		return err
 	}
 
-	for _, file := range cniYamls {
-		if _, err := self.Kubectl("apply -f %s/%s", REMOTE_TARGET_PATH, file); err != nil {
+	for _, manifest := range networkCni.Manifests() {
+		if !isRemoteManifest(manifest) {
+			manifest = fmt.Sprintf("%s/%s", REMOTE_TARGET_PATH, manifest)
+		}
+		if _, err := self.Kubectl(ctx, "apply -f %s", manifest); err != nil {
 			return err
 		}
 	}
 
-	return nil
+	return networkCni.PostInstall(ctx, self)
 }
 
 /* assign node labels */
-func (self *Provisioner) AssignNodeLabelAnnotation() error {
+func (self *Provisioner) AssignNodeLabelAnnotation(ctx context.Context) error {
+
+	networkCni, err := GetNetworkCni(self.Cluster)
+	if err != nil {
+		return err
+	}
 
 	// commons labels
 	for _, machine := range self.GetMachinesAll() {
-		if _, err := self.Kubectl("label nodes %s %s=%s", machine.Name, app.LABEL_KEY_CSP, machine.CSP); err != nil {
+		if _, err := self.Kubectl(ctx, "label nodes %s %s=%s", machine.Name, app.LABEL_KEY_CSP, machine.CSP); err != nil {
 			return err
 		}
-		if _, err := self.Kubectl("label nodes %s %s=%s", machine.Name, app.LABEL_KEY_REGION, machine.Region); err != nil {
+		if _, err := self.Kubectl(ctx, "label nodes %s %s=%s", machine.Name, app.LABEL_KEY_REGION, machine.Region); err != nil {
 			return err
 		}
-		if _, err := self.Kubectl("label nodes %s %s=%s", machine.Name, app.LABEL_KEY_ZONE, machine.Zone); err != nil {
+		if _, err := self.Kubectl(ctx, "label nodes %s %s=%s", machine.Name, app.LABEL_KEY_ZONE, machine.Zone); err != nil {
 			return err
 		}
 	}
 
-	// network-cni annotations
-	if self.Cluster.NetworkCni == app.NETWORKCNI_KILO {
-		for _, machine := range self.GetMachinesAll() {
-			// use a full mesh network
-			if _, err := self.Kubectl("annotate nodes %s kilo.squat.ai/location=%s", machine.Name, machine.Name); err != nil {
+	// cloud metadata labels & annotations (instance-type, connection, a MCIS vm & a CSP vm)
+	for _, machine := range self.GetMachinesAll() {
+		labels := map[string]string{app.LABEL_KEY_INSTANCE_TYPE: labelValue(machine.Spec), app.LABEL_KEY_CONNECTION: labelValue(machine.Connection)}
+		for key, value := range labels {
+			if value == "" {
+				continue
+			}
+			if _, err := self.Kubectl(ctx, "label nodes %s %s=%s --overwrite", machine.Name, key, value); err != nil {
+				return err
+			}
+		}
+		annotations := map[string]string{app.ANNOTATION_KEY_PROVIDER_ID: machine.ProviderId, app.ANNOTATION_KEY_CSP_VM_ID: machine.CspVMId}
+		for key, value := range annotations {
+			if value == "" {
+				continue
+			}
+			if _, err := self.Kubectl(ctx, "annotate nodes %s %s='%s' --overwrite", machine.Name, key, value); err != nil {
 				return err
 			}
-			if _, err := self.Kubectl("annotate nodes %s kilo.squat.ai/persistent-keepalive=25", machine.Name); err != nil {
+		}
+	}
+
+	// user labels & taints (node-set)
+	for _, machine := range self.GetMachinesAll() {
+		if err := self.UpdateNodeLabelsTaints(ctx, machine.Name, nil, nil, machine.Labels, machine.Taints); err != nil {
+			return err
+		}
+	}
+
+	// network-cni annotations
+	for _, machine := range self.GetMachinesAll() {
+		for key, value := range networkCni.NodeAnnotations(machine) {
+			if _, err := self.Kubectl(ctx, "annotate nodes %s %s=%s --overwrite", machine.Name, key, value); err != nil {
 				return err
 			}
 		}
@@ -234,10 +455,63 @@ func (self *Provisioner) AssignNodeLabelAnnotation() error {
 	return nil
 }
 
+/* update user labels & taints of a node (old labels & taints which are not included in new ones are removed) */
+func (self *Provisioner) UpdateNodeLabelsTaints(ctx context.Context, nodeName string, oldLabels map[string]string, oldTaints []app.NodeTaintReq, labels map[string]string, taints []app.NodeTaintReq) error {
+
+	// labels
+	args := []string{}
+	for key := range oldLabels {
+		if _, exists := labels[key]; !exists {
+			args = append(args, key+"-")
+		}
+	}
+	for key, value := range labels {
+		args = append(args, fmt.Sprintf("%s=%s", key, value))
+	}
+	if len(args) > 0 {
+		sort.Strings(args)
+		if _, err := self.Kubectl(ctx, "label nodes %s %s --overwrite", nodeName, strings.Join(args, " ")); err != nil {
+			return err
+		}
+	}
+
+	// taints (identified by a key & an effect)
+	args = []string{}
+	for _, old := range oldTaints {
+		exists := false
+		for _, taint := range taints {
+			exists = exists || (taint.Key == old.Key && taint.Effect == old.Effect)
+		}
+		if !exists {
+			args = append(args, fmt.Sprintf("%s:%s-", old.Key, old.Effect))
+		}
+	}
+	if len(args) > 0 {
+		if _, err := self.Kubectl(ctx, "taint nodes %s %s", nodeName, strings.Join(args, " ")); err != nil {
+			return err
+		}
+	}
+	args = []string{}
+	for _, taint := range taints {
+		if taint.Value == "" {
+			args = append(args, fmt.Sprintf("%s:%s", taint.Key, taint.Effect))
+		} else {
+			args = append(args, fmt.Sprintf("%s=%s:%s", taint.Key, taint.Value, taint.Effect))
+		}
+	}
+	if len(args) > 0 {
+		if _, err := self.Kubectl(ctx, "taint nodes %s %s --overwrite", nodeName, strings.Join(args, " ")); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 /* new generate worker-node join command */
-func (self *Provisioner) NewWorkerJoinCommand() (string, error) {
+func (self *Provisioner) NewWorkerJoinCommand(ctx context.Context) (string, error) {
 
-	if joinCommand, err := self.leader.executeSSH("sudo kubeadm token create --print-join-command"); err != nil {
+	if joinCommand, err := self.leader.executeSSH(ctx, "sudo kubeadm token create --print-join-command"); err != nil {
 		return "", err
 	} else if joinCommand == "" {
 		return "", errors.New("join command is empty")
@@ -247,11 +521,11 @@ func (self *Provisioner) NewWorkerJoinCommand() (string, error) {
 }
 
 /* execute kubectl */
-func (self *Provisioner) Kubectl(format string, a ...interface{}) (string, error) {
+func (self *Provisioner) Kubectl(ctx context.Context, format string, a ...interface{}) (string, error) {
 
 	command := fmt.Sprintf(format, a...)
 	command = fmt.Sprintf("sudo kubectl %s --kubeconfig=/etc/kubernetes/admin.conf", command)
-	if output, err := self.leader.executeSSH(command); err != nil {
+	if output, err := self.leader.executeSSH(ctx, command); err != nil {
 		return "", errors.New(fmt.Sprintf("Failed to kubectl. (command='%s')", command))
 	} else {
 		return output, nil
@@ -272,13 +546,27 @@ func (self *Provisioner) GetMachinesAll() []*Machine {
 	return machines
 }
 
+/* get a machine by a name (nil if not exists) */
+func (self *Provisioner) GetMachine(name string) *Machine {
+
+	if self.leader != nil && self.leader.Name == name {
+		return self.leader.Machine
+	}
+	for _, machine := range self.GetMachinesAll() {
+		if machine.Name == name {
+			return machine
+		}
+	}
+	return nil
+}
+
 /* drain a node + delete node + delete a VM */
-func (self *Provisioner) DrainAndDeleteNode(nodeName string) error {
+func (self *Provisioner) DrainAndDeleteNode(ctx context.Context, nodeName string) error {
 
-	if output, err := self.Kubectl("drain %s --ignore-daemonsets --force --delete-local-data", nodeName); err != nil {
+	if output, err := self.Kubectl(ctx, "drain %s --ignore-daemonsets --force --delete-local-data", nodeName); err != nil {
 		return errors.New(fmt.Sprintf("Failed to drain a node (node=%s, output='%s')", nodeName, output))
 	}
-	if output, err := self.Kubectl("delete node %s", nodeName); err != nil {
+	if output, err := self.Kubectl(ctx, "delete node %s", nodeName); err != nil {
 		return errors.New(fmt.Sprintf("Failed to delete a node (node=%s, output='%s')", nodeName, output))
 	}
 	vm := tumblebug.NewVM(self.Cluster.Namespace, nodeName, self.Cluster.MCIS)
@@ -309,3 +597,30 @@ func getJoinCmd(cpInitResult string) []string {
 
 	return []string{fmt.Sprintf("%s %s %s", join1, join2, join3), fmt.Sprintf("%s %s", join1, join2)}
 }
+
+/* a concurrency limit of provisioning nodes (bootstrap, worker-node join) */
+func concurrency() int {
+	if *app.Config.ProvisionConcurrency < 1 {
+		return 1
+	}
+	return *app.Config.ProvisionConcurrency
+}
+
+/* a host key of a VM metadata in an authorized-keys format */
+func vmHostKey(vm tumblebug.VM) string {
+	if strings.Contains(vm.SSHHostKeyInfo.PublicKey, " ") {
+		return strings.TrimSpace(vm.SSHHostKeyInfo.PublicKey)
+	}
+	return fmt.Sprintf("%s %s", vm.SSHHostKeyInfo.HostKeyAlgorithm, vm.SSHHostKeyInfo.PublicKey)
+}
+
+var invalidLabelValueRegex = regexp.MustCompile(`[^A-Za-z0-9_.\-]`)
+
+/* a valid label value (invalid characters are replaced with '-', max 63 characters) */
+func labelValue(value string) string {
+	value = invalidLabelValueRegex.ReplaceAllString(value, "-")
+	if len(value) > 63 {
+		value = value[:63]
+	}
+	return strings.Trim(value, "-_.")
+}
@@ -0,0 +1,537 @@
+package provision
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"github.com/cloud-barista/cb-mcks/src/core/tumblebug"
+	"golang.org/x/crypto/ssh"
+	"gopkg.in/yaml.v2"
+)
+
+func TestMain(m *testing.M) {
+	if os.Getenv("APP_ROOT") == "" {
+		root, _ := filepath.Abs("../../..")
+		os.Setenv("APP_ROOT", root)
+	}
+	app.Setup()
+	os.Exit(m.Run())
+}
+
+/* a provisioner of a control-plane & a worker bound to fake VMs */
+func newTestProvisioner(t *testing.T, executor CommandExecutor) *Provisioner {
+
+	cluster := model.NewCluster("namespace-1", "cluster-1")
+	cluster.MCIS = "cluster-1"
+	cluster.Version = "1.23.14-00"
+	cluster.NetworkCni = app.NETWORKCNI_CANAL
+
+	provisioner := NewProvisioner(cluster)
+	provisioner.SetExecutor(executor)
+	provisioner.AppendControlPlaneMachine("c-1-abcde", app.CSP_AWS, "ap-northeast-2", "ap-northeast-2a", "private-key", nil, nil, nil)
+	provisioner.AppendWorkerNodeMachine("w-1-abcde", app.CSP_AWS, "ap-northeast-2", "ap-northeast-2a", "private-key", map[string]string{"env": "dev"}, nil, nil)
+	cluster.CpLeader = "c-1-abcde"
+
+	vms := []tumblebug.VM{}
+	for i, name := range []string{"c-1-abcde", "w-1-abcde"} {
+		vm := tumblebug.NewVM("namespace-1", name, "cluster-1")
+		vm.PublicIP = []string{"10.0.0.1", "10.0.0.2"}[i]
+		vm.PrivateIP = []string{"192.168.0.1", "192.168.0.2"}[i]
+		vm.UserAccount = tumblebug.VM_USER_ACCOUNT
+		vm.Status = tumblebug.VMSTATUS_RUNNING
+		vms = append(vms, *vm)
+	}
+	if _, err := provisioner.BindVM(vms); err != nil {
+		t.Fatalf("BindVM error (cause=%v)", err)
+	}
+	return provisioner
+}
+
+func TestProvisionerBootstrapInitJoin(t *testing.T) {
+
+	executor := NewFakeExecutor()
+	provisioner := newTestProvisioner(t, executor)
+
+	// bootstrap
+	if err := provisioner.Bootstrap(context.Background()); err != nil {
+		t.Fatalf("Bootstrap error (cause=%v)", err)
+	}
+	copies := map[string][]string{}
+	for _, c := range executor.Copies {
+		copies[c.Node] = append(copies[c.Node], filepath.Base(c.Destination))
+	}
+	if !strings.Contains(strings.Join(copies["c-1-abcde"], ","), "k8s-init.sh") {
+		t.Fatalf("k8s-init.sh is not copied to a control-plane (copies=%v)", copies["c-1-abcde"])
+	}
+	if strings.Join(copies["w-1-abcde"], ",") != "bootstrap.sh" {
+		t.Fatalf("Only bootstrap.sh should be copied to a worker (copies=%v)", copies["w-1-abcde"])
+	}
+	if commands := executor.Find("bootstrap.sh 1.23.14-00 aws w-1-abcde 10.0.0.2 canal"); len(commands) != 1 {
+		t.Fatalf("bootstrap.sh is not executed on a worker (commands=%v)", executor.CommandsOf("w-1-abcde"))
+	}
+
+	// control-plane init
+	if err := provisioner.InstallHAProxy(context.Background()); err != nil {
+		t.Fatalf("InstallHAProxy error (cause=%v)", err)
+	}
+	joinCmds, kubeconfig, err := provisioner.InitControlPlane(context.Background(), app.ClusterConfigKubernetesReq{NetworkCni: app.NETWORKCNI_CANAL, PodCidr: app.POD_CIDR, ServiceCidr: app.SERVICE_CIDR, ServiceDnsDomain: app.SERVICE_DOMAIN})
+	if err != nil {
+		t.Fatalf("InitControlPlane error (cause=%v)", err)
+	}
+	if kubeconfig != FAKE_KUBECONFIG {
+		t.Fatalf("Unexpected kubeconfig (%s)", kubeconfig)
+	}
+	if len(joinCmds) != 2 || !strings.Contains(joinCmds[0], FAKE_CERTIFICATE_KEY) || !strings.Contains(joinCmds[1], FAKE_JOIN_TOKEN) || strings.Contains(joinCmds[1], "--control-plane") {
+		t.Fatalf("Unexpected join commands (%v)", joinCmds)
+	}
+	for _, c := range executor.Copies {
+		if c.Destination == REMOTE_TARGET_PATH+"/"+KUBEADM_CONFIG_FILE && !strings.Contains(c.Content, app.POD_CIDR) {
+			t.Fatalf("A kubeadm-config does not contain a pod cidr (config=%s)", c.Content)
+		}
+	}
+
+	// worker join
+	for _, machine := range provisioner.WorkerNodeMachines {
+		if err := machine.JoinWorker(context.Background(), &joinCmds[1]); err != nil {
+			t.Fatalf("JoinWorker error (cause=%v)", err)
+		}
+	}
+	if commands := executor.Find("kubeadm join"); len(commands) != 1 || commands[0].Node != "w-1-abcde" {
+		t.Fatalf("Unexpected join commands (commands=%v)", commands)
+	}
+
+	// node labels
+	if err := provisioner.AssignNodeLabelAnnotation(context.Background()); err != nil {
+		t.Fatalf("AssignNodeLabelAnnotation error (cause=%v)", err)
+	}
+	if commands := executor.Find("label nodes w-1-abcde env=dev"); len(commands) != 1 || commands[0].Node != "c-1-abcde" {
+		t.Fatalf("A user label is not assigned via a leader (commands=%v)", commands)
+	}
+}
+
+func TestProvisionerInitControlPlaneFailed(t *testing.T) {
+
+	executor := NewFakeExecutor()
+	executor.On("k8s-init.sh").Return("[ERROR FileAvailable--etc-kubernetes-manifests-kube-apiserver.yaml]")
+	provisioner := newTestProvisioner(t, executor)
+
+	if _, _, err := provisioner.InitControlPlane(context.Background(), app.ClusterConfigKubernetesReq{NetworkCni: app.NETWORKCNI_CANAL}); err == nil {
+		t.Fatalf("InitControlPlane should be failed")
+	}
+}
+
+func TestJoinWorkerFailed(t *testing.T) {
+
+	executor := NewFakeExecutor()
+	executor.On("kubeadm join").Node("w-1-abcde").Fail(errors.New("Process exited with status 1"))
+	provisioner := newTestProvisioner(t, executor)
+
+	joinCmd := "kubeadm join 127.0.0.1:9998 --token " + FAKE_JOIN_TOKEN
+	if err := provisioner.WorkerNodeMachines["w-1-abcde"].JoinWorker(context.Background(), &joinCmd); err == nil {
+		t.Fatalf("JoinWorker should be failed")
+	}
+	if commands := executor.Find("systemctl restart mcks-bootstrap"); len(commands) != 0 {
+		t.Fatalf("mcks-bootstrap should not be restarted (commands=%v)", commands)
+	}
+}
+
+func TestNewWorkerJoinCommand(t *testing.T) {
+
+	cluster := model.NewCluster("namespace-1", "cluster-1")
+	cluster.CpLeader = "c-1-abcde"
+	cluster.Nodes = []*model.Node{{Model: model.Model{Name: "c-1-abcde"}, PublicIP: "10.0.0.1", Role: app.CONTROL_PLANE}}
+
+	executor := NewFakeExecutor()
+	provisioner := NewProvisioner(cluster)
+	provisioner.SetExecutor(executor)
+
+	if joinCmd, err := provisioner.NewWorkerJoinCommand(context.Background()); err != nil {
+		t.Fatalf("NewWorkerJoinCommand error (cause=%v)", err)
+	} else if !strings.Contains(joinCmd, FAKE_JOIN_TOKEN) {
+		t.Fatalf("Unexpected join command (%s)", joinCmd)
+	}
+	if commands := executor.CommandsOf("c-1-abcde"); len(commands) != 1 {
+		t.Fatalf("Unexpected commands on a leader (commands=%v)", commands)
+	}
+}
+
+/* a new host key in an authorized-keys format */
+func newTestHostKey(t *testing.T) string {
+
+	public, _, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("Failed to generate a key (cause=%v)", err)
+	}
+	key, err := ssh.NewPublicKey(public)
+	if err != nil {
+		t.Fatalf("Failed to generate a key (cause=%v)", err)
+	}
+	return marshalHostKey(key)
+}
+
+func TestHostKeyVerification(t *testing.T) {
+
+	executor := NewFakeExecutor()
+	provisioner := newTestProvisioner(t, executor)
+	hostKey := newTestHostKey(t)
+	executor.HostKeys["w-1-abcde"] = hostKey
+
+	// trust-on-first-use
+	worker := provisioner.WorkerNodeMachines["w-1-abcde"]
+	if _, err := worker.executeSSH(context.Background(), "/bin/hostname"); err != nil {
+		t.Fatalf("executeSSH error (cause=%v)", err)
+	}
+	nodes := []*model.Node{{Model: model.Model{Name: "w-1-abcde"}}}
+	provisioner.RecordHostKeys(nodes)
+	if nodes[0].HostKey != hostKey {
+		t.Fatalf("A host key is not pinned (pinned=%s)", nodes[0].HostKey)
+	}
+
+	// a changed host key (man-in-the-middle)
+	executor.HostKeys["w-1-abcde"] = newTestHostKey(t)
+	if _, err := worker.executeSSH(context.Background(), "/bin/hostname"); err == nil || !strings.Contains(err.Error(), "Host key verification failed") {
+		t.Fatalf("executeSSH should be failed (cause=%v)", err)
+	}
+	if err := worker.copyContent(context.Background(), "content", "/tmp/content"); err == nil {
+		t.Fatalf("executeSCP should be failed")
+	}
+
+	// reset
+	if newHostKey, err := provisioner.ResetHostKey(context.Background(), "w-1-abcde"); err != nil {
+		t.Fatalf("ResetHostKey error (cause=%v)", err)
+	} else if newHostKey != executor.HostKeys["w-1-abcde"] {
+		t.Fatalf("A new host key is not pinned (pinned=%s)", newHostKey)
+	}
+}
+
+func TestHostKeyFromVM(t *testing.T) {
+
+	hostKey := newTestHostKey(t)
+	fields := strings.Fields(hostKey)
+
+	provisioner := NewProvisioner(model.NewCluster("namespace-1", "cluster-1"))
+	provisioner.AppendControlPlaneMachine("c-1-abcde", app.CSP_AWS, "ap-northeast-2", "ap-northeast-2a", "private-key", nil, nil, nil)
+	vm := tumblebug.NewVM("namespace-1", "c-1-abcde", "cluster-1")
+	vm.PublicIP = "10.0.0.1"
+	vm.SSHHostKeyInfo.HostKeyAlgorithm = fields[0]
+	vm.SSHHostKeyInfo.PublicKey = fields[1]
+
+	if nodes, err := provisioner.BindVM([]tumblebug.VM{*vm}); err != nil {
+		t.Fatalf("BindVM error (cause=%v)", err)
+	} else if nodes[0].HostKey != hostKey {
+		t.Fatalf("A host key of a VM is not pinned (pinned=%s)", nodes[0].HostKey)
+	}
+}
+
+func TestBastionPrivateNodes(t *testing.T) {
+
+	bastion := model.NewBastion("config-private")
+	bastion.Host = "3.35.10.21"
+	bastion.Username = "cb-user"
+	bastion.PrivateKey = "private-key"
+	if err := bastion.PutStore(); err != nil {
+		t.Fatalf("Bastion PutStore error (cause=%v)", err)
+	}
+	defer bastion.Delete()
+
+	executor := NewFakeExecutor()
+	cluster := model.NewCluster("namespace-1", "cluster-1")
+	cluster.Version = "1.23.14-00"
+	cluster.NetworkCni = app.NETWORKCNI_CANAL
+	provisioner := NewProvisioner(cluster)
+	provisioner.SetExecutor(executor)
+	provisioner.AppendControlPlaneMachine("c-1-abcde", app.CSP_AWS, "ap-northeast-2", "ap-northeast-2a", "private-key", nil, nil, nil)
+	provisioner.AppendWorkerNodeMachine("w-1-abcde", app.CSP_AWS, "ap-northeast-2", "ap-northeast-2a", "private-key", nil, nil, nil)
+	cluster.CpLeader = "c-1-abcde"
+
+	// a vm without a public-ip is rejected without a bastion
+	vm := tumblebug.NewVM("namespace-1", "w-1-abcde", "cluster-1")
+	vm.Config = "config-public"
+	vm.PrivateIP = "192.168.0.2"
+	vm.Status = tumblebug.VMSTATUS_RUNNING
+	if _, err := provisioner.BindVM([]tumblebug.VM{*vm}); err == nil {
+		t.Fatalf("A vm without a public-ip should be rejected without a bastion")
+	}
+
+	vms := []tumblebug.VM{}
+	for i, name := range []string{"c-1-abcde", "w-1-abcde"} {
+		vm := tumblebug.NewVM("namespace-1", name, "cluster-1")
+		vm.Config = "config-private"
+		vm.PrivateIP = []string{"192.168.0.1", "192.168.0.2"}[i]
+		vm.UserAccount = tumblebug.VM_USER_ACCOUNT
+		vm.Status = tumblebug.VMSTATUS_RUNNING
+		vms = append(vms, *vm)
+	}
+	nodes, err := provisioner.BindVM(vms)
+	if err != nil {
+		t.Fatalf("BindVM error (cause=%v)", err)
+	}
+	if nodes[1].PrivateIP != "192.168.0.2" || nodes[1].Connection != "config-private" {
+		t.Fatalf("Unexpected node (privateIp=%s, connection=%s)", nodes[1].PrivateIP, nodes[1].Connection)
+	}
+	if machine := provisioner.GetMachine("w-1-abcde"); machine.Bastion == nil || machine.address() != "192.168.0.2:22" {
+		t.Fatalf("A machine should be reached via a bastion (address=%s)", machine.address())
+	}
+
+	// private-ips are used as node-ips and an advertise-address
+	if err := provisioner.Bootstrap(context.Background()); err != nil {
+		t.Fatalf("Bootstrap error (cause=%v)", err)
+	}
+	if commands := executor.Find("bootstrap.sh 1.23.14-00 aws w-1-abcde 192.168.0.2 canal private"); len(commands) != 1 {
+		t.Fatalf("bootstrap.sh is not executed with a private-ip (commands=%v)", executor.CommandsOf("w-1-abcde"))
+	}
+	if _, _, err := provisioner.InitControlPlane(context.Background(), app.ClusterConfigKubernetesReq{NetworkCni: app.NETWORKCNI_CANAL, PodCidr: app.POD_CIDR, ServiceCidr: app.SERVICE_CIDR, ServiceDnsDomain: app.SERVICE_DOMAIN}); err != nil {
+		t.Fatalf("InitControlPlane error (cause=%v)", err)
+	}
+	for _, c := range executor.Copies {
+		if c.Destination == REMOTE_TARGET_PATH+"/"+KUBEADM_CONFIG_FILE && !strings.Contains(c.Content, `"advertise-address": "192.168.0.1"`) {
+			t.Fatalf("A kubeadm-config does not advertise a private-ip (config=%s)", c.Content)
+		}
+	}
+}
+
+func TestSSHSettings(t *testing.T) {
+
+	executor := NewFakeExecutor()
+	cluster := model.NewCluster("namespace-1", "cluster-1")
+	provisioner := NewProvisioner(cluster)
+	provisioner.SetExecutor(executor)
+	provisioner.AppendWorkerNodeMachine("w-1-abcde", app.CSP_AWS, "ap-northeast-2", "ap-northeast-2a", "private-key", nil, nil, &app.SSHReq{Port: 2222, Username: "ubuntu", DialTimeout: 3, Retries: 3, RetryInterval: 1})
+	provisioner.AppendWorkerNodeMachine("w-2-abcde", app.CSP_AWS, "ap-northeast-2", "ap-northeast-2a", "private-key", nil, nil, nil)
+
+	vms := []tumblebug.VM{}
+	for i, name := range []string{"w-1-abcde", "w-2-abcde"} {
+		vm := tumblebug.NewVM("namespace-1", name, "cluster-1")
+		vm.PublicIP = []string{"10.0.0.1", "10.0.0.2"}[i]
+		vm.Status = tumblebug.VMSTATUS_RUNNING
+		vm.UserAccount = "tb-user" // a node-set username is prior to a VM user account
+		vms = append(vms, *vm)
+	}
+	nodes, err := provisioner.BindVM(vms)
+	if err != nil {
+		t.Fatalf("BindVM error (cause=%v)", err)
+	}
+
+	// node-set settings & global settings (a username of a VM user account)
+	if machine := provisioner.GetMachine("w-1-abcde"); machine.Username != "ubuntu" || machine.address() != "10.0.0.1:2222" {
+		t.Fatalf("Unexpected ssh settings (username=%s, address=%s)", machine.Username, machine.address())
+	}
+	if machine := provisioner.GetMachine("w-2-abcde"); machine.Username != "tb-user" || machine.address() != fmt.Sprintf("10.0.0.2:%d", *app.Config.SSHPort) {
+		t.Fatalf("Unexpected ssh settings (username=%s, address=%s)", machine.Username, machine.address())
+	}
+	if nodes[0].SSH == nil || nodes[0].SSH.Port != 2222 || nodes[0].SSH.MaxRetryInterval != *app.Config.SSHMaxRetryInterval || nodes[1].SSH.Username != "tb-user" {
+		t.Fatalf("SSH settings are not recorded to a node (ssh=%v)", nodes[0].SSH)
+	}
+
+	// retries with a dial timeout
+	executor.Unreachable["w-1-abcde"] = true
+	if err := provisioner.GetMachine("w-1-abcde").ConnectionTest(context.Background()); err == nil {
+		t.Fatalf("ConnectionTest should be failed")
+	}
+	if len(executor.Dials) != 3 || executor.Dials[0].Timeout != 3*time.Second || executor.Dials[0].Address != "10.0.0.1:2222" {
+		t.Fatalf("Unexpected dials (dials=%v)", executor.Dials)
+	}
+}
+
+func TestJoinNodes(t *testing.T) {
+
+	executor := NewFakeExecutor()
+	provisioner := newTestProvisioner(t, executor)
+	provisioner.AppendControlPlaneMachine("c-3-abcde", app.CSP_AWS, "ap-northeast-2", "ap-northeast-2a", "private-key", nil, nil, nil)
+	provisioner.AppendControlPlaneMachine("c-2-abcde", app.CSP_AWS, "ap-northeast-2", "ap-northeast-2a", "private-key", nil, nil, nil)
+	provisioner.AppendWorkerNodeMachine("w-2-abcde", app.CSP_AWS, "ap-northeast-2", "ap-northeast-2a", "private-key", nil, nil, nil)
+	provisioner.AppendWorkerNodeMachine("w-3-abcde", app.CSP_AWS, "ap-northeast-2", "ap-northeast-2a", "private-key", nil, nil, nil)
+	for _, machine := range provisioner.GetMachinesAll() {
+		machine.PublicIP = "10.0.0.1"
+	}
+
+	// control-planes join in an order of node indexes
+	if err := provisioner.JoinControlPlanes(context.Background(), "kubeadm join --control-plane"); err != nil {
+		t.Fatalf("JoinControlPlanes error (cause=%v)", err)
+	}
+	if commands := executor.Find("--control-plane"); len(commands) != 2 || commands[0].Node != "c-2-abcde" || commands[1].Node != "c-3-abcde" {
+		t.Fatalf("Unexpected control-plane joins (commands=%v)", commands)
+	}
+
+	// a failed join is retried once, a worker failed on a retry is returned
+	executor.On("kubeadm join").Node("w-2-").Fail(errors.New("Process exited with status 1")).Times(1)
+	executor.On("kubeadm join").Node("w-3-").Fail(errors.New("Process exited with status 1"))
+	failed := provisioner.JoinWorkers(context.Background(), "kubeadm join --token "+FAKE_JOIN_TOKEN)
+	if len(failed) != 1 || failed["w-3-abcde"] == nil {
+		t.Fatalf("Unexpected failed workers (failed=%v)", failed)
+	}
+	if commands := executor.Find("kubeadm reset -f"); len(commands) != 2 {
+		t.Fatalf("Failed workers should be reset before a retry (commands=%v)", commands)
+	}
+	if commands := executor.Find("--token " + FAKE_JOIN_TOKEN); len(commands) != 5 {
+		t.Fatalf("Unexpected worker joins (commands=%v)", commands)
+	}
+}
+
+func TestNodeLogs(t *testing.T) {
+
+	executor := NewFakeExecutor()
+	provisioner := newTestProvisioner(t, executor)
+	for _, name := range []string{"c-1-abcde", "w-1-abcde"} {
+		if err := model.NewNodeLogList("namespace-1", "cluster-1", name).Delete(); err != nil {
+			t.Fatalf("NodeLogList delete error (cause=%v)", err)
+		}
+	}
+
+	// outputs & errors are recorded per a node & a step (join secrets are masked)
+	executor.On("kubeadm join").Node("w-1-").Fail(errors.New("Process exited with status 1")).Times(1)
+	if failed := provisioner.JoinWorkers(WithStep(context.Background(), app.STEP_JOIN), "kubeadm join --token "+FAKE_JOIN_TOKEN); len(failed) != 0 {
+		t.Fatalf("Unexpected failed workers (failed=%v)", failed)
+	}
+	log := model.NewNodeLog("namespace-1", "cluster-1", "w-1-abcde", app.STEP_JOIN)
+	if exists, err := log.Select(); err != nil || !exists {
+		t.Fatalf("A join log should be recorded (exists=%v, cause=%v)", exists, err)
+	}
+	if !strings.Contains(log.Output, "[error] Process exited with status 1") || !strings.Contains(log.Output, "This node has joined the cluster") {
+		t.Fatalf("Unexpected join log (output=%s)", log.Output)
+	}
+	if strings.Contains(log.Output, FAKE_JOIN_TOKEN) || !strings.Contains(log.Output, "--token ******") {
+		t.Fatalf("A join token should be masked (output=%s)", log.Output)
+	}
+
+	// commands out of steps
+	if _, err := provisioner.Kubectl(context.Background(), "get nodes"); err != nil {
+		t.Fatalf("Kubectl error (cause=%v)", err)
+	}
+	logs := model.NewNodeLogList("namespace-1", "cluster-1", "c-1-abcde")
+	if err := logs.SelectList(); err != nil || len(logs.Items) != 1 || logs.Items[0].Step != app.STEP_OTHER {
+		t.Fatalf("Unexpected logs of a leader (logs=%v, cause=%v)", logs.Items, err)
+	}
+
+	// older outputs are truncated over a maximum size
+	maxSize := *app.Config.NodeLogMaxSize
+	*app.Config.NodeLogMaxSize = 256
+	defer func() { *app.Config.NodeLogMaxSize = maxSize }()
+	machine := provisioner.WorkerNodeMachines["w-1-abcde"]
+	for i := 0; i < 10; i++ {
+		machine.executeSSH(WithStep(context.Background(), app.STEP_BOOTSTRAP), "echo %d", i)
+	}
+	log = model.NewNodeLog("namespace-1", "cluster-1", "w-1-abcde", app.STEP_BOOTSTRAP)
+	if _, err := log.Select(); err != nil {
+		t.Fatalf("NodeLog select error (cause=%v)", err)
+	}
+	if !log.Truncated || log.Size > 256 || log.Size != len(log.Output) || !strings.Contains(log.Output, "$ echo 9") || strings.Contains(log.Output, "$ echo 0") {
+		t.Fatalf("Unexpected truncated log (size=%d, output=%s)", log.Size, log.Output)
+	}
+}
+
+func TestKiloPortAndKeepalive(t *testing.T) {
+
+	executor := NewFakeExecutor()
+	provisioner := newTestProvisioner(t, executor)
+	port, keepalive := 51821, 0
+	provisioner.Cluster.NetworkCni = app.NETWORKCNI_KILO
+	provisioner.Cluster.Kilo = &app.ClusterConfigKiloReq{Port: &port, PersistentKeepalive: &keepalive}
+
+	// a configured port is passed to mcks-bootstrap
+	if err := provisioner.Bootstrap(context.Background()); err != nil {
+		t.Fatalf("Bootstrap error (cause=%v)", err)
+	}
+	if commands := executor.Find("bootstrap.sh 1.23.14-00 aws w-1-abcde 10.0.0.2 kilo public 'kilo.squat.ai/force-endpoint=${PUBLIC_IP}:51821'"); len(commands) != 1 {
+		t.Fatalf("bootstrap.sh is not executed with a kilo port (commands=%v)", executor.CommandsOf("w-1-abcde"))
+	}
+
+	// keepalive 0 is off (not a default)
+	annotations := newKilo(provisioner.Cluster.Kilo).NodeAnnotations(provisioner.WorkerNodeMachines["w-1-abcde"].Machine)
+	if _, exists := annotations["kilo.squat.ai/persistent-keepalive"]; exists {
+		t.Fatalf("A persistent-keepalive should be off (annotations=%v)", annotations)
+	}
+	if annotations = newKilo(nil).NodeAnnotations(provisioner.WorkerNodeMachines["w-1-abcde"].Machine); annotations["kilo.squat.ai/persistent-keepalive"] != "25" {
+		t.Fatalf("Unexpected a default persistent-keepalive (annotations=%v)", annotations)
+	}
+}
+
+func TestUpdateEndpoint(t *testing.T) {
+
+	executor := NewFakeExecutor()
+	provisioner := newTestProvisioner(t, executor)
+	executor.On("sudo cat " + KUBEADM_CONFIG_PATH).Return("apiVersion: kubeadm.k8s.io/v1beta3\nkind: ClusterConfiguration\ncontrolPlaneEndpoint: 10.0.0.9:9998\n")
+	executor.On("healthz").Return("ok")
+
+	if _, err := provisioner.UpdateEndpoint(context.Background(), "api.example.com", nil); err != nil {
+		t.Fatalf("UpdateEndpoint error (cause=%v)", err)
+	}
+
+	// an api-server manifest is regenerated on a control-plane
+	if commands := executor.Find("kubeadm init phase control-plane apiserver --config"); len(commands) != 1 || commands[0].Node != "c-1-abcde" {
+		t.Fatalf("An api-server manifest is not regenerated (commands=%v)", commands)
+	}
+	if copies := executor.Copies; len(copies) == 0 || !strings.Contains(copies[0].Content, "advertise-address: 10.0.0.1") || !strings.Contains(copies[0].Content, "api.example.com") {
+		t.Fatalf("Unexpected a kubeadm-config (copies=%v)", copies)
+	}
+
+	// node-ips (kubelet & mcks-bootstrap) are updated and a kubelet is restarted on all nodes
+	for name, ip := range map[string]string{"c-1-abcde": "10.0.0.1", "w-1-abcde": "10.0.0.2"} {
+		commands := strings.Join(executor.CommandsOf(name), "\n")
+		if !strings.Contains(commands, "-node-ip="+ip+"#' /etc/default/kubelet") || !strings.Contains(commands, `PUBLIC_IP="`+ip+`"#' /lib/systemd/system/mcks-bootstrap`) || !strings.Contains(commands, "systemctl restart mcks-bootstrap") {
+			t.Fatalf("A node-ip is not updated (node=%s, commands=%v)", name, commands)
+		}
+	}
+}
+
+func TestSensitiveNodeLogs(t *testing.T) {
+
+	executor := NewFakeExecutor()
+	provisioner := newTestProvisioner(t, executor)
+	if err := model.NewNodeLogList("namespace-1", "cluster-1", "c-1-abcde").Delete(); err != nil {
+		t.Fatalf("NodeLogList delete error (cause=%v)", err)
+	}
+	secret, clientKey := "c2VjcmV0LWVuY3J5cHRpb24ta2V5LTAxMjM0NTY3ODk=", "FAKE-CLIENT-KEY-DATA"
+	executor.On("cat " + ENCRYPTION_CONFIG_PATH).Return("apiVersion: apiserver.config.k8s.io/v1\nkind: EncryptionConfiguration\nresources:\n- resources: [secrets]\n  providers:\n  - aescbc:\n      keys:\n      - name: key-1\n        secret: " + secret + "\n  - identity: {}\n")
+	executor.On("cat /etc/kubernetes/admin.conf").Return(FAKE_KUBECONFIG + "users:\n- name: kubernetes-admin\n  user:\n    client-key-data: " + clientKey + "\n")
+	executor.On("sudo cat " + KUBEADM_CONFIG_PATH).Return("apiVersion: kubeadm.k8s.io/v1beta3\nkind: ClusterConfiguration\ncontrolPlaneEndpoint: 10.0.0.9:9998\n")
+
+	// an encryption key & an admin kubeconfig are not recorded (only commands)
+	if err := provisioner.RotateEncryptionKey(context.Background()); err != nil {
+		t.Fatalf("RotateEncryptionKey error (cause=%v)", err)
+	}
+	if kubeconfig, err := provisioner.UpdateEndpoint(context.Background(), "api.example.com", nil); err != nil || !strings.Contains(kubeconfig, clientKey) {
+		t.Fatalf("UpdateEndpoint error (cause=%v)", err)
+	}
+	log := model.NewNodeLog("namespace-1", "cluster-1", "c-1-abcde", app.STEP_OTHER)
+	if exists, err := log.Select(); err != nil || !exists {
+		t.Fatalf("A log should be recorded (exists=%v, cause=%v)", exists, err)
+	}
+	if strings.Contains(log.Output, secret) || strings.Contains(log.Output, clientKey) || strings.Contains(log.Output, "controlPlaneEndpoint") {
+		t.Fatalf("Secrets should not be recorded (output=%s)", log.Output)
+	}
+	if !strings.Contains(log.Output, "$ sudo cat "+ENCRYPTION_CONFIG_PATH) || !strings.Contains(log.Output, "$ sudo cat /etc/kubernetes/admin.conf") {
+		t.Fatalf("Sensitive commands should be recorded (output=%s)", log.Output)
+	}
+}
+
+func TestKubeadmConfigQuotedKeys(t *testing.T) {
+
+	// keys of extra-args are quoted (a key can not inject yaml even if not validated)
+	req := app.ClusterConfigKubernetesReq{ApiServer: &app.ClusterConfigComponentReq{ExtraArgs: map[string]string{"v: 1\n  anonymous-auth": "true"}}}
+	config, err := renderKubeadmConfig(req, "1.23.14-00", "10.0.0.1")
+	if err != nil {
+		t.Fatalf("renderKubeadmConfig error (cause=%v)", err)
+	}
+	cluster := struct {
+		ApiServer struct {
+			ExtraArgs map[string]string `yaml:"extraArgs"`
+		} `yaml:"apiServer"`
+	}{}
+	if err := yaml.Unmarshal([]byte(strings.Split(config, "\n---")[0]), &cluster); err != nil {
+		t.Fatalf("A kubeadm-config is invalid (cause=%v, config=%s)", err, config)
+	}
+	if _, exists := cluster.ApiServer.ExtraArgs["anonymous-auth"]; exists || cluster.ApiServer.ExtraArgs["v: 1\n  anonymous-auth"] != "true" {
+		t.Fatalf("A key of extra-args is not quoted (extraArgs=%v)", cluster.ApiServer.ExtraArgs)
+	}
+}
@@ -6,24 +6,54 @@ import (
 )
 
 const (
-	REMOTE_TARGET_PATH    = "/tmp"
-	CNI_CANAL_FILE        = "addons/canal/canal_v3.20.0.yaml"
-	CNI_KILO_CRDS_FILE    = "addons/kilo/crds_v0.3.0.yaml"
-	CNI_KILO_KUBEADM_FILE = "addons/kilo/kilo-kubeadm-flannel_v0.3.0.yaml"
-	CNI_KILO_FLANNEL_FILE = "addons/kilo/kube-flannel_v0.14.0.yaml"
+	REMOTE_TARGET_PATH        = "/tmp"
+	CNI_CANAL_FILE            = "addons/canal/canal_v3.20.0.yaml"
+	CNI_KILO_CRDS_FILE        = "addons/kilo/crds_v0.3.0.yaml"
+	CNI_KILO_KUBEADM_FILE     = "addons/kilo/kilo-kubeadm-flannel_v0.3.0.yaml"
+	CNI_KILO_FLANNEL_FILE     = "addons/kilo/kube-flannel_v0.14.0.yaml"
+	CNI_CALICO_VXLAN_URL      = "https://docs.projectcalico.org/archive/v3.20/manifests/calico-vxlan.yaml"
+	CNI_CALICO_WIREGUARD_FILE = "addons/calico/felix-wireguard_v3.20.yaml"
+	CNI_CILIUM_URL            = "https://raw.githubusercontent.com/cilium/cilium/v1.10.5/install/kubernetes/quick-install.yaml"
+	CNI_FLANNEL_FILE          = "addons/flannel/kube-flannel_v0.14.0.yaml"
+	ADDON_CATALOG_FILE        = "addons/catalog.yaml"
+	KUBEADM_CONFIG_FILE       = "kubeadm-config.yaml"
+	KUBEADM_CONFIG_PATH       = "/etc/kubernetes/mcks/kubeadm-config.yaml"
+
+	CONTROL_PLANE_ENDPOINT_PORT = 9998
+
+	CSI_AWS_EBS_REPO             = "https://kubernetes-sigs.github.io/aws-ebs-csi-driver"
+	CSI_AWS_EBS_VERSION          = "2.6.4"
+	CSI_GCP_PD_KUSTOMIZE_URL     = "github.com/kubernetes-sigs/gcp-compute-persistent-disk-csi-driver/deploy/kubernetes/overlays/stable-master?ref=v1.3.4"
+	CSI_AZURE_DISK_REPO          = "https://raw.githubusercontent.com/kubernetes-sigs/azuredisk-csi-driver/master/charts"
+	CSI_AZURE_DISK_VERSION       = "v1.16.0"
+	CSI_OPENSTACK_CINDER_REPO    = "https://kubernetes.github.io/cloud-provider-openstack"
+	CSI_OPENSTACK_CINDER_VERSION = "2.3.0"
+
+	exitCodePrefix = "mcks-exit-code="
 )
 
 type Machine struct {
-	Name       string
-	PublicIP   string
-	PrivateIP  string
-	Username   string
-	CSP        app.CSP
-	Role       app.ROLE
-	Region     string
-	Zone       string
-	Spec       string
-	Credential string
+	Name        string
+	Namespace   string // a namespace & a cluster of a machine (remote outputs are recorded to node logs if exists)
+	ClusterName string
+	PublicIP    string
+	PrivateIP   string
+	Username    string
+	CSP         app.CSP
+	Role        app.ROLE
+	Region      string
+	Zone        string
+	Spec        string
+	Connection  string
+	ProviderId  string
+	CspVMId     string
+	Credential  string
+	Labels      map[string]string
+	Taints      []app.NodeTaintReq
+	HostKey     string          // a pinned host key (authorized-keys format, empty : trust-on-first-use)
+	Bastion     *model.Bastion  // a jump-host of a connection (nil : direct ssh to a public-ip)
+	SSH         app.SSHReq      // ssh settings (port, dial-timeout, retries, keepalive)
+	Executor    CommandExecutor // nil : DefaultExecutor
 }
 type ControlPlaneMachine struct {
 	*Machine
@@ -37,4 +67,6 @@ type Provisioner struct {
 	leader               *ControlPlaneMachine
 	ControlPlaneMachines map[string]*ControlPlaneMachine
 	WorkerNodeMachines   map[string]*WorkerNodeMachine
+	Executor             CommandExecutor // an executor injected into machines (nil : DefaultExecutor)
+	bastions             map[string]*model.Bastion
 }
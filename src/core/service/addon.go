@@ -0,0 +1,162 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"github.com/cloud-barista/cb-mcks/src/core/provision"
+	"github.com/cloud-barista/cb-mcks/src/utils/lang"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+/* get add-ons */
+func ListAddon(namespace string, clusterName string) (*model.AddonList, error) {
+
+	// validate namespace
+	if err := verifyNamespace(namespace); err != nil {
+		return nil, err
+	}
+
+	addonList := model.NewAddonList(namespace, clusterName)
+
+	cluster := model.NewCluster(namespace, clusterName)
+	if exists, err := cluster.Select(); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New(fmt.Sprintf("Could not be found a cluster '%s' (namespace=%s)", clusterName, namespace))
+	} else if cluster.Addons != nil {
+		addonList.Items = cluster.Addons
+	}
+	return addonList, nil
+}
+
+/* install an add-on */
+func InstallAddon(ctx context.Context, namespace string, clusterName string, req *app.AddonReq) (*model.Addon, error) {
+
+	// validate namespace
+	if err := verifyNamespace(namespace); err != nil {
+		return nil, err
+	}
+
+	// get a cluster-entity
+	cluster := model.NewCluster(namespace, clusterName)
+	if exists, err := cluster.Select(); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New(fmt.Sprintf("Could not be found a cluster '%s'. (namespace=%s)", clusterName, namespace))
+	} else if cluster.Status.Phase != model.ClusterPhaseProvisioned {
+		return nil, errors.New(fmt.Sprintf("Unable to install an add-on. status is '%s'.", cluster.Status.Phase))
+	}
+
+	// find an add-on in catalog
+	catalog, err := provision.GetAddonCatalog()
+	if err != nil {
+		return nil, err
+	}
+	addonVersion, err := catalog.Find(req.Name, req.Version, cluster.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	// install
+	provisioner := provision.NewProvisioner(cluster)
+	addon := installAddon(ctx, provisioner, req.Name, addonVersion)
+	if err := cluster.PutAddon(addon); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to update a cluster-entity. (cause='%v')", err))
+	}
+	if addon.Phase == model.AddonPhaseFailed {
+		return nil, errors.New(addon.Message)
+	}
+
+	return addon, nil
+}
+
+/* uninstall an add-on */
+func UninstallAddon(ctx context.Context, namespace string, clusterName string, addonName string) (*app.Status, error) {
+
+	// validate namespace
+	if err := verifyNamespace(namespace); err != nil {
+		return nil, err
+	}
+
+	// get a cluster-entity
+	cluster := model.NewCluster(namespace, clusterName)
+	if exists, err := cluster.Select(); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New(fmt.Sprintf("Could not be found a cluster '%s'. (namespace=%s)", clusterName, namespace))
+	} else if cluster.Status.Phase != model.ClusterPhaseProvisioned {
+		return nil, errors.New(fmt.Sprintf("Unable to uninstall an add-on. status is '%s'.", cluster.Status.Phase))
+	}
+
+	addon := cluster.GetAddon(addonName)
+	if addon == nil {
+		return app.NewStatus(app.STATUS_NOTFOUND, fmt.Sprintf("Could not be found an add-on '%s'", addonName)), nil
+	}
+
+	// find an installed version in catalog
+	catalog, err := provision.GetAddonCatalog()
+	if err != nil {
+		return nil, err
+	}
+	addonVersion, err := catalog.Find(addon.Name, addon.Version, cluster.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	// uninstall
+	provisioner := provision.NewProvisioner(cluster)
+	if err := provisioner.UninstallAddon(ctx, addonVersion); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to uninstall an add-on '%s'. (cause='%v')", addonName, err))
+	}
+	if err := cluster.DeleteAddon(addonName); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to update a cluster-entity. (cause='%v')", err))
+	}
+
+	logger.Infof("[%s.%s] Add-on uninstallation has been completed. (addon=%s)", namespace, clusterName, addonName)
+	return app.NewStatus(app.STATUS_SUCCESS, fmt.Sprintf("Add-on '%s' has been uninstalled", addonName)), nil
+}
+
+/* find add-ons in catalog (validation) */
+func findAddons(reqs []app.AddonReq, k8sVersion string) (map[string]*provision.AddonCatalogVersion, error) {
+
+	addons := map[string]*provision.AddonCatalogVersion{}
+	if len(reqs) == 0 {
+		return addons, nil
+	}
+	catalog, err := provision.GetAddonCatalog()
+	if err != nil {
+		return nil, err
+	}
+	for _, req := range reqs {
+		if _, exists := addons[req.Name]; exists {
+			return nil, errors.New(fmt.Sprintf("Add-on '%s' is duplicated", req.Name))
+		}
+		if addonVersion, err := catalog.Find(req.Name, req.Version, k8sVersion); err != nil {
+			return nil, err
+		} else {
+			addons[req.Name] = addonVersion
+		}
+	}
+	return addons, nil
+}
+
+/* install an add-on and returns a add-on entity with a result */
+func installAddon(ctx context.Context, provisioner *provision.Provisioner, name string, addonVersion *provision.AddonCatalogVersion) *model.Addon {
+
+	addon := model.NewAddon(name, addonVersion.Version)
+	if err := provisioner.InstallAddon(ctx, addonVersion); err != nil {
+		addon.Phase = model.AddonPhaseFailed
+		addon.Message = fmt.Sprintf("Failed to install an add-on '%s'. (version=%s, cause='%v')", name, addonVersion.Version, err)
+		logger.Warnf("[%s.%s] %s", provisioner.Cluster.Namespace, provisioner.Cluster.Name, addon.Message)
+	} else {
+		addon.Phase = model.AddonPhaseInstalled
+		addon.InstalledTime = lang.GetNowUTC()
+		logger.Infof("[%s.%s] Add-on installation has been completed. (addon=%s, version=%s)", provisioner.Cluster.Namespace, provisioner.Cluster.Name, name, addonVersion.Version)
+	}
+	return addon
+}
@@ -0,0 +1,73 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"github.com/cloud-barista/cb-mcks/src/core/tumblebug"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+/* get a bastion of a connection (a private-key is not returned) */
+func GetBastion(connection string) (*model.Bastion, error) {
+
+	bastion := model.NewBastion(connection)
+	if exists, err := bastion.Select(); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New(fmt.Sprintf("Could not be found a bastion of a connection '%s'", connection))
+	}
+	bastion.PrivateKey = ""
+
+	return bastion, nil
+}
+
+/* create or update a bastion of a connection (ssh of nodes in the connection tunnels through the bastion) */
+func PutBastion(connection string, req *app.BastionReq) (*model.Bastion, error) {
+
+	if exists, err := tumblebug.NewConnection(connection).GET(); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New(fmt.Sprintf("Could not be found a connection '%s'", connection))
+	}
+
+	bastion := model.NewBastion(connection)
+	if _, err := bastion.Select(); err != nil {
+		return nil, err
+	}
+	// a pinned host key is kept only if a bastion host is not changed
+	if req.HostKey != "" || bastion.Host != req.Host || bastion.Port != req.Port {
+		bastion.HostKey = req.HostKey
+	}
+	bastion.Host = req.Host
+	bastion.Port = req.Port
+	bastion.Username = req.Username
+	bastion.PrivateKey = req.PrivateKey
+	if err := bastion.PutStore(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to save a bastion-entity. (cause='%v')", err))
+	}
+	logger.Infof("[%s] Bastion has been saved. (bastion=%s)", connection, bastion.Address())
+
+	bastion.PrivateKey = ""
+	return bastion, nil
+}
+
+/* delete a bastion of a connection */
+func DeleteBastion(connection string) (*app.Status, error) {
+
+	bastion := model.NewBastion(connection)
+	if exists, err := bastion.Select(); err != nil {
+		return nil, err
+	} else if !exists {
+		return app.NewStatus(app.STATUS_NOTFOUND, fmt.Sprintf("Could not be found a bastion of a connection '%s'", connection)), nil
+	}
+	if err := bastion.Delete(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to delete a bastion-entity. (cause='%v')", err))
+	}
+
+	logger.Infof("[%s] Bastion has been deleted.", connection)
+	return app.NewStatus(app.STATUS_SUCCESS, fmt.Sprintf("Bastion of a connection '%s' has been deleted", connection)), nil
+}
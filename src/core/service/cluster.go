@@ -1,8 +1,10 @@
 package service
 
 import (
+	"context"
 	"errors"
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
@@ -47,21 +49,30 @@ func GetCluster(namespace string, clusterName string) (*model.Cluster, error) {
 		return nil, errors.New(fmt.Sprintf("Could not be found a cluster '%s' (namespace=%s)", clusterName, namespace))
 	}
 
+	// current estimated cost
+	if len(cluster.Nodes) > 0 {
+		if cost, err := estimateNodes(cluster.Nodes); err != nil {
+			logger.Warnf("[%s.%s] Failed to estimate a cost. (cause='%v')", namespace, clusterName, err)
+		} else {
+			cluster.Cost = cost
+		}
+	}
+
 	return cluster, nil
 }
 
-/* create a cluster */
-func CreateCluster(namespace string, minorversion string, patchversion string, req *app.ClusterReq) (*model.Cluster, error) {
+/* verify a cluster request (a namespace, a kubernetes version, node counts & addons) and returns a kubernetes version */
+func verifyClusterReq(namespace string, minorversion string, patchversion string, req *app.ClusterReq) (string, map[string]*provision.AddonCatalogVersion, error) {
 
 	// validate a namespace
 	if err := verifyNamespace(namespace); err != nil {
-		return nil, err
+		return "", nil, err
 	}
 	if minorversion == "" {
 		minorversion = "1.18"
 	}
 	if !strings.Contains(minorversion, "1.18") && !strings.Contains(minorversion, "1.23") {
-		return nil, errors.New("Supported Kubernetes version is 1.18 or 1.23")
+		return "", nil, errors.New("Supported Kubernetes version is 1.18 or 1.23")
 	}
 	if patchversion == "" {
 		patchversion = "1"
@@ -70,18 +81,51 @@ func CreateCluster(namespace string, minorversion string, patchversion string, r
 
 	// validate prameters
 	if req.ControlPlane[0].Count < 1 {
-		return nil, errors.New("Control-Plane count must be at least one.")
+		return "", nil, errors.New("Control-Plane count must be at least one.")
 	}
 	if len(req.Worker) < 1 {
-		return nil, errors.New("Worker must be at least one.")
+		return "", nil, errors.New("Worker must be at least one.")
 	} else {
 		for _, worker := range req.Worker {
 			if worker.Count < 1 {
-				return nil, errors.New(fmt.Sprintf("Worker count must be at least one. (connection=%s)", worker.Connection))
+				return "", nil, errors.New(fmt.Sprintf("Worker count must be at least one. (connection=%s)", worker.Connection))
 			}
 		}
 	}
 
+	addons, err := findAddons(req.Addons, k8sVersion)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return k8sVersion, addons, nil
+}
+
+/* set cluster paramaters of a request */
+func setClusterParams(cluster *model.Cluster, k8sVersion string, req *app.ClusterReq) {
+	cluster.Version = k8sVersion
+	cluster.NetworkCni = req.Config.Kubernetes.NetworkCni
+	cluster.Kilo = req.Config.Kubernetes.Kilo
+	cluster.Endpoint = req.Config.Kubernetes.Endpoint
+	cluster.CertSANs = req.Config.Kubernetes.CertSANs
+	cluster.Oidc = req.Config.Kubernetes.Oidc
+	cluster.Audit = req.Config.Kubernetes.Audit
+	cluster.Encryption = req.Config.Kubernetes.Encryption
+	cluster.Label = req.Label
+	cluster.InstallMonAgent = req.InstallMonAgent
+	cluster.Description = req.Description
+}
+
+/* create a cluster */
+func CreateCluster(ctx context.Context, namespace string, minorversion string, patchversion string, req *app.ClusterReq) (*model.Cluster, error) {
+
+	ctx = detachContext(ctx) // provisioning is bounded by step timeouts only (not by a disconnected client)
+
+	k8sVersion, addons, err := verifyClusterReq(namespace, minorversion, patchversion, req)
+	if err != nil {
+		return nil, err
+	}
+
 	clusterName := req.Name
 	mcisName := clusterName
 
@@ -104,11 +148,7 @@ func CreateCluster(namespace string, minorversion string, patchversion string, r
 	logger.Infof("[%s.%s] Validation & clean-up has been completed.", namespace, clusterName)
 
 	// set cluster paramaters
-	cluster.Version = k8sVersion
-	cluster.NetworkCni = req.Config.Kubernetes.NetworkCni
-	cluster.Label = req.Label
-	cluster.InstallMonAgent = req.InstallMonAgent
-	cluster.Description = req.Description
+	setClusterParams(cluster, k8sVersion, req)
 	provisioner := provision.NewProvisioner(cluster)
 
 	//update phase(provisioning)
@@ -129,7 +169,9 @@ func CreateCluster(namespace string, minorversion string, patchversion string, r
 	logger.Infof("[%s.%s] MCIS validation has been completed. (mcis=%s)", namespace, clusterName, mcisName)
 
 	// create a MCIR - "vpc, f/w, sshkey, image, spec" - with vlidations
-	mcir := NewMCIR(namespace, app.CONTROL_PLANE, req.ControlPlane[0])
+	mcirs := []*MCIR{}
+	mcir := NewMCIR(namespace, app.CONTROL_PLANE, req.ControlPlane[0], cluster)
+	mcirs = append(mcirs, mcir)
 	reason, msg := mcir.CreateIfNotExist()
 	if reason != "" {
 		cluster.FailReason(reason, msg)
@@ -142,14 +184,15 @@ func CreateCluster(namespace string, minorversion string, patchversion string, r
 				cluster.CpLeader = name
 			}
 			mcis.VMs = append(mcis.VMs, mcir.NewVM(namespace, name, mcisName))
-			provisioner.AppendControlPlaneMachine(name, mcir.csp, mcir.region, mcir.zone, mcir.credential)
+			provisioner.AppendControlPlaneMachine(name, mcir.csp, mcir.region, mcir.zone, mcir.credential, mcir.labels, mcir.taints, mcir.ssh)
 		}
 	}
 	logger.Infof("[%s.%s] MCIR(control-plane) creation has been completed.", namespace, clusterName)
 
 	idx := 0
 	for _, worker := range req.Worker {
-		mcir := NewMCIR(namespace, app.WORKER, worker)
+		mcir := NewMCIR(namespace, app.WORKER, worker, cluster)
+		mcirs = append(mcirs, mcir)
 		reason, msg := mcir.CreateIfNotExist()
 		if reason != "" {
 			cluster.FailReason(reason, msg)
@@ -159,13 +202,19 @@ func CreateCluster(namespace string, minorversion string, patchversion string, r
 			for i := 0; i < mcir.vmCount; i++ {
 				name := lang.GenerateNewNodeName(string(app.WORKER), idx+1)
 				mcis.VMs = append(mcis.VMs, mcir.NewVM(namespace, name, mcisName))
-				provisioner.AppendWorkerNodeMachine(name, mcir.csp, mcir.region, mcir.zone, mcir.credential)
+				provisioner.AppendWorkerNodeMachine(name, mcir.csp, mcir.region, mcir.zone, mcir.credential, mcir.labels, mcir.taints, mcir.ssh)
 				idx = idx + 1
 			}
 		}
 	}
 	logger.Infof("[%s.%s] MCIR(worker nodes) creation has been completed.", namespace, clusterName)
 
+	// verify csi flags of node-sets
+	if err := verifyCsi(cluster, mcirs); err != nil {
+		cluster.FailReason(model.InvalidMCIRReason, err.Error())
+		return nil, err
+	}
+
 	// create a MCIS (contains vm)
 	mcis.Label = app.MCIS_LABEL
 	mcis.InstallMonAgent = cluster.InstallMonAgent
@@ -194,15 +243,19 @@ func CreateCluster(namespace string, minorversion string, patchversion string, r
 
 	// kubernetes provisioning : bootstrap
 	time.Sleep(2 * time.Second)
-	if err := provisioner.Bootstrap(); err != nil {
-		cluster.FailReason(model.SetupBoostrapFailedReason, fmt.Sprintf("Bootstrap failed. (cause='%v')", err))
+	bootstrapCtx, cancel := stepContext(ctx, app.STEP_BOOTSTRAP, *app.Config.BootstrapTimeout)
+	err = provisioner.Bootstrap(bootstrapCtx)
+	cancel()
+	if err != nil {
+		cluster.FailReason(stepFailure(bootstrapCtx, app.STEP_BOOTSTRAP, *app.Config.BootstrapTimeout, model.SetupBoostrapFailedReason, fmt.Sprintf("Bootstrap failed. (cause='%v')", err)))
 		cleanUpCluster(*cluster, mcis)
 		return nil, errors.New(cluster.Status.Message)
 	}
+	provisioner.RecordHostKeys(cluster.Nodes)
 	logger.Infof("[%s.%s] Bootstrap has been completed.", namespace, clusterName)
 
 	// kubernetes provisioning : haproxy
-	if err := provisioner.InstallHAProxy(); err != nil {
+	if err := provisioner.InstallHAProxy(provision.WithStep(ctx, app.STEP_HAPROXY)); err != nil {
 		cluster.FailReason(model.SetupHaproxyFailedReason, fmt.Sprintf("Failed to install haproxy. (cause='%v')", err))
 		cleanUpCluster(*cluster, mcis)
 		return nil, errors.New(cluster.Status.Message)
@@ -211,57 +264,77 @@ func CreateCluster(namespace string, minorversion string, patchversion string, r
 
 	// kubernetes provisioning :control-plane init
 	var joinCmds []string
-	joinCmds, kubeconfig, err := provisioner.InitControlPlane(req.Config.Kubernetes)
+	initCtx, cancel := stepContext(ctx, app.STEP_INIT, *app.Config.InitTimeout)
+	joinCmds, kubeconfig, err := provisioner.InitControlPlane(initCtx, req.Config.Kubernetes)
+	cancel()
 	if err != nil {
-		cluster.FailReason(model.InitControlPlaneFailedReason, fmt.Sprintf("Fail to initialize Control-plane. (cause='%v')", err))
+		cluster.FailReason(stepFailure(initCtx, app.STEP_INIT, *app.Config.InitTimeout, model.InitControlPlaneFailedReason, fmt.Sprintf("Fail to initialize Control-plane. (cause='%v')", err)))
 		cleanUpCluster(*cluster, mcis)
 		return nil, errors.New(cluster.Status.Message)
 	}
 	cluster.ClusterConfig = kubeconfig
 	logger.Infof("[%s.%s] Control-Plane initialize has been completed.", namespace, clusterName)
 
-	// kubernetes provisioning : control-plane join
-	for _, machine := range provisioner.ControlPlaneMachines {
-		if provisioner.Cluster.CpLeader != machine.Name {
-			if err := machine.JoinControlPlane(&joinCmds[0]); err != nil {
-				cluster.FailReason(model.JoinControlPlaneFailedReason, fmt.Sprintf("Fail to control-plane join. (node=%s)", machine.Name))
-				cleanUpCluster(*cluster, mcis)
-				return nil, errors.New(cluster.Status.Message)
-			}
-		}
+	// kubernetes provisioning : control-plane join (one by one)
+	joinCtx, cancel := stepContext(ctx, app.STEP_JOIN, *app.Config.JoinTimeout)
+	err = provisioner.JoinControlPlanes(joinCtx, joinCmds[0])
+	cancel()
+	if err != nil {
+		cluster.FailReason(stepFailure(joinCtx, app.STEP_JOIN, *app.Config.JoinTimeout, model.JoinControlPlaneFailedReason, fmt.Sprintf("Fail to control-plane join. (cause='%v')", err)))
+		cleanUpCluster(*cluster, mcis)
+		return nil, errors.New(cluster.Status.Message)
 	}
 	logger.Infof("[%s.%s] Control-Plane join has been completed.", namespace, clusterName)
 
-	// kubernetes provisioning : worker node join
-	for _, machine := range provisioner.WorkerNodeMachines {
-		if err := machine.JoinWorker(&joinCmds[1]); err != nil {
-			cluster.FailReason(model.JoinWorkerFailedReason, fmt.Sprintf("Fail to worker-node join. (node=%s)", machine.Name))
-			cleanUpCluster(*cluster, mcis)
-			return nil, errors.New(cluster.Status.Message)
-		}
+	// kubernetes provisioning : worker node join (in parallel, failed workers are removed if any worker has joined)
+	joinCtx, cancel = stepContext(ctx, app.STEP_JOIN, *app.Config.JoinTimeout)
+	failed := provisioner.JoinWorkers(joinCtx, joinCmds[1])
+	cancel()
+	if len(failed) > 0 && len(failed) == len(provisioner.WorkerNodeMachines) {
+		cluster.FailReason(stepFailure(joinCtx, app.STEP_JOIN, *app.Config.JoinTimeout, model.JoinWorkerFailedReason, fmt.Sprintf("Fail to worker-node join. (nodes=%s)", failedNodeNames(failed))))
+		cleanUpCluster(*cluster, mcis)
+		return nil, errors.New(cluster.Status.Message)
 	}
-	logger.Infof("[%s.%s] Woker-nodes join has been completed.", namespace, clusterName)
+	failures := removeFailedWorkers(ctx, provisioner, cluster, failed)
+	logger.Infof("[%s.%s] Woker-nodes join has been completed. (failed=%d)", namespace, clusterName, len(failures))
 
 	// assign node labels (topology.cloud-barista.github.io/csp , topology.kubernetes.io/region, topology.kubernetes.io/zone)
-	if err = provisioner.AssignNodeLabelAnnotation(); err != nil {
+	if err = provisioner.AssignNodeLabelAnnotation(ctx); err != nil {
 		logger.Warnf("[%s.%s] Failed to assign node labels (cause='%v')", namespace, clusterName, err)
 	} else {
 		logger.Infof("[%s.%s] Node label assignment has been completed.", namespace, clusterName)
 	}
 
 	// kubernetes provisioning : deploy network-cni
-	if err = provisioner.InstallNetworkCni(); err != nil {
-		cluster.FailReason(model.SetupNetworkCNIFailedReason, fmt.Sprintf("Failed to install network-cni. (cni=%s)", req.Config.Kubernetes.NetworkCni))
+	cniCtx, cancel := stepContext(ctx, app.STEP_CNI, *app.Config.CniTimeout)
+	err = provisioner.InstallNetworkCni(cniCtx)
+	cancel()
+	if err != nil {
+		cluster.FailReason(stepFailure(cniCtx, app.STEP_CNI, *app.Config.CniTimeout, model.SetupNetworkCNIFailedReason, fmt.Sprintf("Failed to install network-cni. (cni=%s)", req.Config.Kubernetes.NetworkCni)))
 		cleanUpCluster(*cluster, mcis)
 		return nil, errors.New(cluster.Status.Message)
 	}
 	logger.Infof("[%s.%s] CNI installation has been completed.", namespace, clusterName)
 
+	// kubernetes provisioning : csi drivers (topology.cloud-barista.github.io/csp)
+	installCsiDrivers(ctx, provisioner, mcirs)
+
+	// kubernetes provisioning : add-ons (a failure of add-on does not fail a cluster)
+	for _, addonReq := range req.Addons {
+		cluster.Addons = append(cluster.Addons, installAddon(ctx, provisioner, addonReq.Name, addons[addonReq.Name]))
+	}
+
 	// save nodes metadata & update status
 	for _, node := range cluster.Nodes {
 		node.CreatedTime = lang.GetNowUTC()
 	}
 	cluster.UpdatePhase(model.ClusterPhaseProvisioned)
+	if len(failures) > 0 {
+		// a cluster is provisioned without failed worker-nodes
+		cluster.Status.Reason = model.JoinWorkerFailedReason
+		cluster.Status.Message = fmt.Sprintf("Worker-nodes failed to join have been removed. (nodes=%s)", failedNodeNames(failed))
+		cluster.PutStore()
+	}
 	logger.Infof("[%s.%s] Cluster creation has been completed.", namespace, clusterName)
 
 	return cluster, nil
@@ -309,11 +382,210 @@ func DeleteCluster(namespace string, clusterName string) (*app.Status, error) {
 	return app.NewStatus(app.STATUS_SUCCESS, fmt.Sprintf("Cluster '%s' has been deleted", clusterName)), nil
 }
 
+/* update an api-server endpoint (regenerate api-server certificates & a kubeconfig, refresh public-ips of nodes) */
+func UpdateEndpoint(ctx context.Context, namespace string, clusterName string, req *app.EndpointReq) (*model.Cluster, error) {
+
+	cluster, err := getProvisionedCluster(namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	// refresh public-ips of nodes (e.g. a leader vm gets a new public-ip)
+	provisioner, err := newClusterProvisioner(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	// regenerate api-server certificates & kubeconfigs
+	kubeconfig, err := provisioner.UpdateEndpoint(ctx, req.Endpoint, req.CertSANs)
+	if err != nil {
+		return nil, err
+	}
+	cluster.Endpoint = req.Endpoint
+	cluster.CertSANs = req.CertSANs
+	cluster.ClusterConfig = kubeconfig
+	if err := cluster.PutStore(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to update a cluster-entity. (cause='%v')", err))
+	}
+
+	logger.Infof("[%s.%s] Endpoint update has been completed. (endpoint=%s)", namespace, clusterName, req.Endpoint)
+	return cluster, nil
+}
+
+/* a provisioner with all machines of a cluster (refresh public-ips of nodes) */
+func newClusterProvisioner(cluster *model.Cluster) (*provision.Provisioner, error) {
+
+	mcis := tumblebug.NewMCIS(cluster.Namespace, cluster.MCIS)
+	if exists, err := mcis.GET(); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New(fmt.Sprintf("Can't be found a MCIS '%s'.", cluster.MCIS))
+	}
+	provisioner := provision.NewProvisioner(cluster)
+	for _, node := range cluster.Nodes {
+		if node.Name == cluster.CpLeader {
+			provisioner.AppendControlPlaneMachine(node.Name, node.Csp, "", "", node.Credential, node.Labels, node.Taints, node.SSH) // the first control-plane machine is a leader
+		}
+	}
+	vms := []tumblebug.VM{}
+	for _, node := range cluster.Nodes {
+		if node.Role == app.CONTROL_PLANE && node.Name != cluster.CpLeader {
+			provisioner.AppendControlPlaneMachine(node.Name, node.Csp, "", "", node.Credential, node.Labels, node.Taints, node.SSH)
+		} else if node.Role == app.WORKER {
+			provisioner.AppendWorkerNodeMachine(node.Name, node.Csp, "", "", node.Credential, node.Labels, node.Taints, node.SSH)
+		}
+		for _, vm := range mcis.VMs {
+			if vm.Name == node.Name {
+				vms = append(vms, vm)
+			}
+		}
+	}
+	provisioner.PinHostKeys(cluster.Nodes)
+	nodes, err := provisioner.BindVM(vms)
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range cluster.Nodes {
+		for _, n := range nodes {
+			if node.Name == n.Name {
+				node.PublicIP = n.PublicIP
+				node.PrivateIP = n.PrivateIP
+				node.Connection = n.Connection
+			}
+		}
+	}
+	logger.Infof("[%s.%s] IPs of nodes have been refreshed.", cluster.Namespace, cluster.Name)
+
+	return provisioner, nil
+}
+
+/* update OIDC settings of api-servers (rewrite static pod manifests of control-plane nodes) */
+func UpdateOidc(ctx context.Context, namespace string, clusterName string, req *app.ClusterConfigOidcReq) (*model.Cluster, error) {
+
+	cluster, err := getProvisionedCluster(namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	provisioner, err := newClusterProvisioner(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.IssuerUrl == "" && req.ClientId == "" {
+		req = nil // disable OIDC
+	}
+	if err := provisioner.UpdateOidc(ctx, req); err != nil {
+		return nil, err
+	}
+	cluster.Oidc = req
+	if err := cluster.PutStore(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to update a cluster-entity. (cause='%v')", err))
+	}
+
+	logger.Infof("[%s.%s] OIDC update has been completed. (enabled=%t)", namespace, clusterName, req != nil)
+	return cluster, nil
+}
+
+/* rotate an encryption key of secrets (encryption at rest) */
+func RotateEncryptionKey(ctx context.Context, namespace string, clusterName string) (*app.Status, error) {
+
+	cluster, err := getProvisionedCluster(namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.Encryption == nil {
+		return nil, errors.New(fmt.Sprintf("Encryption at rest is not enabled. (cluster=%s)", clusterName))
+	}
+	provisioner, err := newClusterProvisioner(cluster)
+	if err != nil {
+		return nil, err
+	}
+	if err := provisioner.RotateEncryptionKey(ctx); err != nil {
+		return nil, err
+	}
+	if err := cluster.PutStore(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to update a cluster-entity. (cause='%v')", err))
+	}
+
+	logger.Infof("[%s.%s] Encryption key rotation has been completed.", namespace, clusterName)
+	return app.NewStatus(app.STATUS_SUCCESS, fmt.Sprintf("Encryption key of cluster '%s' has been rotated", clusterName)), nil
+}
+
+/* remove worker-nodes which are failed to join (VMs & node-entities) and returns failures */
+func removeFailedWorkers(ctx context.Context, provisioner *provision.Provisioner, cluster *model.Cluster, failed map[string]error) []model.NodeFailure {
+
+	failures := []model.NodeFailure{}
+	for name, cause := range failed {
+		logger.Warnf("[%s.%s] Failed to join a worker-node. (node=%s, cause='%v')", cluster.Namespace, cluster.Name, name, cause)
+		if err := provisioner.RemoveFailedNode(ctx, name); err != nil {
+			logger.Warnf("[%s.%s] %s", cluster.Namespace, cluster.Name, err.Error())
+		}
+		for i, node := range cluster.Nodes {
+			if node.Name == name {
+				cluster.Nodes = append(cluster.Nodes[:i], cluster.Nodes[i+1:]...)
+				break
+			}
+		}
+		failures = append(failures, model.NodeFailure{Name: name, Message: cause.Error()})
+	}
+	sort.Slice(failures, func(i, j int) bool { return failures[i].Name < failures[j].Name })
+
+	return failures
+}
+
+/* names of failed nodes (comma-separated) */
+func failedNodeNames(failed map[string]error) string {
+
+	names := []string{}
+	for name := range failed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return strings.Join(names, ",")
+}
+
+/* a context which keeps values of a parent context but is never cancelled or timed out with it */
+type detachedContext struct {
+	parent context.Context
+}
+
+func detachContext(ctx context.Context) context.Context {
+	return detachedContext{parent: ctx}
+}
+
+func (self detachedContext) Deadline() (time.Time, bool)       { return time.Time{}, false }
+func (self detachedContext) Done() <-chan struct{}             { return nil }
+func (self detachedContext) Err() error                        { return nil }
+func (self detachedContext) Value(key interface{}) interface{} { return self.parent.Value(key) }
+
+type stepDeadlineKey struct{}
+
+/* a context of a provisioning step (outputs of remote commands are recorded to node logs of a step, timeout : seconds, <= 0 : no timeout) */
+func stepContext(ctx context.Context, step app.Step, timeout int) (context.Context, context.CancelFunc) {
+
+	ctx = provision.WithStep(ctx, step)
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	deadline := time.Now().Add(time.Second * time.Duration(timeout))
+	return context.WithDeadline(context.WithValue(ctx, stepDeadlineKey{}, deadline), deadline)
+}
+
+/* a reason & a message of a failed step (StepTimeoutReason if a deadline of a step itself has expired, not a parent's one) */
+func stepFailure(ctx context.Context, step app.Step, timeout int, reason model.ClusterReason, message string) (model.ClusterReason, string) {
+
+	if deadline, ok := ctx.Value(stepDeadlineKey{}).(time.Time); ok && errors.Is(ctx.Err(), context.DeadlineExceeded) && !time.Now().Before(deadline) {
+		return model.StepTimeoutReason, fmt.Sprintf("The '%s' step has timed out. (timeout=%ds, cause='%s')", step, timeout, message)
+	}
+	return reason, message
+}
+
 /* clean-up a Cluster(with MCIS) & update a cluster-entity */
 func cleanUpCluster(cluster model.Cluster, mcis *tumblebug.MCIS) {
 	for _, node := range cluster.Nodes {
 		node.Credential = ""
 		node.PublicIP = ""
+		node.PrivateIP = ""
 	}
 	if err := cluster.PutStore(); err != nil {
 		logger.Warnf("[%s.%s] Failed to update a cluster-entity. (cause='%v')", cluster.Namespace, cluster.Name, err)
@@ -0,0 +1,308 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"github.com/cloud-barista/cb-mcks/src/core/provision"
+	"github.com/cloud-barista/cb-mcks/src/core/tumblebug"
+	"github.com/cloud-barista/cb-mcks/src/core/tumblebug/fake"
+)
+
+const (
+	testNamespace  = "ns-service-test"
+	testConnection = "config-gcp-asia-northeast3"
+)
+
+func TestMain(m *testing.M) {
+	if os.Getenv("APP_ROOT") == "" {
+		root, _ := filepath.Abs("../../..")
+		os.Setenv("APP_ROOT", root)
+	}
+	app.Setup()
+	os.Exit(m.Run())
+}
+
+/* a fake tumblebug with a namespace, a connection & specs */
+func useFakeTumblebug(t *testing.T) *fake.Server {
+
+	server := fake.NewServer()
+	server.AddNamespace(testNamespace).AddConnection(testConnection, "gcp", "asia-northeast3", "asia-northeast3-a").AddSpec(testConnection, "n1-standard-2", 2, 7680)
+	restore := server.Use()
+	t.Cleanup(func() {
+		restore()
+		server.Close()
+	})
+	return server
+}
+
+/* inject a fake executor into provisioners */
+func useFakeExecutor(t *testing.T) *provision.FakeExecutor {
+
+	executor := provision.NewFakeExecutor()
+	original := provision.DefaultExecutor
+	provision.DefaultExecutor = executor
+	t.Cleanup(func() { provision.DefaultExecutor = original })
+	return executor
+}
+
+func newTestClusterReq(name string) *app.ClusterReq {
+
+	return &app.ClusterReq{
+		Name:         name,
+		ControlPlane: []app.NodeSetReq{{Connection: testConnection, Count: 1, Spec: "n1-standard-2"}},
+		Worker:       []app.NodeSetReq{{Connection: testConnection, Count: 2, Spec: "n1-standard-2", Labels: map[string]string{"env": "dev"}}},
+		Config:       app.ClusterConfigReq{Kubernetes: app.ClusterConfigKubernetesReq{NetworkCni: app.NETWORKCNI_CANAL, PodCidr: app.POD_CIDR, ServiceCidr: app.SERVICE_CIDR, ServiceDnsDomain: app.SERVICE_DOMAIN}},
+	}
+}
+
+func deleteTestCluster(t *testing.T, name string) {
+	t.Cleanup(func() { model.NewCluster(testNamespace, name).Delete() })
+}
+
+func TestCreateClusterAndAddNode(t *testing.T) {
+
+	server := useFakeTumblebug(t)
+	executor := useFakeExecutor(t)
+	deleteTestCluster(t, "cluster-service-test")
+
+	// create a cluster
+	cluster, err := CreateCluster(context.Background(), testNamespace, "1.23", "14", newTestClusterReq("cluster-service-test"))
+	if err != nil {
+		t.Fatalf("CreateCluster error (cause=%v)", err)
+	}
+	if cluster.Status.Phase != model.ClusterPhaseProvisioned {
+		t.Fatalf("Unexpected phase (phase=%s, message=%s)", cluster.Status.Phase, cluster.Status.Message)
+	}
+	if mcis := server.MCIS(testNamespace, "cluster-service-test"); mcis == nil || len(mcis.VMs) != 3 {
+		t.Fatalf("Unexpected a MCIS (%v)", mcis)
+	}
+	for _, kind := range []string{"vNet", "securityGroup", "sshKey", "image", "spec"} {
+		if !server.ExistsResource(testNamespace, kind, map[string]string{"vNet": testConnection + "-vpc", "securityGroup": testConnection + "-sg", "sshKey": testConnection + "-sshkey", "image": testConnection + "-ubuntu1804", "spec": testConnection + "-n1-standard-2-spec"}[kind]) {
+			t.Fatalf("A MCIR is not created (kind=%s)", kind)
+		}
+	}
+	if cluster.ClusterConfig != provision.FAKE_KUBECONFIG {
+		t.Fatalf("Unexpected kubeconfig (%s)", cluster.ClusterConfig)
+	}
+	if len(cluster.Nodes) != 3 {
+		t.Fatalf("Unexpected nodes (len=%d)", len(cluster.Nodes))
+	}
+	if commands := executor.Find("k8s-init.sh"); len(commands) != 1 || commands[0].Node != cluster.CpLeader {
+		t.Fatalf("k8s-init.sh is not executed on a leader (commands=%v)", commands)
+	}
+	if commands := executor.Find("kubeadm join"); len(commands) != 2 {
+		t.Fatalf("Unexpected join commands (commands=%v)", commands)
+	}
+	if commands := executor.Find("apply -f " + provision.REMOTE_TARGET_PATH + "/" + provision.CNI_CANAL_FILE); len(commands) != 1 {
+		t.Fatalf("A network-cni is not applied (commands=%v)", executor.CommandsOf(cluster.CpLeader))
+	}
+
+	// add a worker node
+	nodes, err := AddNode(context.Background(), testNamespace, "cluster-service-test", &app.NodeReq{Worker: []app.NodeSetReq{{Connection: testConnection, Count: 1, Spec: "n1-standard-2"}}})
+	if err != nil {
+		t.Fatalf("AddNode error (cause=%v)", err)
+	}
+	if len(nodes.Items) != 4 || len(server.MCIS(testNamespace, "cluster-service-test").VMs) != 4 {
+		t.Fatalf("Unexpected nodes (len=%d)", len(nodes.Items))
+	}
+	added := nodes.Items[3]
+	if !strings.HasPrefix(added.Name, "w-3-") || added.PublicIP == "" {
+		t.Fatalf("Unexpected a added node (name=%s, ip=%s)", added.Name, added.PublicIP)
+	}
+	if commands := executor.Find("kubeadm token create"); len(commands) != 1 || commands[0].Node != cluster.CpLeader {
+		t.Fatalf("A join command is not created on a leader (commands=%v)", commands)
+	}
+	if commands := executor.CommandsOf(added.Name); len(commands) == 0 || !strings.Contains(strings.Join(commands, "\n"), "sudo kubeadm join") {
+		t.Fatalf("A added node is not joined (commands=%v)", commands)
+	}
+}
+
+func TestCreateClusterJoinFailed(t *testing.T) {
+
+	server := useFakeTumblebug(t)
+	executor := useFakeExecutor(t)
+	executor.On("kubeadm join").Fail(errors.New("Process exited with status 1"))
+	deleteTestCluster(t, "cluster-service-fail")
+
+	if _, err := CreateCluster(context.Background(), testNamespace, "1.23", "14", newTestClusterReq("cluster-service-fail")); err == nil {
+		t.Fatalf("CreateCluster should be failed")
+	}
+	cluster := model.NewCluster(testNamespace, "cluster-service-fail")
+	if _, err := cluster.Select(); err != nil {
+		t.Fatalf("Cluster select error (cause=%v)", err)
+	}
+	if cluster.Status.Phase != model.ClusterPhaseFailed || cluster.Status.Reason != model.JoinWorkerFailedReason {
+		t.Fatalf("Unexpected status (phase=%s, reason=%s)", cluster.Status.Phase, cluster.Status.Reason)
+	}
+	if server.MCIS(testNamespace, "cluster-service-fail") != nil {
+		t.Fatalf("A MCIS is not cleaned up")
+	}
+}
+
+func TestCreateClusterPartialJoin(t *testing.T) {
+
+	server := useFakeTumblebug(t)
+	executor := useFakeExecutor(t)
+	executor.On("kubeadm join").Node("w-2-").Fail(errors.New("Process exited with status 1"))
+	deleteTestCluster(t, "cluster-service-partial")
+
+	cluster, err := CreateCluster(context.Background(), testNamespace, "1.23", "14", newTestClusterReq("cluster-service-partial"))
+	if err != nil {
+		t.Fatalf("CreateCluster error (cause=%v)", err)
+	}
+	if cluster.Status.Phase != model.ClusterPhaseProvisioned || cluster.Status.Reason != model.JoinWorkerFailedReason {
+		t.Fatalf("Unexpected status (phase=%s, reason=%s)", cluster.Status.Phase, cluster.Status.Reason)
+	}
+	if len(cluster.Nodes) != 2 || cluster.GetNode(cluster.CpLeader) == nil {
+		t.Fatalf("A failed worker should be removed (nodes=%d)", len(cluster.Nodes))
+	}
+	if mcis := server.MCIS(testNamespace, "cluster-service-partial"); mcis == nil || len(mcis.VMs) != 2 {
+		t.Fatalf("A VM of a failed worker should be deleted (%v)", mcis)
+	}
+
+	// logs of a removed worker are kept
+	failed := ""
+	for _, c := range executor.Find("kubeadm join") {
+		if strings.HasPrefix(c.Node, "w-2-") {
+			failed = c.Node
+		}
+	}
+	logs, err := ListNodeLog(testNamespace, "cluster-service-partial", failed, "join")
+	if err != nil || len(logs.Items) != 1 || !strings.Contains(logs.Items[0].Output, "Process exited with status 1") {
+		t.Fatalf("A join log of a removed worker should be kept (node=%s, logs=%v, cause=%v)", failed, logs, err)
+	}
+	if _, err := ListNodeLog(testNamespace, "cluster-service-partial", failed, "unknown"); err == nil {
+		t.Fatalf("An invalid step should be failed")
+	}
+}
+
+func TestCreateClusterStepTimeout(t *testing.T) {
+
+	useFakeTumblebug(t)
+	executor := useFakeExecutor(t)
+	executor.On("bootstrap.sh").Node("w-1-").Hang()
+	timeout := *app.Config.BootstrapTimeout
+	*app.Config.BootstrapTimeout = 1
+	defer func() { *app.Config.BootstrapTimeout = timeout }()
+	deleteTestCluster(t, "cluster-service-timeout")
+
+	if _, err := CreateCluster(context.Background(), testNamespace, "1.23", "14", newTestClusterReq("cluster-service-timeout")); err == nil || !strings.Contains(err.Error(), "'bootstrap' step has timed out") {
+		t.Fatalf("CreateCluster should be timed out (cause=%v)", err)
+	}
+	cluster := model.NewCluster(testNamespace, "cluster-service-timeout")
+	if _, err := cluster.Select(); err != nil {
+		t.Fatalf("Cluster select error (cause=%v)", err)
+	}
+	if cluster.Status.Phase != model.ClusterPhaseFailed || cluster.Status.Reason != model.StepTimeoutReason {
+		t.Fatalf("Unexpected status (phase=%s, reason=%s)", cluster.Status.Phase, cluster.Status.Reason)
+	}
+	if len(executor.Find("k8s-init.sh")) != 0 {
+		t.Fatalf("A control-plane should not be initialized after a timeout")
+	}
+}
+
+func TestCreateClusterDetached(t *testing.T) {
+
+	useFakeTumblebug(t)
+	useFakeExecutor(t)
+	deleteTestCluster(t, "cluster-service-detached")
+
+	// a cancelled request context (e.g. a disconnected client) does not cancel provisioning
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if cluster, err := CreateCluster(ctx, testNamespace, "1.23", "14", newTestClusterReq("cluster-service-detached")); err != nil || cluster.Status.Phase != model.ClusterPhaseProvisioned {
+		t.Fatalf("CreateCluster should not be cancelled (cause=%v)", err)
+	}
+
+	// a parent deadline is not a step timeout
+	parent, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	stepCtx, stepCancel := stepContext(parent, app.STEP_BOOTSTRAP, 60)
+	defer stepCancel()
+	<-stepCtx.Done()
+	if reason, _ := stepFailure(stepCtx, app.STEP_BOOTSTRAP, 60, model.SetupBoostrapFailedReason, "cancelled"); reason != model.SetupBoostrapFailedReason {
+		t.Fatalf("Unexpected reason (reason=%s)", reason)
+	}
+}
+
+func TestCreateClusterMCIRFailed(t *testing.T) {
+
+	server := useFakeTumblebug(t)
+	executor := useFakeExecutor(t)
+	server.Fail(http.MethodPost, "/resources/vNet").Message("vpc quota exceeded")
+	deleteTestCluster(t, "cluster-service-mcir")
+
+	if _, err := CreateCluster(context.Background(), testNamespace, "1.23", "14", newTestClusterReq("cluster-service-mcir")); err == nil || !strings.Contains(err.Error(), "vpc quota exceeded") {
+		t.Fatalf("CreateCluster should be failed (cause=%v)", err)
+	}
+	cluster := model.NewCluster(testNamespace, "cluster-service-mcir")
+	if _, err := cluster.Select(); err != nil {
+		t.Fatalf("Cluster select error (cause=%v)", err)
+	}
+	if cluster.Status.Reason != model.CreateVpcFailedReason {
+		t.Fatalf("Unexpected reason (reason=%s)", cluster.Status.Reason)
+	}
+	if server.MCIS(testNamespace, "cluster-service-mcir") != nil || len(executor.Commands) != 0 {
+		t.Fatalf("Nothing should be provisioned (commands=%v)", executor.Commands)
+	}
+}
+
+func TestCreateClusterFirewallPorts(t *testing.T) {
+
+	useFakeTumblebug(t)
+	useFakeExecutor(t)
+	deleteTestCluster(t, "cluster-service-firewall")
+
+	// a reused firewall which allows tcp only (canal requires udp 8472)
+	fw := &tumblebug.Firewall{Model: tumblebug.Model{Name: testConnection + "-sg", Namespace: testNamespace}, Config: testConnection, FirewallRules: []tumblebug.FirewallRules{{Protocol: "tcp", Direction: "inbound", From: "1", To: "65535"}}}
+	if err := fw.POST(); err != nil {
+		t.Fatalf("Firewall POST error (cause=%v)", err)
+	}
+	if plan, err := PlanCluster(testNamespace, "1.23", "14", newTestClusterReq("cluster-service-firewall")); err != nil || plan.Valid || plan.Errors[0].Reason != model.CreateSecurityGroupFailedReason {
+		t.Fatalf("A plan should be invalid (plan=%v, cause=%v)", plan, err)
+	}
+	if _, err := CreateCluster(context.Background(), testNamespace, "1.23", "14", newTestClusterReq("cluster-service-firewall")); err == nil || !strings.Contains(err.Error(), "protocol=udp, port=8472") {
+		t.Fatalf("CreateCluster should be failed (cause=%v)", err)
+	}
+
+	// ports of a network-cni are appended unless allowed by default rules
+	if rules := tumblebug.NewFirewall(app.CSP_GCP, testNamespace, "sg", testConnection, tumblebug.FirewallRules{Protocol: "udp", Direction: "inbound", From: "8472", To: "8472"}).FirewallRules; len(rules) != 3 {
+		t.Fatalf("An allowed rule should not be appended (rules=%v)", rules)
+	}
+	if rules := tumblebug.NewFirewall(app.CSP_GCP, testNamespace, "sg", testConnection, tumblebug.FirewallRules{Protocol: "sctp", Direction: "inbound", From: "9", To: "9"}).FirewallRules; len(rules) != 4 {
+		t.Fatalf("A required rule should be appended (rules=%v)", rules)
+	}
+}
+
+func TestCreateClusterCsiDisagreed(t *testing.T) {
+
+	server := useFakeTumblebug(t)
+	executor := useFakeExecutor(t)
+	deleteTestCluster(t, "cluster-service-csi")
+
+	// node-sets of a csp with different csi values
+	req := newTestClusterReq("cluster-service-csi")
+	req.Worker[0].Csi = true
+	if _, err := CreateCluster(context.Background(), testNamespace, "1.23", "14", req); err == nil || !strings.Contains(err.Error(), "must have a same 'csi' value") {
+		t.Fatalf("CreateCluster should be failed (cause=%v)", err)
+	}
+	cluster := model.NewCluster(testNamespace, "cluster-service-csi")
+	if _, err := cluster.Select(); err != nil {
+		t.Fatalf("Cluster select error (cause=%v)", err)
+	}
+	if cluster.Status.Reason != model.InvalidMCIRReason {
+		t.Fatalf("Unexpected reason (reason=%s)", cluster.Status.Reason)
+	}
+	if server.MCIS(testNamespace, "cluster-service-csi") != nil || len(executor.Commands) != 0 {
+		t.Fatalf("Nothing should be provisioned (commands=%v)", executor.Commands)
+	}
+}
@@ -0,0 +1,71 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/tumblebug"
+)
+
+/* list connections with a csp, a region & a zone (whether the MCKS supports a csp & an image is resolvable) */
+func ListConnections() (*ConnectionList, error) {
+
+	connections := tumblebug.NewConnectionList()
+	if _, err := connections.GET(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to get a connection list. (cause='%v')", err))
+	}
+
+	list := &ConnectionList{Kind: app.KIND_CONNECTION_LIST, Items: []ConnectionInfo{}}
+	regions := map[string]*tumblebug.Region{}
+	for _, connection := range connections.Connections {
+		item := ConnectionInfo{
+			Name:           connection.ConfigName,
+			Csp:            app.CSP(strings.ToLower(connection.ProviderName)),
+			RegionName:     connection.RegionName,
+			CredentialName: connection.CredentialName,
+		}
+		item.Supported = isSupportedCSP(item.Csp)
+
+		// get a region (regions are shared by connections)
+		region, exists := regions[connection.RegionName]
+		if !exists {
+			region = tumblebug.NewRegion(connection.RegionName)
+			if exists, err := region.GET(); err != nil {
+				item.Message = fmt.Sprintf("Failed to get a region data. (cause='%v')", err)
+				region = nil
+			} else if !exists {
+				item.Message = fmt.Sprintf("Region does not exist (%s)", connection.RegionName)
+				region = nil
+			}
+			regions[connection.RegionName] = region
+		}
+		if region != nil {
+			for _, r := range region.KeyValueInfoList {
+				if r.Key == "Region" {
+					item.Region = r.Value
+				} else if r.Key == "Zone" {
+					item.Zone = r.Value
+				}
+			}
+		}
+
+		if !item.Supported {
+			item.Message = fmt.Sprintf("The CSP '%s' is not supported", connection.ProviderName)
+		} else if region != nil {
+			if _, err := getCSPImageId(item.Csp, connection.ConfigName, region); err != nil {
+				item.Message = err.Error()
+			} else {
+				item.ImageResolvable = true
+			}
+		} else if item.Message == "" {
+			item.Message = fmt.Sprintf("Could not be found a region data (%s)", connection.RegionName)
+		}
+		list.Items = append(list.Items, item)
+	}
+	sort.SliceStable(list.Items, func(i, j int) bool { return list.Items[i].Name < list.Items[j].Name })
+
+	return list, nil
+}
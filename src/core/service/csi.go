@@ -0,0 +1,56 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"github.com/cloud-barista/cb-mcks/src/core/provision"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+/* verify csi flags of node-sets and set csps of csi drivers to a cluster (a driver is deployed per csp, so node-sets of a csp must agree) */
+func verifyCsi(cluster *model.Cluster, mcirs []*MCIR) error {
+
+	// csps which already have nodes
+	csi := map[app.CSP]bool{}
+	for _, node := range cluster.Nodes {
+		csi[node.Csp] = cluster.HasCsiDriver(node.Csp)
+	}
+	for _, mcir := range mcirs {
+		if v, exists := csi[mcir.csp]; exists && v != mcir.csi {
+			return errors.New(fmt.Sprintf("Node-sets of the CSP '%s' must have a same 'csi' value, a CSI driver is deployed per CSP. (connection=%s, csi=%t)", mcir.csp, mcir.config, mcir.csi))
+		}
+		csi[mcir.csp] = mcir.csi
+	}
+
+	cluster.CsiDrivers = []app.CSP{}
+	for csp, v := range csi {
+		if v {
+			cluster.CsiDrivers = append(cluster.CsiDrivers, csp)
+		}
+	}
+	sort.Slice(cluster.CsiDrivers, func(i, j int) bool { return cluster.CsiDrivers[i] < cluster.CsiDrivers[j] })
+	return nil
+}
+
+/* install csi drivers of node-sets (a driver per csp, a failure of csi driver does not fail a cluster) */
+func installCsiDrivers(ctx context.Context, provisioner *provision.Provisioner, mcirs []*MCIR) {
+
+	installed := map[app.CSP]bool{}
+	for _, mcir := range mcirs {
+		if !mcir.csi || installed[mcir.csp] {
+			continue
+		}
+		installed[mcir.csp] = true
+		if err := provisioner.InstallCsiDriver(ctx, mcir.csp, mcir.cloud); err != nil {
+			logger.Warnf("[%s.%s] Failed to install a csi driver. (csp=%s, cause='%v')", provisioner.Cluster.Namespace, provisioner.Cluster.Name, mcir.csp, err)
+		} else {
+			logger.Infof("[%s.%s] CSI driver installation has been completed. (csp=%s, storageclass=%s)", provisioner.Cluster.Namespace, provisioner.Cluster.Name, mcir.csp, provision.CsiStorageClassName(mcir.csp))
+		}
+	}
+}
@@ -56,6 +56,16 @@ var ibmImageMap = map[string]string{
 	"jp-tok":   "r022-61fdadec-6b03-4bd2-bfca-62cd16f5673f", //일본 (도쿄)
 }
 
+// verify a CSP is supported
+func isSupportedCSP(csp app.CSP) bool {
+
+	switch csp {
+	case app.CSP_AWS, app.CSP_GCP, app.CSP_AZURE, app.CSP_ALIBABA, app.CSP_TENCENT, app.CSP_OPENSTACK, app.CSP_IBM, app.CSP_CLOUDIT:
+		return true
+	}
+	return false
+}
+
 // get a cidr-block
 func getCSPCidrBlock(csp app.CSP) string {
 
@@ -0,0 +1,45 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"github.com/cloud-barista/cb-mcks/src/core/provision"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+/* apply manifests to a cluster */
+func ApplyManifest(ctx context.Context, namespace string, clusterName string, req *app.ManifestReq) (*model.ManifestResult, error) {
+
+	// validate namespace
+	if err := verifyNamespace(namespace); err != nil {
+		return nil, err
+	}
+
+	// get a cluster-entity
+	cluster := model.NewCluster(namespace, clusterName)
+	if exists, err := cluster.Select(); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New(fmt.Sprintf("Could not be found a cluster '%s'. (namespace=%s)", clusterName, namespace))
+	} else if cluster.Status.Phase != model.ClusterPhaseProvisioned {
+		return nil, errors.New(fmt.Sprintf("Unable to apply manifests. status is '%s'.", cluster.Status.Phase))
+	}
+
+	// apply
+	provisioner := provision.NewProvisioner(cluster)
+	objects, err := provisioner.ApplyManifest(ctx, req.Manifest, req.PruneSelector, req.ForceConflicts)
+	if err != nil {
+		return nil, err
+	}
+
+	result := model.NewManifestResult()
+	result.Items = objects
+	logger.Infof("[%s.%s] Manifests apply has been completed. (objects=%d)", namespace, clusterName, len(objects))
+
+	return result, nil
+}
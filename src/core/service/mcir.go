@@ -3,11 +3,15 @@ package service
 import (
 	"errors"
 	"fmt"
+	"math"
+	"path"
+	"sort"
 	"strconv"
 	"strings"
 
 	"github.com/cloud-barista/cb-mcks/src/core/app"
 	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"github.com/cloud-barista/cb-mcks/src/core/provision"
 	"github.com/cloud-barista/cb-mcks/src/core/tumblebug"
 	"github.com/cloud-barista/cb-mcks/src/utils/lang"
 
@@ -18,6 +22,7 @@ type MCIR struct {
 	namespace    string
 	csp          app.CSP
 	role         app.ROLE
+	cluster      *model.Cluster
 	config       string //prameter
 	spec         string //prameter
 	vmCount      int    //prameter
@@ -30,18 +35,29 @@ type MCIR struct {
 	specName     string
 	region       string
 	zone         string
+	imageId      string
+	csi          bool               //prameter
+	labels       map[string]string  //prameter
+	taints       []app.NodeTaintReq //prameter
+	ssh          *app.SSHReq        //prameter
+	cloud        *provision.CsiCloud
 }
 
-func NewMCIR(namespace string, role app.ROLE, nodeSetReq app.NodeSetReq) *MCIR {
+func NewMCIR(namespace string, role app.ROLE, nodeSetReq app.NodeSetReq, cluster *model.Cluster) *MCIR {
 
 	specName := strings.ToLower(lang.ReplaceAll(nodeSetReq.Spec, []string{".", "_", " "}, "-"))
 
 	return &MCIR{
 		namespace:    namespace,
 		role:         role,
+		cluster:      cluster,
 		config:       nodeSetReq.Connection,
 		spec:         nodeSetReq.Spec,
 		vmCount:      nodeSetReq.Count,
+		csi:          nodeSetReq.Csi,
+		labels:       nodeSetReq.Labels,
+		taints:       nodeSetReq.Taints,
+		ssh:          nodeSetReq.SSH,
 		vpcName:      fmt.Sprintf("%s-vpc", nodeSetReq.Connection),
 		firewallName: fmt.Sprintf("%s-sg", nodeSetReq.Connection),
 		sshkeyName:   fmt.Sprintf("%s-sshkey", nodeSetReq.Connection),
@@ -53,46 +69,8 @@ func NewMCIR(namespace string, role app.ROLE, nodeSetReq app.NodeSetReq) *MCIR {
 /* create a MCIR (vpc, firewall, ssk-key, vm-spec, vm-image) if there is not exist */
 func (self *MCIR) CreateIfNotExist() (model.ClusterReason, string) {
 
-	// validate a connection info.
-	connection := tumblebug.NewConnection(self.config)
-	if exists, err := connection.GET(); err != nil {
-		return model.InvalidMCIRReason, fmt.Sprintf("Failed to get a connection info. (%s)", self.config)
-	} else if !exists {
-		return model.InvalidMCIRReason, fmt.Sprintf("Connection does not exist. (%s)", self.config)
-	}
-	self.csp = app.CSP(strings.ToLower(connection.ProviderName))
-
-	//validate a CSP
-	exists := false
-	for _, c := range []string{string(app.CSP_AWS), string(app.CSP_GCP), string(app.CSP_AZURE), string(app.CSP_ALIBABA), string(app.CSP_TENCENT), string(app.CSP_OPENSTACK), string(app.CSP_IBM), string(app.CSP_CLOUDIT)} {
-		if string(self.csp) == c {
-			exists = true
-			break
-		}
-	}
-	if exists == false {
-		return model.InvalidMCIRReason, fmt.Sprintf("The CSP '%s' is not supported", connection.ProviderName)
-	}
-
-	// validation a spec.
-	if err := self.verifySpec(); err != nil {
-		return model.InvalidMCIRReason, err.Error()
-	}
-
-	// get a region
-	region := tumblebug.NewRegion(connection.RegionName)
-	if exists, err := region.GET(); err != nil {
-		return model.InvalidMCIRReason, fmt.Sprintf("Failed to get a region data. (cause='%v')", err)
-	} else if !exists {
-		return model.InvalidMCIRReason, fmt.Sprintf("Region does not exist (%s)", connection.RegionName)
-	}
-	for _, r := range region.KeyValueInfoList {
-		if r.Key == "Region" {
-			self.region = r.Value
-		} else if r.Key == "Zone" {
-			self.zone = r.Value
-		}
-
+	if reason, msg := self.resolve(); reason != "" {
+		return reason, msg
 	}
 
 	// Create a VPC
@@ -111,14 +89,21 @@ func (self *MCIR) CreateIfNotExist() (model.ClusterReason, string) {
 	}
 	self.subnetName = vpc.Subnets[0].Name
 
-	// Create a Firewall
-	fw := tumblebug.NewFirewall(self.csp, self.namespace, self.firewallName, self.config)
+	// Create a Firewall (with ports that the network-cni requires)
+	networkCni, err := provision.GetNetworkCni(self.cluster)
+	if err != nil {
+		return model.InvalidMCIRReason, err.Error()
+	}
+	fw := tumblebug.NewFirewall(self.csp, self.namespace, self.firewallName, self.config, networkCni.FirewallRules()...)
 	fw.VPCId = self.vpcName
 	exists, err = fw.GET()
 	if err != nil {
 		return model.CreateSecurityGroupFailedReason, fmt.Sprintf("Failed to create a Firewall Rules. (cause='%v')", err)
 	}
 	if exists {
+		if reason, message := self.verifyFirewall(fw, networkCni); reason != "" {
+			return reason, message
+		}
 		logger.Infof("[%s] Firewall has been reused. (%s)", self.config, self.firewallName)
 	} else {
 		if err = fw.POST(); err != nil {
@@ -144,12 +129,8 @@ func (self *MCIR) CreateIfNotExist() (model.ClusterReason, string) {
 	self.credential = sshKey.PrivateKey
 
 	// Create a Image
-	imageId, err := getCSPImageId(self.csp, self.config, region)
-	if err != nil {
-		return model.InvalidMCIRReason, err.Error()
-	}
 	image := tumblebug.NewImage(self.namespace, self.imageName, self.config)
-	image.CspImageId = imageId
+	image.CspImageId = self.imageId
 	exists, err = image.GET()
 	if err != nil {
 		return model.CreateVmImageFailedReason, fmt.Sprintf("Failed to create a Image. (cause='%v')", err)
@@ -182,6 +163,129 @@ func (self *MCIR) CreateIfNotExist() (model.ClusterReason, string) {
 	return "", ""
 }
 
+/* resolve & validate a connection, a CSP, a spec, a region, a credential and an image (read-only) */
+func (self *MCIR) resolve() (model.ClusterReason, string) {
+
+	// validate a connection info.
+
+	connection := tumblebug.NewConnection(self.config)
+	if exists, err := connection.GET(); err != nil {
+		return model.InvalidMCIRReason, fmt.Sprintf("Failed to get a connection info. (%s)", self.config)
+	} else if !exists {
+		return model.InvalidMCIRReason, fmt.Sprintf("Connection does not exist. (%s)", self.config)
+	}
+	self.csp = app.CSP(strings.ToLower(connection.ProviderName))
+
+	//validate a CSP
+	if !isSupportedCSP(self.csp) {
+		return model.InvalidMCIRReason, fmt.Sprintf("The CSP '%s' is not supported", connection.ProviderName)
+	}
+
+	// validation a spec.
+	if err := self.verifySpec(); err != nil {
+		return model.InvalidMCIRReason, err.Error()
+	}
+
+	// get a region
+	region := tumblebug.NewRegion(connection.RegionName)
+	if exists, err := region.GET(); err != nil {
+		return model.InvalidMCIRReason, fmt.Sprintf("Failed to get a region data. (cause='%v')", err)
+	} else if !exists {
+		return model.InvalidMCIRReason, fmt.Sprintf("Region does not exist (%s)", connection.RegionName)
+	}
+	for _, r := range region.KeyValueInfoList {
+		if r.Key == "Region" {
+			self.region = r.Value
+		} else if r.Key == "Zone" {
+			self.zone = r.Value
+		}
+
+	}
+
+	// get a credential (csi driver)
+	if self.csi {
+		if _, err := provision.GetCsiDriver(self.csp); err != nil {
+			return model.InvalidMCIRReason, err.Error()
+		}
+		credential := tumblebug.NewCredential(connection.CredentialName)
+		if exists, err := credential.GET(); err != nil {
+			return model.InvalidMCIRReason, fmt.Sprintf("Failed to get a credential. (cause='%v')", err)
+		} else if !exists {
+			return model.InvalidMCIRReason, fmt.Sprintf("Credential does not exist (%s)", connection.CredentialName)
+		}
+		self.cloud = provision.NewCsiCloud(credential.KeyValueInfoList, region.KeyValueInfoList)
+	}
+
+	// resolve an image
+	imageId, err := getCSPImageId(self.csp, self.config, region)
+	if err != nil {
+		return model.InvalidMCIRReason, err.Error()
+	}
+	self.imageId = imageId
+
+	return "", ""
+}
+
+/* plan a MCIR (vpc, firewall, ssk-key, vm-image, vm-spec) without changes - resources to create or reuse */
+func (self *MCIR) Plan() ([]model.PlanResource, model.ClusterReason, string) {
+
+	if reason, msg := self.resolve(); reason != "" {
+		return nil, reason, msg
+	}
+
+	resources := []model.PlanResource{}
+	plan := func(kind string, name string, get func() (bool, error)) (bool, error) {
+		exists, err := get()
+		if err != nil {
+			return false, err
+		}
+		resource := model.PlanResource{Kind: kind, Name: name, Connection: self.config, Action: model.PlanActionCreate}
+		if exists {
+			resource.Action = model.PlanActionReuse
+		}
+		resources = append(resources, resource)
+		return exists, nil
+	}
+
+	vpc := tumblebug.NewVPC(self.namespace, self.vpcName, self.config, getCSPCidrBlock(self.csp))
+	if _, err := plan("vpc", self.vpcName, vpc.GET); err != nil {
+		return nil, model.CreateVpcFailedReason, fmt.Sprintf("Failed to get a VPC. (cause='%v')", err)
+	}
+
+	networkCni, err := provision.GetNetworkCni(self.cluster)
+	if err != nil {
+		return nil, model.InvalidMCIRReason, err.Error()
+	}
+	fw := tumblebug.NewFirewall(self.csp, self.namespace, self.firewallName, self.config, networkCni.FirewallRules()...)
+	fw.VPCId = self.vpcName
+	if exists, err := plan("firewall", self.firewallName, fw.GET); err != nil {
+		return nil, model.CreateSecurityGroupFailedReason, fmt.Sprintf("Failed to get a Firewall Rules. (cause='%v')", err)
+	} else if exists {
+		if reason, message := self.verifyFirewall(fw, networkCni); reason != "" {
+			return nil, reason, message
+		}
+	}
+
+	sshKey := tumblebug.NewSSHKey(self.namespace, self.sshkeyName, self.config)
+	if _, err := plan("sshKey", self.sshkeyName, sshKey.GET); err != nil {
+		return nil, model.CreateSSHKeyFailedReason, fmt.Sprintf("Failed to get a SSH-Key. (cause='%v')", err)
+	}
+
+	image := tumblebug.NewImage(self.namespace, self.imageName, self.config)
+	image.CspImageId = self.imageId
+	if _, err := plan("image", self.imageName, image.GET); err != nil {
+		return nil, model.CreateVmImageFailedReason, fmt.Sprintf("Failed to get a Image. (cause='%v')", err)
+	}
+
+	spec := tumblebug.NewSpec(self.namespace, self.specName, self.config)
+	spec.CspSpecName = self.spec
+	if _, err := plan("spec", self.specName, spec.GET); err != nil {
+		return nil, model.CreateVmSpecFailedReason, fmt.Sprintf("Failed to get a VM Spec. (cause='%v')", err)
+	}
+
+	return resources, "", ""
+}
+
 /* new a VM template */
 func (self *MCIR) NewVM(namespace string, name string, mcisName string) tumblebug.VM {
 	vm := tumblebug.NewVM(namespace, name, mcisName)
@@ -192,9 +296,22 @@ func (self *MCIR) NewVM(namespace string, name string, mcisName string) tumblebu
 	vm.SSHKey = self.sshkeyName
 	vm.Image = self.imageName
 	vm.Spec = self.specName
+	if self.ssh != nil && self.ssh.Username != "" {
+		vm.UserAccount = self.ssh.Username
+	}
 	return *vm
 }
 
+/* verify a reused firewall allows ports that the network-cni requires */
+func (self *MCIR) verifyFirewall(fw *tumblebug.Firewall, networkCni provision.NetworkCni) (model.ClusterReason, string) {
+	for _, rule := range networkCni.FirewallRules() {
+		if !fw.Allows(rule) {
+			return model.CreateSecurityGroupFailedReason, fmt.Sprintf("Firewall '%s' does not allow a port required by network-cni. (cni=%s, protocol=%s, port=%s)", self.firewallName, networkCni.Name(), rule.Protocol, rule.From)
+		}
+	}
+	return "", ""
+}
+
 /* verify - cpus & momories & look-up(exists) */
 func (self *MCIR) verifySpec() error {
 
@@ -210,8 +327,8 @@ func (self *MCIR) verifySpec() error {
 		if err != nil {
 			return errors.New(fmt.Sprintf("Failed to convert cpu count. (csp=%s, spec=%s, cpu=%s)", self.csp, self.spec, lookupSpec.CPU.Count))
 		}
-		if vCpuCount < 2 {
-			return errors.New(fmt.Sprintf("Kubernetes control plane node needs 2 cpu at least. (csp=%s, spec=%s, cpu=%d)", self.csp, self.spec, vCpuCount))
+		if vCpuCount < app.SPEC_MIN_CPU_CONTROL_PLANE {
+			return errors.New(fmt.Sprintf("Kubernetes control plane node needs %d cpu at least. (csp=%s, spec=%s, cpu=%d)", app.SPEC_MIN_CPU_CONTROL_PLANE, self.csp, self.spec, vCpuCount))
 		}
 	}
 
@@ -221,59 +338,126 @@ func (self *MCIR) verifySpec() error {
 	}
 
 	gbMem := mem / 1024
-	if gbMem < 2 {
-		return errors.New(fmt.Sprintf("kubernetes node needs 2 GiB or more of RAM. (csp=%s, spec=%s, memory=%dGB)", self.csp, self.spec, gbMem))
+	if gbMem < app.SPEC_MIN_MEMORY_GIB {
+		return errors.New(fmt.Sprintf("kubernetes node needs %d GiB or more of RAM. (csp=%s, spec=%s, memory=%dGB)", app.SPEC_MIN_MEMORY_GIB, self.csp, self.spec, gbMem))
 	}
 
 	return nil
 }
 
-func VerifySpecList(configName string, controlPlane string, cpumin int, cpumax int, memorymin int, memorymax int) (SpecList, error) {
-	if controlPlane == "Y" {
-		if cpumin < 2 {
-			return SpecList{}, errors.New(fmt.Sprintf("Kubernetes control plane node needs 2 cpu at least. (cpu-min=%d)", cpumin))
+/* list specs of a connection (filters, a sort & a cursor pagination) */
+func ListSpecs(connection string, req app.SpecListReq) (*SpecList, error) {
+
+	lookupSpecs := tumblebug.NewLookupSpecs(connection)
+	if exist, err := lookupSpecs.GET(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to lookup spec. (connection=%s, cause='%v')", connection, err))
+	} else if !exist {
+		return nil, errors.New(fmt.Sprintf("Could not be found a specList. (connection=%s)", connection))
+	}
+
+	items := []Vmspecs{}
+	for _, spec := range lookupSpecs.Vmspecs {
+		cpu, err := strconv.Atoi(spec.CPU.Count)
+		if err != nil {
+			logger.Warnf("Failed to convert CPU. (connection=%s, spec=%s, cpu=%s)", connection, spec.Name, spec.CPU.Count)
+			continue
 		}
-		if memorymin < 2 {
-			return SpecList{}, errors.New(fmt.Sprintf("Kubernetes control plane node needs 2 memory at least. (memory-min=%d)", memorymin))
+		mem, err := strconv.Atoi(spec.Memory)
+		if err != nil {
+			logger.Warnf("Failed to convert memory. (connection=%s, spec=%s, memory=%s)", connection, spec.Name, spec.Memory)
+			continue
+		}
+		item := Vmspecs{Name: spec.Name, Arch: specArchitecture(spec.KeyValueList), cpuCount: cpu, memoryGiB: float64(mem) / 1024}
+		item.CPU.Count, item.CPU.Clock = spec.CPU.Count, spec.CPU.Clock
+		item.Memory = strconv.FormatFloat(math.Round(item.memoryGiB*100)/100, 'f', -1, 64)
+		item.clockGHz, _ = strconv.ParseFloat(spec.CPU.Clock, 64)
+		for _, gpu := range spec.Gpu {
+			item.Gpus = append(item.Gpus, VmspecGpu{Count: gpu.Count, Mfr: gpu.Mfr, Model: gpu.Model, Memory: gpu.Memory})
+		}
+		if matchSpec(item, req) {
+			items = append(items, item)
 		}
 	}
 
-	if cpumin > cpumax {
-		return SpecList{}, errors.New(fmt.Sprintf("The cpu-max must be greater than or equal to the cpu-min. (cpu-min=%d cpu-max=%d)", cpumin, cpumax))
+	// sort (a name is a tie-breaker, so a cursor is a strict position)
+	desc := strings.HasPrefix(req.Sort, "-")
+	key := strings.TrimPrefix(req.Sort, "-")
+	less := func(a, b Vmspecs) bool {
+		var x, y float64
+		switch key {
+		case "cpu":
+			x, y = float64(a.cpuCount), float64(b.cpuCount)
+		case "memory":
+			x, y = a.memoryGiB, b.memoryGiB
+		case "clock":
+			x, y = a.clockGHz, b.clockGHz
+		}
+		if x != y {
+			return (x < y) != desc
+		}
+		if a.Name != b.Name {
+			return (a.Name < b.Name) != (desc && key == "name")
+		}
+		return false
 	}
+	sort.SliceStable(items, func(i, j int) bool { return less(items[i], items[j]) })
 
-	if memorymin > memorymax {
-		return SpecList{}, errors.New(fmt.Sprintf("The memory-max must be greater than or equal to the memory-min. (memory-min=%d memory-max=%d)", memorymin, memorymax))
+	// page
+	if req.Cursor != "" {
+		cursor, err := app.DecodeSpecCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		last := Vmspecs{Name: cursor.Name, cpuCount: cursor.Cpu, memoryGiB: cursor.Memory, clockGHz: cursor.Clock}
+		items = items[sort.Search(len(items), func(i int) bool { return less(last, items[i]) }):]
+	}
+	specList := &SpecList{Kind: "specList", Config: connection, Vmspecs: items}
+	if req.Limit > 0 && len(items) > req.Limit {
+		specList.Vmspecs = items[:req.Limit]
+		last := specList.Vmspecs[req.Limit-1]
+		specList.NextCursor = app.EncodeSpecCursor(app.SpecCursor{Sort: req.Sort, Name: last.Name, Cpu: last.cpuCount, Memory: last.memoryGiB, Clock: last.clockGHz})
 	}
 
-	lookupSpecs := tumblebug.NewLookupSpecs(configName)
+	return specList, nil
+}
 
-	if exist, err := lookupSpecs.GET(); err != nil {
-		return SpecList{}, errors.New(fmt.Sprintf("Failed to lookup spec. (connection=%s, cause='%v')", configName, err))
-	} else if !exist {
-		return SpecList{}, errors.New(fmt.Sprintf("Could not be found a specList. (connection=%s)", configName))
-	} else {
-		var filterSpec []Vmspecs
-
-		for _, Vmspec := range lookupSpecs.Vmspecs {
-			cpuCount, err := strconv.Atoi(Vmspec.CPU.Count)
-			if err != nil {
-				return SpecList{}, errors.New(fmt.Sprintf("Failed to convert CPU. (CPU=%s)", Vmspec.CPU.Count))
-			}
-			mem, err := strconv.Atoi(Vmspec.Memory)
-			if err != nil {
-				return SpecList{}, errors.New(fmt.Sprintf("Failed to convert memory. (memory=%s)", Vmspec.Memory))
-			}
-			mem /= 1024
-			if cpuCount >= cpumin && cpumax >= cpuCount && mem >= memorymin && memorymax >= mem {
-				VMSpecList := Vmspecs{Name: Vmspec.Name, Memory: strconv.Itoa(mem), CPU: Vmspec.CPU}
-				filterSpec = append(filterSpec, VMSpecList)
-			}
-		}
+/* verify a spec matches filters of a request */
+func matchSpec(spec Vmspecs, req app.SpecListReq) bool {
 
-		SpecList := SpecList{Kind: "specList", Config: configName, Vmspecs: filterSpec}
+	if spec.cpuCount < req.CpuMin || (req.CpuMax > 0 && spec.cpuCount > req.CpuMax) {
+		return false
+	}
+	if spec.memoryGiB < req.MemoryMin || (req.MemoryMax > 0 && spec.memoryGiB > req.MemoryMax) {
+		return false
+	}
+	if (req.ClockMin > 0 && spec.clockGHz < req.ClockMin) || (req.ClockMax > 0 && (spec.clockGHz == 0 || spec.clockGHz > req.ClockMax)) {
+		return false // an unknown clock does not match clock filters
+	}
+	if req.Arch != "" && spec.Arch != req.Arch {
+		return false
+	}
+	if (req.Gpu == "Y" && len(spec.Gpus) == 0) || (req.Gpu == "N" && len(spec.Gpus) > 0) {
+		return false
+	}
+	if req.Name != "" {
+		if matched, _ := path.Match(strings.ToLower(req.Name), strings.ToLower(spec.Name)); !matched {
+			return false
+		}
+	}
+	return true
+}
 
-		return SpecList, nil
+/* an architecture of a spec (x86_64 if it is not reported by a CSP) */
+func specArchitecture(keyValues []tumblebug.KeyValue) string {
 
+	for _, kv := range keyValues {
+		key, value := strings.ToLower(kv.Key), strings.ToLower(kv.Value)
+		if !strings.Contains(key, "arch") && !strings.Contains(key, "processor") {
+			continue
+		}
+		if strings.Contains(value, "arm64") || strings.Contains(value, "aarch64") {
+			return app.SPEC_ARCH_ARM64
+		}
 	}
+	return app.SPEC_ARCH_X86_64
 }
@@ -1,7 +1,7 @@
 package service
 
 import (
-	//"context"
+	"context"
 	"errors"
 	"fmt"
 	"time"
@@ -57,8 +57,51 @@ func GetNode(namespace string, clusterName string, nodeName string) (*model.Node
 	return nil, errors.New(fmt.Sprintf("Could not be found a node '%s' (namespace=%s, cluster=%s)", nodeName, namespace, clusterName))
 }
 
+/* get logs of a node (outputs of remote commands per a provisioning step, step : empty for all steps) */
+func ListNodeLog(namespace string, clusterName string, nodeName string, step string) (*model.NodeLogList, error) {
+	err := verifyNamespace(namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster := model.NewCluster(namespace, clusterName)
+	if exists, err := cluster.Select(); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New(fmt.Sprintf("Could not be found a cluster '%s'. (namespace=%s)", clusterName, namespace))
+	}
+
+	logs := model.NewNodeLogList(namespace, clusterName, nodeName)
+	if step == "" {
+		if err := logs.SelectList(); err != nil {
+			return nil, err
+		}
+	} else {
+		switch app.Step(step) {
+		case app.STEP_BOOTSTRAP, app.STEP_HAPROXY, app.STEP_INIT, app.STEP_JOIN, app.STEP_CNI, app.STEP_OTHER:
+		default:
+			return nil, errors.New(fmt.Sprintf("Invalid step '%s'. (bootstrap, haproxy, init, join, cni, other)", step))
+		}
+		log := model.NewNodeLog(namespace, clusterName, nodeName, app.Step(step))
+		if exists, err := log.Select(); err != nil {
+			return nil, err
+		} else if exists {
+			logs.Items = append(logs.Items, log)
+		}
+	}
+
+	// logs of removed nodes (e.g. failed to join) are kept until a cluster is deleted
+	if len(logs.Items) == 0 && !cluster.ExistsNode(nodeName) {
+		return nil, errors.New(fmt.Sprintf("Could not be found a node '%s' (namespace=%s, cluster=%s)", nodeName, namespace, clusterName))
+	}
+
+	return logs, nil
+}
+
 /* add a node */
-func AddNode(namespace string, clusterName string, req *app.NodeReq) (*model.NodeList, error) {
+func AddNode(ctx context.Context, namespace string, clusterName string, req *app.NodeReq) (*model.NodeList, error) {
+
+	ctx = detachContext(ctx) // provisioning is bounded by step timeouts only (not by a disconnected client)
 
 	// validate namespace
 	if err := verifyNamespace(namespace); err != nil {
@@ -90,7 +133,7 @@ func AddNode(namespace string, clusterName string, req *app.NodeReq) (*model.Nod
 	provisioner := provision.NewProvisioner(cluster)
 
 	// get join command
-	workerJoinCmd, err := provisioner.NewWorkerJoinCommand()
+	workerJoinCmd, err := provisioner.NewWorkerJoinCommand(ctx)
 	if err != nil {
 		return nil, errors.New(fmt.Sprintf("failed to get join-command (cause='%v')", err))
 	}
@@ -99,11 +142,16 @@ func AddNode(namespace string, clusterName string, req *app.NodeReq) (*model.Nod
 	// create a MCIR & MCIS-vm
 	idx := cluster.NextNodeIndex(app.WORKER)
 	vms := []tumblebug.VM{}
+	mcirs := []*MCIR{}
 	for _, worker := range req.Worker {
-		mcir := NewMCIR(namespace, app.WORKER, worker)
+		mcir := NewMCIR(namespace, app.WORKER, worker, cluster)
+		mcirs = append(mcirs, mcir)
 		reason, msg := mcir.CreateIfNotExist()
 		if reason != "" {
 			return nil, errors.New(msg)
+		} else if err := verifyCsi(cluster, mcirs); err != nil {
+			cleanUpNodes(*provisioner)
+			return nil, err
 		} else {
 			for i := 0; i < mcir.vmCount; i++ {
 				name := lang.GenerateNewNodeName(string(app.WORKER), idx)
@@ -113,7 +161,7 @@ func AddNode(namespace string, clusterName string, req *app.NodeReq) (*model.Nod
 					return nil, err
 				}
 				vms = append(vms, vm)
-				provisioner.AppendWorkerNodeMachine(name, mcir.csp, mcir.region, mcir.zone, mcir.credential)
+				provisioner.AppendWorkerNodeMachine(name, mcir.csp, mcir.region, mcir.zone, mcir.credential, mcir.labels, mcir.taints, mcir.ssh)
 				idx = idx + 1
 			}
 		}
@@ -133,28 +181,39 @@ func AddNode(namespace string, clusterName string, req *app.NodeReq) (*model.Nod
 
 	// kubernetes provisioning : bootstrap
 	time.Sleep(2 * time.Second)
-	if err := provisioner.Bootstrap(); err != nil {
+	bootstrapCtx, cancel := stepContext(ctx, app.STEP_BOOTSTRAP, *app.Config.BootstrapTimeout)
+	err = provisioner.Bootstrap(bootstrapCtx)
+	cancel()
+	if err != nil {
 		cleanUpNodes(*provisioner)
-		return nil, errors.New(fmt.Sprintf("Bootstrap failed. (cause='%v')", err))
+		_, message := stepFailure(bootstrapCtx, app.STEP_BOOTSTRAP, *app.Config.BootstrapTimeout, model.SetupBoostrapFailedReason, fmt.Sprintf("Bootstrap failed. (cause='%v')", err))
+		return nil, errors.New(message)
 	}
+	provisioner.RecordHostKeys(cluster.Nodes)
 	logger.Infof("[%s.%s] Bootstrap has been completed.", namespace, clusterName)
 
-	// kubernetes provisioning : worker node join
-	for _, machine := range provisioner.WorkerNodeMachines {
-		if err := machine.JoinWorker(&workerJoinCmd); err != nil {
-			cleanUpNodes(*provisioner)
-			return nil, errors.New(fmt.Sprintf("Fail to worker-node join. (node=%s)", machine.Name))
-		}
+	// kubernetes provisioning : worker node join (in parallel, failed workers are removed if any worker has joined)
+	joinCtx, cancel := stepContext(ctx, app.STEP_JOIN, *app.Config.JoinTimeout)
+	failed := provisioner.JoinWorkers(joinCtx, workerJoinCmd)
+	cancel()
+	if len(failed) > 0 && len(failed) == len(provisioner.WorkerNodeMachines) {
+		cleanUpNodes(*provisioner)
+		_, message := stepFailure(joinCtx, app.STEP_JOIN, *app.Config.JoinTimeout, model.JoinWorkerFailedReason, fmt.Sprintf("Fail to worker-node join. (nodes=%s)", failedNodeNames(failed)))
+		return nil, errors.New(message)
 	}
-	logger.Infof("[%s.%s] Woker-nodes join has been completed.", namespace, clusterName)
+	failures := removeFailedWorkers(ctx, provisioner, cluster, failed)
+	logger.Infof("[%s.%s] Woker-nodes join has been completed. (failed=%d)", namespace, clusterName, len(failures))
 
 	// assign node labels (topology.cloud-barista.github.io/csp , topology.kubernetes.io/region, topology.kubernetes.io/zone)
-	if err = provisioner.AssignNodeLabelAnnotation(); err != nil {
+	if err = provisioner.AssignNodeLabelAnnotation(ctx); err != nil {
 		logger.Warnf("[%s.%s] Failed to assign node labels (cause='%v')", namespace, clusterName, err)
 	} else {
 		logger.Infof("[%s.%s] Node label assignment has been completed.", namespace, clusterName)
 	}
 
+	// kubernetes provisioning : csi drivers (topology.cloud-barista.github.io/csp)
+	installCsiDrivers(ctx, provisioner, mcirs)
+
 	// save nodes metadata & update status
 	for _, node := range cluster.Nodes {
 		node.CreatedTime = lang.GetNowUTC()
@@ -167,11 +226,74 @@ func AddNode(namespace string, clusterName string, req *app.NodeReq) (*model.Nod
 
 	nodes := model.NewNodeList(namespace, clusterName)
 	nodes.Items = cluster.Nodes
+	if len(failures) > 0 {
+		nodes.Failed = failures
+	}
 	return nodes, nil
 }
 
+/* update labels & taints of a node */
+func UpdateNode(ctx context.Context, namespace string, clusterName string, nodeName string, req *app.NodeUpdateReq) (*model.Node, error) {
+
+	cluster, err := getProvisionedCluster(namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	var node *model.Node
+	for _, n := range cluster.Nodes {
+		if n.Name == nodeName {
+			node = n
+		}
+	}
+	if node == nil {
+		return nil, errors.New(fmt.Sprintf("Could not be found a node '%s' (namespace=%s, cluster=%s)", nodeName, namespace, clusterName))
+	}
+
+	provisioner := provision.NewProvisioner(cluster)
+	if err := provisioner.UpdateNodeLabelsTaints(ctx, nodeName, node.Labels, node.Taints, req.Labels, req.Taints); err != nil {
+		return nil, err
+	}
+	node.Labels = req.Labels
+	node.Taints = req.Taints
+	if err := cluster.PutStore(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to update a cluster-entity. (cause='%v')", err))
+	}
+
+	logger.Infof("[%s.%s] Node update has been completed. (node=%s)", namespace, clusterName, nodeName)
+	return node, nil
+}
+
+/* reset a pinned host key of a node (a host key of a new contact is pinned, e.g. a host key is rotated on a VM) */
+func ResetHostKey(ctx context.Context, namespace string, clusterName string, nodeName string) (*model.Node, error) {
+
+	cluster, err := getProvisionedCluster(namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	node := cluster.GetNode(nodeName)
+	if node == nil {
+		return nil, errors.New(fmt.Sprintf("Could not be found a node '%s' (namespace=%s, cluster=%s)", nodeName, namespace, clusterName))
+	}
+
+	provisioner, err := newClusterProvisioner(cluster)
+	if err != nil {
+		return nil, err
+	}
+	hostKey, err := provisioner.ResetHostKey(ctx, nodeName)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to reset a host key. (node=%s, cause='%v')", nodeName, err))
+	}
+	node.HostKey = hostKey
+	if err := cluster.PutStore(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to update a cluster-entity. (cause='%v')", err))
+	}
+
+	logger.Infof("[%s.%s] Host key has been reset. (node=%s)", namespace, clusterName, nodeName)
+	return node, nil
+}
+
 /* remove a node */
-func RemoveNode(namespace string, clusterName string, nodeName string) (*app.Status, error) {
+func RemoveNode(ctx context.Context, namespace string, clusterName string, nodeName string) (*app.Status, error) {
 
 	//validate
 	if err := verifyNamespace(namespace); err != nil {
@@ -200,7 +322,7 @@ func RemoveNode(namespace string, clusterName string, nodeName string) (*app.Sta
 	// get a provisioner
 	provisioner := provision.NewProvisioner(cluster)
 	// delete node (kubernetes) & vm (mcis)
-	if err := provisioner.DrainAndDeleteNode(nodeName); err != nil {
+	if err := provisioner.DrainAndDeleteNode(ctx, nodeName); err != nil {
 		return nil, err
 	}
 	// delete a node-entity
@@ -222,12 +344,13 @@ func cleanUpNodes(provisioner provision.Provisioner) {
 			if node.Name == nodeName {
 				node.Credential = ""
 				node.PublicIP = ""
+				node.PrivateIP = ""
 				existNode = true
 				break
 			}
 		}
 		if existNode {
-			if err := provisioner.DrainAndDeleteNode(nodeName); err != nil {
+			if err := provisioner.DrainAndDeleteNode(context.Background(), nodeName); err != nil {
 				logger.Warnf("[%s.%s] %s", provisioner.Cluster.Namespace, provisioner.Cluster.Name, err.Error())
 			}
 		}
@@ -0,0 +1,108 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"github.com/cloud-barista/cb-mcks/src/core/tumblebug"
+)
+
+/* plan a cluster creation (dry-run) - resolve connections, CSPs, regions, images & specs and returns resources to create or reuse without changes */
+func PlanCluster(namespace string, minorversion string, patchversion string, req *app.ClusterReq) (*model.ClusterPlan, error) {
+
+	k8sVersion, addons, err := verifyClusterReq(namespace, minorversion, patchversion, req)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &model.ClusterPlan{
+		Kind:      app.KIND_CLUSTER_PLAN,
+		Namespace: namespace,
+		Name:      req.Name,
+		Version:   k8sVersion,
+		Errors:    []model.PlanError{},
+		Nodes:     []model.PlanNode{},
+		Resources: []model.PlanResource{},
+		Addons:    []string{},
+	}
+	fail := func(reason model.ClusterReason, message string) {
+		plan.Errors = append(plan.Errors, model.PlanError{Reason: reason, Message: message})
+	}
+	for name := range addons {
+		plan.Addons = append(plan.Addons, name)
+	}
+	sort.Strings(plan.Addons)
+
+	// name conflicts (a failed cluster is cleaned up)
+	cluster := model.NewCluster(namespace, req.Name)
+	if exists, err := cluster.Select(); err != nil {
+		return nil, err
+	} else if exists {
+		if cluster.Status.Phase == model.ClusterPhaseFailed {
+			plan.Resources = append(plan.Resources, model.PlanResource{Kind: "cluster", Name: req.Name, Action: model.PlanActionDelete, Message: fmt.Sprintf("Clean up a failed cluster (reason=%s)", cluster.Status.Reason)})
+		} else {
+			fail("", fmt.Sprintf("The cluster '%s' already exists. (namespace=%s)", req.Name, namespace))
+		}
+	}
+	cluster = model.NewCluster(namespace, req.Name)
+	setClusterParams(cluster, k8sVersion, req)
+
+	mcis := tumblebug.NewMCIS(namespace, req.Name)
+	if exists, err := mcis.GET(); err != nil {
+		fail(model.GetMCISFailedReason, err.Error())
+	} else if exists {
+		fail(model.AlreadyExistMCISFailedReason, fmt.Sprintf("MCIS already exists. (namespace=%s, mcis=%s)", namespace, req.Name))
+	}
+
+	// MCIRs & nodes (a MCIR per a node-set, shared MCIRs are planned once)
+	planned := map[string]bool{}
+	mcirs := []*MCIR{}
+	nodeSets := []app.NodeSetReq{req.ControlPlane[0]}
+	nodeSets = append(nodeSets, req.Worker...)
+	idx := 0
+	for i, nodeSet := range nodeSets {
+		role := app.WORKER
+		if i == 0 {
+			role = app.CONTROL_PLANE
+		}
+		mcir := NewMCIR(namespace, role, nodeSet, cluster)
+		resources, reason, msg := mcir.Plan()
+		if reason != "" {
+			fail(reason, msg)
+			continue
+		}
+		mcirs = append(mcirs, mcir)
+		for _, resource := range resources {
+			key := fmt.Sprintf("%s/%s", resource.Kind, resource.Name)
+			if !planned[key] {
+				planned[key] = true
+				plan.Resources = append(plan.Resources, resource)
+			}
+		}
+		for n := 0; n < nodeSet.Count; n++ {
+			nodeIdx := n + 1
+			if role == app.WORKER {
+				idx++
+				nodeIdx = idx
+			}
+			plan.Nodes = append(plan.Nodes, model.PlanNode{
+				Name:       fmt.Sprintf("%s-%d-*", string(role)[:1], nodeIdx),
+				Role:       role,
+				Connection: nodeSet.Connection,
+				Csp:        mcir.csp,
+				Region:     mcir.region,
+				Zone:       mcir.zone,
+				Spec:       nodeSet.Spec,
+			})
+		}
+	}
+	if err := verifyCsi(cluster, mcirs); err != nil {
+		fail(model.InvalidMCIRReason, err.Error())
+	}
+	plan.Resources = append(plan.Resources, model.PlanResource{Kind: "mcis", Name: req.Name, Action: model.PlanActionCreate, Message: fmt.Sprintf("%d VMs", len(plan.Nodes))})
+	plan.Valid = len(plan.Errors) == 0
+
+	return plan, nil
+}
@@ -0,0 +1,218 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"github.com/cloud-barista/cb-mcks/src/core/tumblebug"
+
+	logger "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v2"
+)
+
+/* a pricing table (conf/pricing.yaml) */
+type pricingTable struct {
+	Currency string `yaml:"currency"`
+	Prices   []struct {
+		Csp    app.CSP `yaml:"csp"`
+		Region string  `yaml:"region"`
+		Spec   string  `yaml:"spec"`
+		Hourly float64 `yaml:"hourly"`
+	} `yaml:"prices"`
+}
+
+const SPIDER_PRICE_RETRY_INTERVAL = time.Minute // a failure is cached for a while (not to call the CB-Spider on every request)
+
+/* prices refreshed from the CB-Spider (key : csp/region) */
+var spiderPrices = struct {
+	sync.Mutex
+	regions map[string]*spiderRegionPrices
+}{regions: map[string]*spiderRegionPrices{}}
+
+/* prices of a region (key : instance-type) */
+type spiderRegionPrices struct {
+	prices  map[string]float64
+	expires time.Time     // a failure expires (zero : never)
+	done    chan struct{} // closed when a request to the CB-Spider is completed
+}
+
+/* load a pricing table (re-read on every estimation, so it can be edited without a restart) */
+func loadPricingTable() (*pricingTable, error) {
+
+	table := &pricingTable{Currency: "USD"}
+	b, err := ioutil.ReadFile(*app.Config.PricingFile)
+	if err != nil {
+		logger.Warnf("Failed to read a pricing table. (path=%s, cause='%v')", *app.Config.PricingFile, err)
+		return table, nil
+	}
+	if err := yaml.Unmarshal(b, table); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to parse a pricing table. (path=%s, cause='%v')", *app.Config.PricingFile, err))
+	}
+	return table, nil
+}
+
+/* an hourly price of a spec (a pricing table > the CB-Spider price information) */
+func (self *pricingTable) hourly(csp app.CSP, region string, spec string, connection string) (float64, string) {
+
+	for _, price := range self.Prices {
+		if price.Csp == csp && strings.EqualFold(price.Spec, spec) && (price.Region == "" || price.Region == region) {
+			return price.Hourly, "table"
+		}
+	}
+
+	// the CB-Spider prices are not mixed with prices of another currency
+	if !strings.EqualFold(self.Currency, tumblebug.PRICE_CURRENCY) {
+		return 0, "unknown"
+	}
+	if hourly, exists := spiderHourly(csp, region, connection)[strings.ToLower(spec)]; exists {
+		return hourly, "spider"
+	}
+	return 0, "unknown"
+}
+
+/* on-demand prices of a region from the CB-Spider (all instance-types of a region are cached at once, a request is shared by concurrent callers) */
+func spiderHourly(csp app.CSP, region string, connection string) map[string]float64 {
+
+	if connection == "" || region == "" {
+		return map[string]float64{}
+	}
+	key := fmt.Sprintf("%s/%s", csp, region)
+
+	spiderPrices.Lock()
+	entry, exists := spiderPrices.regions[key]
+	if !exists || (!entry.expires.IsZero() && time.Now().After(entry.expires)) {
+		entry = &spiderRegionPrices{done: make(chan struct{})}
+		spiderPrices.regions[key] = entry
+		spiderPrices.Unlock()
+
+		// a request out of the lock (a price-client has a short timeout)
+		prices, expires := map[string]float64{}, time.Time{}
+		priceInfo := tumblebug.NewPriceInfo(connection, region)
+		if exists, err := priceInfo.GET(); err != nil {
+			logger.Warnf("Failed to get a price information. (key=%s, cause='%v')", key, err)
+			expires = time.Now().Add(SPIDER_PRICE_RETRY_INTERVAL)
+		} else if exists {
+			prices = priceInfo.OnDemandHourly()
+		}
+		spiderPrices.Lock()
+		entry.prices, entry.expires = prices, expires
+		spiderPrices.Unlock()
+		close(entry.done)
+	} else {
+		spiderPrices.Unlock()
+	}
+
+	<-entry.done
+	return entry.prices
+}
+
+/* estimate costs of a cluster request (hourly & monthly) */
+func EstimateCluster(namespace string, req *app.ClusterReq) (*model.CostEstimate, error) {
+
+	if err := verifyNamespace(namespace); err != nil {
+		return nil, err
+	}
+	table, err := loadPricingTable()
+	if err != nil {
+		return nil, err
+	}
+
+	estimate := newCostEstimate(table)
+	nodeSets := map[app.ROLE][]app.NodeSetReq{app.CONTROL_PLANE: req.ControlPlane, app.WORKER: req.Worker}
+	for _, role := range []app.ROLE{app.CONTROL_PLANE, app.WORKER} {
+		for _, nodeSet := range nodeSets[role] {
+			csp, region, err := lookupConnection(nodeSet.Connection)
+			if err != nil {
+				return nil, err
+			}
+			addCostItem(estimate, table, model.CostItem{Role: role, Connection: nodeSet.Connection, Csp: csp, Region: region, Spec: nodeSet.Spec, Count: nodeSet.Count})
+		}
+	}
+
+	return estimate, nil
+}
+
+/* a csp & a region name of a connection */
+func lookupConnection(name string) (app.CSP, string, error) {
+
+	connection := tumblebug.NewConnection(name)
+	if exists, err := connection.GET(); err != nil {
+		return "", "", errors.New(fmt.Sprintf("Failed to get a connection info. (%s)", name))
+	} else if !exists {
+		return "", "", errors.New(fmt.Sprintf("Connection does not exist. (%s)", name))
+	}
+	region := tumblebug.NewRegion(connection.RegionName)
+	if _, err := region.GET(); err != nil {
+		return "", "", errors.New(fmt.Sprintf("Failed to get a region data. (cause='%v')", err))
+	}
+	regionName := ""
+	for _, r := range region.KeyValueInfoList {
+		if r.Key == "Region" {
+			regionName = r.Value
+		}
+	}
+	return app.CSP(strings.ToLower(connection.ProviderName)), regionName, nil
+}
+
+/* estimate current costs of nodes (grouped by a role, a connection & a spec) */
+func estimateNodes(nodes []*model.Node) (*model.CostEstimate, error) {
+
+	table, err := loadPricingTable()
+	if err != nil {
+		return nil, err
+	}
+
+	estimate := newCostEstimate(table)
+	items := []model.CostItem{}
+	for _, node := range nodes {
+		item := model.CostItem{Role: node.Role, Connection: labelValueOf(node.ConnectionLabel), Csp: node.Csp, Region: labelValueOf(node.RegionLabel), Spec: node.Spec, Count: 1}
+		grouped := false
+		for i := range items {
+			if items[i].Role == item.Role && items[i].Connection == item.Connection && items[i].Csp == item.Csp && items[i].Region == item.Region && items[i].Spec == item.Spec {
+				items[i].Count++
+				grouped = true
+			}
+		}
+		if !grouped {
+			items = append(items, item)
+		}
+	}
+	for _, item := range items {
+		addCostItem(estimate, table, item)
+	}
+
+	return estimate, nil
+}
+
+func newCostEstimate(table *pricingTable) *model.CostEstimate {
+	return &model.CostEstimate{Kind: app.KIND_COST_ESTIMATE, Currency: table.Currency, Items: []model.CostItem{}}
+}
+
+/* price an item & add it to an estimate (an item without a price is excluded from totals) */
+func addCostItem(estimate *model.CostEstimate, table *pricingTable, item model.CostItem) {
+	item.UnitHourly, item.Source = table.hourly(item.Csp, item.Region, item.Spec, item.Connection)
+	item.Hourly = roundCost(item.UnitHourly * float64(item.Count))
+	item.Monthly = roundCost(item.Hourly * app.HOURS_PER_MONTH)
+	estimate.Items = append(estimate.Items, item)
+	estimate.Hourly = roundCost(estimate.Hourly + item.Hourly)
+	estimate.Monthly = roundCost(estimate.Hourly * app.HOURS_PER_MONTH)
+}
+
+func roundCost(value float64) float64 {
+	return math.Round(value*10000) / 10000
+}
+
+/* a value of a "<key>=<value>" label */
+func labelValueOf(label string) string {
+	if idx := strings.Index(label, "="); idx >= 0 {
+		return label[idx+1:]
+	}
+	return ""
+}
@@ -0,0 +1,43 @@
+package service
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+)
+
+func TestSpiderHourly(t *testing.T) {
+
+	// a failing CB-Spider
+	requests := int32(0)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&requests, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+	spiderUrl := *app.Config.SpiderUrl
+	*app.Config.SpiderUrl = server.URL
+	defer func() { *app.Config.SpiderUrl = spiderUrl }()
+
+	// a failure is cached for a while
+	for i := 0; i < 3; i++ {
+		if prices := spiderHourly(app.CSP_AWS, "test-region-1", "config-aws-test-region-1"); len(prices) != 0 {
+			t.Fatalf("Unexpected prices (prices=%v)", prices)
+		}
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("A failure should be cached (requests=%d)", n)
+	}
+
+	// the CB-Spider prices (USD) are not mixed with another currency
+	table := &pricingTable{Currency: "KRW"}
+	if hourly, source := table.hourly(app.CSP_AWS, "test-region-2", "t2.medium", "config-aws-test-region-2"); hourly != 0 || source != "unknown" {
+		t.Fatalf("Unexpected a price (hourly=%f, source=%s)", hourly, source)
+	}
+	if n := atomic.LoadInt32(&requests); n != 1 {
+		t.Fatalf("The CB-Spider should not be requested (requests=%d)", n)
+	}
+}
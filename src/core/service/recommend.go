@@ -0,0 +1,118 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/tumblebug"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+/* recommend specs of candidate connections that meet cpu & memory requirements (ranked by a price or the closest fit) */
+func RecommendSpecs(req app.SpecRecommendReq) (*SpecRecommendList, error) {
+
+	table, err := loadPricingTable()
+	if err != nil {
+		return nil, err
+	}
+
+	// requirements (not less than the minimums verified on a cluster creation)
+	cpuMin, memoryMin := req.Cpu, req.Memory
+	if req.Role == app.CONTROL_PLANE && cpuMin < app.SPEC_MIN_CPU_CONTROL_PLANE {
+		cpuMin = app.SPEC_MIN_CPU_CONTROL_PLANE
+	}
+	if memoryMin < app.SPEC_MIN_MEMORY_GIB {
+		memoryMin = app.SPEC_MIN_MEMORY_GIB
+	}
+
+	items := []SpecRecommend{}
+	for _, connection := range req.Connections {
+		csp, region, err := lookupConnection(connection)
+		if err != nil {
+			return nil, err
+		}
+		lookupSpecs := tumblebug.NewLookupSpecs(connection)
+		if exists, err := lookupSpecs.GET(); err != nil {
+			return nil, errors.New(fmt.Sprintf("Failed to lookup spec. (connection=%s, cause='%v')", connection, err))
+		} else if !exists {
+			logger.Warnf("Could not be found a specList. (connection=%s)", connection)
+			continue
+		}
+		for _, spec := range lookupSpecs.Vmspecs {
+			cpu, err := strconv.Atoi(spec.CPU.Count)
+			if err != nil {
+				continue
+			}
+			mem, err := strconv.Atoi(spec.Memory)
+			if err != nil {
+				continue
+			}
+			if cpu < cpuMin || mem/1024 < memoryMin {
+				continue
+			}
+			item := SpecRecommend{
+				Connection: connection,
+				Csp:        csp,
+				Region:     region,
+				Spec:       spec.Name,
+				Cpu:        cpu,
+				Memory:     roundCost(float64(mem) / 1024),
+				Fit:        roundCost(surplus(float64(cpu), float64(cpuMin)) + surplus(float64(mem)/1024, float64(memoryMin))),
+			}
+			item.Hourly, item.Source = table.hourly(csp, region, spec.Name, connection)
+			item.Monthly = roundCost(item.Hourly * app.HOURS_PER_MONTH)
+			items = append(items, item)
+		}
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		a, b := items[i], items[j]
+		byPrice := func() (bool, bool) {
+			if (a.Source == "unknown") != (b.Source == "unknown") {
+				return b.Source == "unknown", true
+			}
+			if a.Hourly != b.Hourly {
+				return a.Hourly < b.Hourly, true
+			}
+			return false, false
+		}
+		byFit := func() (bool, bool) {
+			if a.Fit != b.Fit {
+				return a.Fit < b.Fit, true
+			}
+			return false, false
+		}
+		orders := []func() (bool, bool){byPrice, byFit}
+		if req.Sort == app.SPEC_SORT_FIT {
+			orders = []func() (bool, bool){byFit, byPrice}
+		}
+		for _, order := range orders {
+			if less, decided := order(); decided {
+				return less
+			}
+		}
+		if a.Connection != b.Connection {
+			return a.Connection < b.Connection
+		}
+		return a.Spec < b.Spec
+	})
+
+	if len(items) > req.Limit {
+		items = items[:req.Limit]
+	}
+	for i := range items {
+		items[i].Rank = i + 1
+	}
+
+	return &SpecRecommendList{Kind: app.KIND_SPEC_RECOMMEND_LIST, Role: req.Role, Cpu: cpuMin, Memory: memoryMin, Sort: req.Sort, Currency: table.Currency, Items: items}, nil
+}
+
+/* a surplus ratio of a value over a requirement */
+func surplus(value float64, required float64) float64 {
+	return (value - required) / math.Max(required, 1)
+}
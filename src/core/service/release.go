@@ -0,0 +1,191 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+	"github.com/cloud-barista/cb-mcks/src/core/provision"
+	"github.com/cloud-barista/cb-mcks/src/utils/lang"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+/* get releases */
+func ListRelease(namespace string, clusterName string) (*model.ReleaseList, error) {
+
+	if _, err := getProvisionedCluster(namespace, clusterName); err != nil {
+		return nil, err
+	}
+
+	releases := model.NewReleaseList(namespace, clusterName)
+	if err := releases.SelectList(); err != nil {
+		return nil, err
+	}
+	return releases, nil
+}
+
+/* get a release */
+func GetRelease(namespace string, clusterName string, releaseName string) (*model.Release, error) {
+
+	if _, err := getProvisionedCluster(namespace, clusterName); err != nil {
+		return nil, err
+	}
+
+	release := model.NewRelease(namespace, clusterName, releaseName)
+	if exists, err := release.Select(); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New(fmt.Sprintf("Could not be found a release '%s'. (namespace=%s, cluster=%s)", releaseName, namespace, clusterName))
+	}
+	return release, nil
+}
+
+/* install a release */
+func InstallRelease(ctx context.Context, namespace string, clusterName string, req *app.ReleaseReq) (*model.Release, error) {
+
+	cluster, err := getProvisionedCluster(namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+	if req.Chart == "" {
+		return nil, errors.New("Chart is empty")
+	}
+
+	release := model.NewRelease(namespace, clusterName, req.Name)
+	if exists, err := release.Select(); err != nil {
+		return nil, err
+	} else if exists {
+		// a release name is unique in a cluster (not per kubernetes namespace)
+		return nil, errors.New(fmt.Sprintf("The release '%s' already exists in kubernetes namespace '%s'. (namespace=%s, cluster=%s)", req.Name, release.Namespace, namespace, clusterName))
+	}
+	release.Namespace = lang.NVL(req.Namespace, "default")
+	release.Chart = req.Chart
+	release.Repo = req.Repo
+	release.ChartVersion = req.Version
+	release.Values = req.Values
+
+	provisioner := provision.NewProvisioner(cluster)
+	if err := provisioner.InstallRelease(ctx, release, false); err != nil {
+		return nil, err
+	}
+	release.UpdatedTime = lang.GetNowUTC()
+	if err := release.PutStore(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to add a release-entity. (cause='%v')", err))
+	}
+
+	logger.Infof("[%s.%s] Release installation has been completed. (release=%s, chart=%s, version=%s)", namespace, clusterName, release.Name, release.Chart, release.ChartVersion)
+	return release, nil
+}
+
+/* upgrade a release (if chart, repo or version is empty, a installed one is used) */
+func UpgradeRelease(ctx context.Context, namespace string, clusterName string, releaseName string, req *app.ReleaseReq) (*model.Release, error) {
+
+	cluster, err := getProvisionedCluster(namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	release := model.NewRelease(namespace, clusterName, releaseName)
+	if exists, err := release.Select(); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New(fmt.Sprintf("Could not be found a release '%s'. (namespace=%s, cluster=%s)", releaseName, namespace, clusterName))
+	}
+	release.Chart = lang.NVL(req.Chart, release.Chart)
+	release.Repo = lang.NVL(req.Repo, release.Repo)
+	release.ChartVersion = lang.NVL(req.Version, release.ChartVersion)
+	// if values are omitted, the installed values are reused (--reuse-values)
+	values := lang.NVL(req.Values, release.Values)
+	release.Values = req.Values
+
+	provisioner := provision.NewProvisioner(cluster)
+	if err := provisioner.InstallRelease(ctx, release, true); err != nil {
+		return nil, err
+	}
+	release.Values = values
+	release.UpdatedTime = lang.GetNowUTC()
+	if err := release.PutStore(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to update a release-entity. (cause='%v')", err))
+	}
+
+	logger.Infof("[%s.%s] Release upgrade has been completed. (release=%s, revision=%d)", namespace, clusterName, release.Name, release.Revision)
+	return release, nil
+}
+
+/* rollback a release */
+func RollbackRelease(ctx context.Context, namespace string, clusterName string, releaseName string, req *app.ReleaseRollbackReq) (*model.Release, error) {
+
+	cluster, err := getProvisionedCluster(namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	release := model.NewRelease(namespace, clusterName, releaseName)
+	if exists, err := release.Select(); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New(fmt.Sprintf("Could not be found a release '%s'. (namespace=%s, cluster=%s)", releaseName, namespace, clusterName))
+	}
+
+	provisioner := provision.NewProvisioner(cluster)
+	if err := provisioner.RollbackRelease(ctx, release, req.Revision); err != nil {
+		return nil, err
+	}
+	release.UpdatedTime = lang.GetNowUTC()
+	if err := release.PutStore(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to update a release-entity. (cause='%v')", err))
+	}
+
+	logger.Infof("[%s.%s] Release rollback has been completed. (release=%s, revision=%d)", namespace, clusterName, release.Name, release.Revision)
+	return release, nil
+}
+
+/* uninstall a release */
+func UninstallRelease(ctx context.Context, namespace string, clusterName string, releaseName string) (*app.Status, error) {
+
+	cluster, err := getProvisionedCluster(namespace, clusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	release := model.NewRelease(namespace, clusterName, releaseName)
+	if exists, err := release.Select(); err != nil {
+		return nil, err
+	} else if !exists {
+		return app.NewStatus(app.STATUS_NOTFOUND, fmt.Sprintf("Could not be found a release '%s'", releaseName)), nil
+	}
+
+	provisioner := provision.NewProvisioner(cluster)
+	if err := provisioner.UninstallRelease(ctx, release); err != nil {
+		return nil, err
+	}
+	if err := release.Delete(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Failed to delete a release-entity. (cause='%v')", err))
+	}
+
+	logger.Infof("[%s.%s] Release uninstallation has been completed. (release=%s)", namespace, clusterName, releaseName)
+	return app.NewStatus(app.STATUS_SUCCESS, fmt.Sprintf("Release '%s' has been uninstalled", releaseName)), nil
+}
+
+/* get a provisioned cluster-entity */
+func getProvisionedCluster(namespace string, clusterName string) (*model.Cluster, error) {
+
+	// validate namespace
+	if err := verifyNamespace(namespace); err != nil {
+		return nil, err
+	}
+
+	// get a cluster-entity
+	cluster := model.NewCluster(namespace, clusterName)
+	if exists, err := cluster.Select(); err != nil {
+		return nil, err
+	} else if !exists {
+		return nil, errors.New(fmt.Sprintf("Could not be found a cluster '%s'. (namespace=%s)", clusterName, namespace))
+	} else if cluster.Status.Phase != model.ClusterPhaseProvisioned {
+		return nil, errors.New(fmt.Sprintf("The cluster is not provisioned. status is '%s'.", cluster.Status.Phase))
+	}
+	return cluster, nil
+}
@@ -0,0 +1,49 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/model"
+)
+
+func TestUpgradeReleaseReuseValues(t *testing.T) {
+
+	useFakeTumblebug(t)
+	executor := useFakeExecutor(t)
+	deleteTestCluster(t, "cluster-release-test")
+
+	if cluster, err := CreateCluster(context.Background(), testNamespace, "1.23", "14", newTestClusterReq("cluster-release-test")); err != nil || cluster.Status.Phase != model.ClusterPhaseProvisioned {
+		t.Fatalf("CreateCluster error (cause=%v)", err)
+	}
+	executor.On("helm install").Return(`{"name":"my-nginx","namespace":"web","version":1,"info":{"status":"deployed"}}`)
+	executor.On("helm upgrade").Return(`{"name":"my-nginx","namespace":"web","version":2,"info":{"status":"deployed"}}`)
+
+	// install a release with values
+	if _, err := InstallRelease(context.Background(), testNamespace, "cluster-release-test", &app.ReleaseReq{Name: "my-nginx", Namespace: "web", Chart: "nginx", Values: "replicaCount: 2"}); err != nil {
+		t.Fatalf("InstallRelease error (cause=%v)", err)
+	}
+
+	// upgrade without values (installed values are reused)
+	release, err := UpgradeRelease(context.Background(), testNamespace, "cluster-release-test", "my-nginx", &app.ReleaseReq{Version: "13.2.11"})
+	if err != nil {
+		t.Fatalf("UpgradeRelease error (cause=%v)", err)
+	}
+	if commands := executor.Find("helm upgrade"); len(commands) != 1 || !strings.Contains(commands[0].Command, "--reuse-values") || strings.Contains(commands[0].Command, " -f ") {
+		t.Fatalf("Unexpected upgrade commands (commands=%v)", commands)
+	}
+	stored := model.NewRelease(testNamespace, "cluster-release-test", "my-nginx")
+	if _, err := stored.Select(); err != nil {
+		t.Fatalf("Select error (cause=%v)", err)
+	}
+	if release.Values != "replicaCount: 2" || stored.Values != "replicaCount: 2" || stored.Revision != 2 {
+		t.Fatalf("Values are not kept (values=%s, stored=%s, revision=%d)", release.Values, stored.Values, stored.Revision)
+	}
+
+	// a same name in another kubernetes namespace
+	if _, err := InstallRelease(context.Background(), testNamespace, "cluster-release-test", &app.ReleaseReq{Name: "my-nginx", Namespace: "default", Chart: "nginx"}); err == nil || !strings.Contains(err.Error(), "kubernetes namespace 'web'") {
+		t.Fatalf("A duplicated release is not rejected (cause=%v)", err)
+	}
+}
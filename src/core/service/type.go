@@ -1,16 +1,72 @@
 package service
 
+import "github.com/cloud-barista/cb-mcks/src/core/app"
+
 type SpecList struct {
-	Kind    string    `json:"kind"`
-	Config  string    `json:"connectionName"`
-	Vmspecs []Vmspecs `json:"items"`
+	Kind       string    `json:"kind"`
+	Config     string    `json:"connectionName"`
+	Vmspecs    []Vmspecs `json:"items"`
+	NextCursor string    `json:"nextCursor,omitempty"` // a cursor of the next page
 }
 
 type Vmspecs struct {
 	Name   string `json:"name"`   // output
-	Memory string `json:"memory"` // output
+	Memory string `json:"memory"` // output - GiB
 	CPU    struct {
 		Count string `json:"count"` // output
 		Clock string `json:"clock"` // output - GHz
 	} `json:"cpu"`
+	Arch      string      `json:"arch"`           // output - x86_64, arm64
+	Gpus      []VmspecGpu `json:"gpus,omitempty"` // output
+	cpuCount  int
+	memoryGiB float64
+	clockGHz  float64
+}
+
+type VmspecGpu struct {
+	Count  string `json:"count"`
+	Mfr    string `json:"mfr"`
+	Model  string `json:"model"`
+	Memory string `json:"memory"` // MB
+}
+
+type SpecRecommendList struct {
+	Kind     app.Kind        `json:"kind"`
+	Role     app.ROLE        `json:"role"`
+	Cpu      int             `json:"cpu"`
+	Memory   int             `json:"memory"` // GiB
+	Sort     app.SpecSort    `json:"sort"`
+	Currency string          `json:"currency"`
+	Items    []SpecRecommend `json:"items"`
+}
+
+type SpecRecommend struct {
+	Rank       int     `json:"rank"`
+	Connection string  `json:"connection"`
+	Csp        app.CSP `json:"csp"`
+	Region     string  `json:"region"`
+	Spec       string  `json:"spec"`
+	Cpu        int     `json:"cpu"`
+	Memory     float64 `json:"memory"` // GiB
+	Hourly     float64 `json:"hourly"` // 0 if unknown
+	Monthly    float64 `json:"monthly"`
+	Source     string  `json:"source"` // table, spider, unknown
+	Fit        float64 `json:"fit"`    // surplus ratio of cpu & memory over a requirement (0 = exact fit)
+}
+
+type ConnectionList struct {
+	Kind  app.Kind         `json:"kind"`
+	Items []ConnectionInfo `json:"items"`
+}
+
+type ConnectionInfo struct {
+	Name            string  `json:"name"`
+	Csp             app.CSP `json:"csp"`
+	Region          string  `json:"region"`
+	Zone            string  `json:"zone"`
+	RegionName      string  `json:"regionName"` // a region name of the CB-Spider
+	CredentialName  string  `json:"credentialName"`
+	Supported       bool    `json:"supported"`       // the MCKS supports a CSP
+	ImageResolvable bool    `json:"imageResolvable"` // an ubuntu 18.04 image can be resolved
+	Message         string  `json:"message,omitempty"`
 }
@@ -9,7 +9,6 @@ import (
 
 	"github.com/beego/beego/v2/core/validation"
 	"github.com/cloud-barista/cb-mcks/src/core/app"
-	"github.com/go-resty/resty/v2"
 
 	logger "github.com/sirupsen/logrus"
 )
@@ -31,13 +30,13 @@ func (self *Model) execute(method string, url string, body interface{}, result i
 	}
 
 	// response check
-	if resp.StatusCode() == http.StatusNotFound {
+	if resp.StatusCode == http.StatusNotFound {
 		logger.Infof("[%s] Could not be found data. (method=%s, url='%s')", self.Name, method, url)
 		return false, nil
-	} else if resp.StatusCode() > 300 {
-		logger.Warnf("[%s] Received error data from the Tumblebug (statusCode=%d, url='%s', body='%v')", self.Name, resp.StatusCode(), resp.Request.URL, resp)
+	} else if resp.StatusCode > 300 {
+		logger.Warnf("[%s] Received error data from the Tumblebug (statusCode=%d, url='%s', body='%v')", self.Name, resp.StatusCode, url, string(resp.Body))
 		status := app.Status{}
-		json.Unmarshal(resp.Body(), &status)
+		json.Unmarshal(resp.Body, &status)
 		// message > message 로 리턴되는 경우가 있어서 한번더 unmarshal 작업
 		if json.Valid([]byte(status.Message)) {
 			json.Unmarshal([]byte(status.Message), &status)
@@ -50,19 +49,9 @@ func (self *Model) execute(method string, url string, body interface{}, result i
 }
 
 /* execute HTTP */
-func executeHTTP(method string, url string, body interface{}, result interface{}) (*resty.Response, error) {
+func executeHTTP(method string, url string, body interface{}, result interface{}) (*Response, error) {
 
-	req := resty.New().SetDisableWarn(true).R().SetBasicAuth(*app.Config.Username, *app.Config.Password)
-
-	if body != nil {
-		req.SetBody(body)
-	}
-	if result != nil {
-		req.SetResult(result)
-	}
-
-	// execute
-	return req.Execute(method, url)
+	return DefaultClient.Execute(method, url, body, result)
 
 }
 
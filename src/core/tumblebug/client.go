@@ -0,0 +1,46 @@
+package tumblebug
+
+import (
+	"time"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/go-resty/resty/v2"
+)
+
+/* a HTTP client of the Tumblebug & the CB-Spider (urls are absolute) */
+type Client interface {
+	Execute(method string, url string, body interface{}, result interface{}) (*Response, error)
+}
+
+/* a response (a result is unmarshaled if a response is JSON) */
+type Response struct {
+	StatusCode int
+	Body       []byte
+}
+
+/* a default client (tests may replace it) */
+var DefaultClient Client = &RESTClient{}
+
+/* a REST client (basic-auth, no timeout if a timeout is zero) */
+type RESTClient struct {
+	Timeout time.Duration
+}
+
+func (self *RESTClient) Execute(method string, url string, body interface{}, result interface{}) (*Response, error) {
+
+	req := resty.New().SetDisableWarn(true).SetTimeout(self.Timeout).R().SetBasicAuth(*app.Config.Username, *app.Config.Password)
+
+	if body != nil {
+		req.SetBody(body)
+	}
+	if result != nil {
+		req.SetResult(result)
+	}
+
+	// execute
+	resp, err := req.Execute(method, url)
+	if err != nil {
+		return nil, err
+	}
+	return &Response{StatusCode: resp.StatusCode(), Body: resp.Body()}, nil
+}
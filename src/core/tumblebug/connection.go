@@ -1,8 +1,14 @@
 package tumblebug
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+
+	logger "github.com/sirupsen/logrus"
 )
 
 /* instance of a Connection Info. */
@@ -13,6 +19,21 @@ func NewConnection(name string) *Connection {
 	}
 }
 
+/* instance of a Connection list */
+func NewConnectionList() *ConnectionList {
+	return &ConnectionList{
+		Model: Model{Name: "connections"},
+	}
+}
+
+/* instance of a Credential */
+func NewCredential(name string) *Credential {
+	return &Credential{
+		Model:          Model{Name: name},
+		CredentialName: name,
+	}
+}
+
 /* instance of a Region */
 func NewRegion(name string) *Region {
 	return &Region{
@@ -28,9 +49,36 @@ func (self *Connection) GET() (bool, error) {
 
 }
 
+// get a connection list
+func (self *ConnectionList) GET() (bool, error) {
+
+	return self.execute(http.MethodGet, "/connConfig", nil, &self)
+
+}
+
 // get a region
 func (self *Region) GET() (bool, error) {
 
 	return self.execute(http.MethodGet, fmt.Sprintf("/region/%s", self.RegionName), nil, &self)
 
 }
+
+// get a credential (the cb-spider, because the Tumblebug does not provide key-values of a credential)
+func (self *Credential) GET() (bool, error) {
+
+	url := fmt.Sprintf("%s/credential/%s", *app.Config.SpiderUrl, self.CredentialName)
+	resp, err := executeHTTP(http.MethodGet, url, nil, &self)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		logger.Infof("[%s] Could not be found data. (method=%s, url='%s')", self.Name, http.MethodGet, url)
+		return false, nil
+	} else if resp.StatusCode > 300 {
+		status := app.Status{}
+		json.Unmarshal(resp.Body, &status)
+		return false, errors.New(fmt.Sprintf("Failed to get a credential from the CB-Spider (statusCode=%d, message='%s')", resp.StatusCode, status.Message))
+	}
+	return true, nil
+
+}
@@ -0,0 +1,152 @@
+package fake
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/tumblebug"
+)
+
+func TestMain(m *testing.M) {
+	if os.Getenv("APP_ROOT") == "" {
+		root, _ := filepath.Abs("../../../..")
+		os.Setenv("APP_ROOT", root)
+	}
+	app.Setup()
+	os.Exit(m.Run())
+}
+
+func newTestServer(t *testing.T) *Server {
+
+	server := NewServer()
+	server.AddNamespace("ns-1").AddConnection("config-aws-ap-northeast-2", "aws", "ap-northeast-2", "ap-northeast-2a").AddSpec("config-aws-ap-northeast-2", "t3.medium", 2, 4096)
+	restore := server.Use()
+	t.Cleanup(func() {
+		restore()
+		server.Close()
+	})
+	return server
+}
+
+func TestConnectionAndSpec(t *testing.T) {
+
+	newTestServer(t)
+
+	if exists, err := tumblebug.NewNS("ns-1").GET(); err != nil || !exists {
+		t.Fatalf("Namespace should exist (exists=%v, cause=%v)", exists, err)
+	}
+	if exists, err := tumblebug.NewNS("ns-2").GET(); err != nil || exists {
+		t.Fatalf("Namespace should not exist (exists=%v, cause=%v)", exists, err)
+	}
+
+	connection := tumblebug.NewConnection("config-aws-ap-northeast-2")
+	if exists, err := connection.GET(); err != nil || !exists {
+		t.Fatalf("Connection should exist (exists=%v, cause=%v)", exists, err)
+	} else if connection.ProviderName != "AWS" {
+		t.Fatalf("Unexpected provider (%s)", connection.ProviderName)
+	}
+	region := tumblebug.NewRegion(connection.RegionName)
+	if exists, err := region.GET(); err != nil || !exists || len(region.KeyValueInfoList) != 2 {
+		t.Fatalf("Region should exist (exists=%v, cause=%v, keyValues=%v)", exists, err, region.KeyValueInfoList)
+	}
+	credential := tumblebug.NewCredential(connection.CredentialName)
+	if exists, err := credential.GET(); err != nil || !exists {
+		t.Fatalf("Credential should exist (exists=%v, cause=%v)", exists, err)
+	}
+
+	spec := tumblebug.NewLookupSpec("config-aws-ap-northeast-2", "t3.medium")
+	if exists, err := spec.GET(); err != nil || !exists {
+		t.Fatalf("Spec should exist (exists=%v, cause=%v)", exists, err)
+	} else if spec.CPU.Count != "2" || spec.Memory != "4096" {
+		t.Fatalf("Unexpected spec (cpu=%s, memory=%s)", spec.CPU.Count, spec.Memory)
+	}
+	specs := tumblebug.NewLookupSpecs("config-aws-ap-northeast-2")
+	if exists, err := specs.GET(); err != nil || !exists || len(specs.Vmspecs) != 1 {
+		t.Fatalf("Unexpected specs (exists=%v, cause=%v, specs=%v)", exists, err, specs.Vmspecs)
+	}
+}
+
+func TestResourcesAndMCIS(t *testing.T) {
+
+	server := newTestServer(t)
+
+	// resources
+	vpc := tumblebug.NewVPC("ns-1", "config-aws-ap-northeast-2-vpc", "config-aws-ap-northeast-2", "192.168.0.0/16")
+	if exists, err := vpc.GET(); err != nil || exists {
+		t.Fatalf("VPC should not exist (exists=%v, cause=%v)", exists, err)
+	}
+	if err := vpc.POST(); err != nil {
+		t.Fatalf("VPC.POST error (cause=%v)", err)
+	}
+	sshKey := tumblebug.NewSSHKey("ns-1", "config-aws-ap-northeast-2-sshkey", "config-aws-ap-northeast-2")
+	if err := sshKey.POST(); err != nil {
+		t.Fatalf("SSHKey.POST error (cause=%v)", err)
+	} else if sshKey.PrivateKey != PRIVATE_KEY {
+		t.Fatalf("Unexpected private key (%s)", sshKey.PrivateKey)
+	}
+	spec := tumblebug.NewSpec("ns-1", "config-aws-ap-northeast-2-t3-medium-spec", "config-aws-ap-northeast-2")
+	spec.CspSpecName = "t3.medium"
+	if err := spec.POST(); err != nil {
+		t.Fatalf("Spec.POST error (cause=%v)", err)
+	}
+
+	// mcis & vm
+	mcis := tumblebug.NewMCIS("ns-1", "cluster-1")
+	vm := tumblebug.NewVM("ns-1", "c-1-abcde", "cluster-1")
+	vm.Config = "config-aws-ap-northeast-2"
+	vm.Spec = spec.Name
+	mcis.VMs = append(mcis.VMs, *vm)
+	if err := mcis.POST(); err != nil {
+		t.Fatalf("MCIS.POST error (cause=%v)", err)
+	}
+	if len(mcis.VMs) != 1 || mcis.VMs[0].PublicIP == "" || mcis.VMs[0].Region.Zone != "ap-northeast-2a" || mcis.VMs[0].CspViewVmDetail.VMSpecName != "t3.medium" {
+		t.Fatalf("Unexpected VMs (%v)", mcis.VMs)
+	}
+	worker := tumblebug.NewVM("ns-1", "w-1-abcde", "cluster-1")
+	worker.Config = "config-aws-ap-northeast-2"
+	if err := worker.POST(); err != nil || worker.PublicIP == "" || worker.PublicIP == mcis.VMs[0].PublicIP {
+		t.Fatalf("Unexpected a worker VM (ip=%s, cause=%v)", worker.PublicIP, err)
+	}
+	if len(server.MCIS("ns-1", "cluster-1").VMs) != 2 {
+		t.Fatalf("A worker VM is not added to a MCIS")
+	}
+	if exists, err := worker.DELETE(); err != nil || !exists {
+		t.Fatalf("VM.DELETE error (exists=%v, cause=%v)", exists, err)
+	}
+
+	// clean-up
+	if err := mcis.TERMINATE(); err != nil {
+		t.Fatalf("MCIS.TERMINATE error (cause=%v)", err)
+	}
+	if exists, err := mcis.DELETE(); err != nil || !exists {
+		t.Fatalf("MCIS.DELETE error (exists=%v, cause=%v)", exists, err)
+	}
+	if server.MCIS("ns-1", "cluster-1") != nil {
+		t.Fatalf("A MCIS is not deleted")
+	}
+	if exists, err := vpc.DELETE(); err != nil || !exists || server.ExistsResource("ns-1", "vNet", vpc.Name) {
+		t.Fatalf("VPC.DELETE error (exists=%v, cause=%v)", exists, err)
+	}
+}
+
+func TestFailure(t *testing.T) {
+
+	server := newTestServer(t)
+	server.Fail(http.MethodPost, "/resources/vNet").Message("quota exceeded").Times(1)
+
+	vpc := tumblebug.NewVPC("ns-1", "config-aws-ap-northeast-2-vpc", "config-aws-ap-northeast-2", "192.168.0.0/16")
+	if err := vpc.POST(); err == nil || err.Error() != "quota exceeded" {
+		t.Fatalf("VPC.POST should be failed (cause=%v)", err)
+	}
+	if err := vpc.POST(); err != nil {
+		t.Fatalf("VPC.POST error (cause=%v)", err)
+	}
+
+	server.Fail("", "/connConfig").Status(http.StatusNotFound)
+	if exists, err := tumblebug.NewConnection("config-aws-ap-northeast-2").GET(); err != nil || exists {
+		t.Fatalf("Connection should not be found (exists=%v, cause=%v)", exists, err)
+	}
+}
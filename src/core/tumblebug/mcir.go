@@ -3,9 +3,12 @@ package tumblebug
 import (
 	"fmt"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/beego/beego/v2/core/validation"
 	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/utils/lang"
 )
 
 /* new instance of VPC */
@@ -23,8 +26,8 @@ func NewVPC(ns string, name string, conf string, cidrBlock string) *VPC {
 	}
 }
 
-/* new instance of Firewall */
-func NewFirewall(csp app.CSP, ns string, name string, conf string) *Firewall {
+/* new instance of Firewall (rules : additional rules which a network-cni requires, appended if not allowed yet) */
+func NewFirewall(csp app.CSP, ns string, name string, conf string, rules ...FirewallRules) *Firewall {
 
 	fw := &Firewall{
 		Model:  Model{Name: name, Namespace: ns},
@@ -44,6 +47,11 @@ func NewFirewall(csp app.CSP, ns string, name string, conf string) *Firewall {
 	} else {
 		fw.FirewallRules = append(fw.FirewallRules, FirewallRules{Protocol: "icmp", Direction: "inbound", From: "-1", To: "-1"})
 	}
+	for _, rule := range rules {
+		if !fw.Allows(rule) {
+			fw.FirewallRules = append(fw.FirewallRules, rule)
+		}
+	}
 
 	return fw
 }
@@ -153,6 +161,33 @@ func (self *Firewall) POST() error {
 	return nil
 }
 
+/* whether a firewall allows a rule (protocol, direction & port-range) */
+func (self *Firewall) Allows(rule FirewallRules) bool {
+
+	isAll := func(v string) bool {
+		return v == "" || v == "-1" || strings.EqualFold(v, "ALL")
+	}
+	toInt := func(v string, def int) int {
+		if i, err := strconv.Atoi(v); err == nil {
+			return i
+		}
+		return def
+	}
+
+	for _, r := range self.FirewallRules {
+		if !strings.EqualFold(r.Direction, rule.Direction) {
+			continue
+		}
+		if !isAll(r.Protocol) && !strings.EqualFold(r.Protocol, rule.Protocol) {
+			continue
+		}
+		if isAll(r.From) || (toInt(r.From, 0) <= toInt(rule.From, 0) && toInt(lang.NVL(r.To, r.From), 0) >= toInt(lang.NVL(rule.To, rule.From), 0)) {
+			return true
+		}
+	}
+	return false
+}
+
 func (self *Firewall) DELETE(ns string) (bool, error) {
 
 	exist, err := self.GET()
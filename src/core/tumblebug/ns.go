@@ -20,7 +20,7 @@ func (self *NS) GET() (bool, error) {
 	if err != nil {
 		return false, err
 	}
-	if resp.StatusCode() == http.StatusNotFound {
+	if resp.StatusCode == http.StatusNotFound {
 		return false, nil
 	}
 
@@ -0,0 +1,77 @@
+package tumblebug
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+const (
+	PRICE_CURRENCY = "USD" // a currency of prices (only USD prices are used)
+	PRICE_TIMEOUT  = 5 * time.Second
+)
+
+/* a client of a price information (a short timeout, a price information is optional to callers) */
+var PriceClient Client = &RESTClient{Timeout: PRICE_TIMEOUT}
+
+/* instance of a price information of compute instances in a region */
+func NewPriceInfo(connection string, region string) *PriceInfo {
+	return &PriceInfo{
+		Model:          Model{Name: region},
+		ConnectionName: connection,
+		RegionName:     region,
+		FilterList:     []KeyValue{},
+	}
+}
+
+// get a price information (the cb-spider)
+func (self *PriceInfo) GET() (bool, error) {
+
+	url := fmt.Sprintf("%s/priceinfo/ComputeInstance/%s", *app.Config.SpiderUrl, self.RegionName)
+	resp, err := PriceClient.Execute(http.MethodPost, url, self, &self)
+	if err != nil {
+		return false, err
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		logger.Infof("[%s] Could not be found data. (method=%s, url='%s')", self.Name, http.MethodPost, url)
+		return false, nil
+	} else if resp.StatusCode > 300 {
+		status := app.Status{}
+		json.Unmarshal(resp.Body, &status)
+		return false, errors.New(fmt.Sprintf("Failed to get a price information from the CB-Spider (statusCode=%d, message='%s')", resp.StatusCode, status.Message))
+	}
+	return true, nil
+
+}
+
+/* on-demand hourly prices (PRICE_CURRENCY) of specs (key : instance-type) */
+func (self *PriceInfo) OnDemandHourly() map[string]float64 {
+
+	prices := map[string]float64{}
+	for _, cloud := range self.CloudPriceList {
+		for _, price := range cloud.PriceList {
+			spec := strings.ToLower(price.ProductInfo.InstanceType)
+			if _, exists := prices[spec]; exists || spec == "" {
+				continue
+			}
+			for _, policy := range price.PriceInfo.PricingPolicies {
+				if !strings.EqualFold(policy.PricingPolicy, "OnDemand") || !strings.EqualFold(policy.Currency, PRICE_CURRENCY) || !strings.HasPrefix(strings.ToLower(policy.Unit), "h") {
+					continue
+				}
+				if hourly, err := strconv.ParseFloat(policy.Price, 64); err == nil && hourly > 0 {
+					prices[spec] = hourly
+					break
+				}
+			}
+		}
+	}
+	return prices
+}
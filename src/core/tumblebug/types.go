@@ -44,6 +44,44 @@ type Connection struct {
 	RegionName     string `json:"RegionName"`
 }
 
+// Connection list
+type ConnectionList struct {
+	Model
+	Connections []Connection `json:"connectionconfig"`
+}
+
+// Credential (cb-spider)
+type Credential struct {
+	Model
+	CredentialName   string     `json:"CredentialName"`
+	ProviderName     string     `json:"ProviderName"`
+	KeyValueInfoList []KeyValue `json:"KeyValueInfoList"`
+}
+
+// Price information (cb-spider)
+type PriceInfo struct {
+	Model
+	ConnectionName string           `json:"ConnectionName"`
+	FilterList     []KeyValue       `json:"FilterList"`
+	RegionName     string           `json:"-"`
+	CloudPriceList []CloudPriceList `json:"cloudPriceList"` // output
+}
+type CloudPriceList struct {
+	PriceList []struct {
+		ProductInfo struct {
+			InstanceType string `json:"instanceType"`
+		} `json:"productInfo"`
+		PriceInfo struct {
+			PricingPolicies []struct {
+				PricingPolicy string `json:"pricingPolicy"`
+				Unit          string `json:"unit"`
+				Currency      string `json:"currency"`
+				Price         string `json:"price"`
+			} `json:"pricingPolicies"`
+		} `json:"priceInfo"`
+	} `json:"priceList"`
+}
+
 type Region struct {
 	Model
 	RegionName       string     `json:"RegionName"`
@@ -132,6 +170,13 @@ type LookupSpecs struct {
 			Count string `json:"count"` // output
 			Clock string `json:"clock"` // output - GHz
 		} `json:"vcpu"`
+		Gpu []struct {
+			Count  string `json:"count"`
+			Mfr    string `json:"mfr"`
+			Model  string `json:"model"`
+			Memory string `json:"mem"` // MB
+		} `json:"gpu"` // output
+		KeyValueList []KeyValue `json:"keyValueList"` // output
 	} `json:"vmspec"`
 }
 
@@ -178,12 +223,21 @@ type VM struct {
 	PrivateIP     string   `json:"privateIP"`     // output
 	Status        VMStatus `json:"status"`        // output
 	SystemMessage string   `json:"systemMessage"` // output
+	Id            string   `json:"id"`            // output
 	Region        struct {
 		Region string `json:"region"`
 		Zone   string `json:"zone"`
 	} `json:"region"` // output
 	CspViewVmDetail struct {
 		VMSpecName string `json:"vmspecName"`
+		IId        struct {
+			NameId   string `json:"nameId"`   // output - NameID by user
+			SystemId string `json:"systemId"` // output - SystemID by CloudOS
+		} `json:"iid"`
 	} `json:"cspViewVmDetail"` // output
+	SSHHostKeyInfo struct {
+		HostKeyAlgorithm string `json:"hostKeyAlgorithm"`
+		PublicKey        string `json:"publicKey"`
+	} `json:"sshHostKeyInfo"` // output (if available)
 
 }
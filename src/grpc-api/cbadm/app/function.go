@@ -46,20 +46,7 @@ func GetBody(o IOptions, tpl string) (buf []byte, err error) {
 	// -f 옵션
 	fileName := o.GetFilename()
 	if len(fileName) > 0 {
-		switch {
-		case fileName == "-": // standard-in
-			buf, err = io.ReadAll(os.Stdin)
-		case strings.Index(fileName, "http://") == 0 || strings.Index(fileName, "https://") == 0: // http
-			if _, err = url.Parse(fileName); err == nil {
-				if resp, err := http.Get(fileName); err == nil {
-					defer resp.Body.Close()
-					buf, err = io.ReadAll(resp.Body)
-				}
-			}
-		default:
-			buf, err = os.ReadFile(fileName) // local file
-		}
-
+		buf, err = ReadFile(fileName)
 		if err == nil {
 			buf, err = yaml.YAMLToJSON(buf)
 		}
@@ -71,3 +58,23 @@ func GetBody(o IOptions, tpl string) (buf []byte, err error) {
 
 	return
 }
+
+// read a file (standard-in "-", http(s) URL or a local file)
+func ReadFile(fileName string) (buf []byte, err error) {
+	switch {
+	case fileName == "-": // standard-in
+		buf, err = io.ReadAll(os.Stdin)
+	case strings.Index(fileName, "http://") == 0 || strings.Index(fileName, "https://") == 0: // http
+		if _, err = url.Parse(fileName); err == nil {
+			var resp *http.Response
+			if resp, err = http.Get(fileName); err == nil {
+				defer resp.Body.Close()
+				buf, err = io.ReadAll(resp.Body)
+			}
+		}
+	default:
+		buf, err = os.ReadFile(fileName) // local file
+	}
+
+	return
+}
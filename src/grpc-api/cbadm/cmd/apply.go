@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cloud-barista/cb-mcks/src/grpc-api/cbadm/app"
+	lb_api "github.com/cloud-barista/cb-mcks/src/grpc-api/request"
+	"github.com/cloud-barista/cb-mcks/src/utils/lang"
+)
+
+type ApplyOptions struct {
+	*app.Options
+	clusterName    string
+	pruneSelector  string
+	forceConflicts bool
+}
+
+func (o *ApplyOptions) Validate() error {
+	o.Namespace = lang.NVL(o.Namespace, app.Config.GetCurrentContext().Namespace)
+	if o.Namespace == "" {
+		return fmt.Errorf("Namespace is required.")
+	}
+	if o.clusterName == "" {
+		return fmt.Errorf("ClusterName is required.")
+	}
+	if o.Filename == "" {
+		return fmt.Errorf("-f Filepath is required")
+	}
+	return nil
+}
+
+// NewApplyCmd - Manifest 적용을 수행하는 Cobra Command 생성
+func NewApplyCmd(o *app.Options) *cobra.Command {
+
+	oApply := &ApplyOptions{
+		Options: o,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "apply -f FILENAME --cluster CLUSTER_NAME [options]",
+		Short: "Apply manifests to a cluster",
+		Long:  "This is a apply command for manifests (server-side apply)",
+		Run: func(cmd *cobra.Command, args []string) {
+			app.ValidateError(cmd, oApply.Validate())
+			app.ValidateError(cmd, func() error {
+				manifest, err := app.ReadFile(o.Filename)
+				if err != nil {
+					return err
+				}
+				out, err := json.Marshal(lb_api.ManifestApplyRequest{
+					Namespace: o.Namespace,
+					Cluster:   oApply.clusterName,
+					Item: lb_api.ManifestReq{
+						Manifest:       string(manifest),
+						PruneSelector:  oApply.pruneSelector,
+						ForceConflicts: oApply.forceConflicts,
+					},
+				})
+				if err != nil {
+					return err
+				}
+				o.Data = string(out)
+				SetupAndRun(cmd, o)
+				return nil
+			}())
+		},
+	}
+	cmd.Flags().StringVar(&oApply.clusterName, "cluster", "", "Name of cluster")
+	cmd.Flags().StringVar(&oApply.pruneSelector, "prune-selector", "", "Label selector to apply & prune objects (e.g. app.kubernetes.io/managed-by=gitops)")
+	cmd.Flags().BoolVar(&oApply.forceConflicts, "force-conflicts", false, "Force apply on field-manager conflicts")
+
+	return cmd
+}
@@ -14,11 +14,13 @@ type CreateClusterOptions struct {
 		Connection string
 		Count      int
 		Spec       string
+		Csi        bool
 	}
 	Worker struct {
 		Connection string
 		Count      int
 		Spec       string
+		Csi        bool
 	}
 }
 
@@ -29,6 +31,7 @@ type CreateNodeOptions struct {
 		Connection string
 		Count      int
 		Spec       string
+		Csi        bool
 	}
 }
 
@@ -96,9 +99,12 @@ func NewCreateCmd(o *app.Options) *cobra.Command {
 	cmdCluster.Flags().StringVar(&oCluster.ControlPlane.Connection, "control-plane-connection", "", "Connection name of control-plane nodes")
 	cmdCluster.Flags().IntVar(&oCluster.ControlPlane.Count, "control-plane-count", 1, "Count of control-plane nodes")
 	cmdCluster.Flags().StringVar(&oCluster.ControlPlane.Spec, "control-plane-spec", "", "Spec. of control-plane nodes")
+	cmdCluster.Flags().BoolVar(&oCluster.ControlPlane.Csi, "control-plane-csi", false, "Deploy a CSI driver to control-plane nodes")
 	cmdCluster.Flags().StringVar(&oCluster.Worker.Connection, "worker-connection", "", "Connection name of wroker nodes")
 	cmdCluster.Flags().IntVar(&oCluster.Worker.Count, "worker-count", 1, "Count of wroker nodes")
 	cmdCluster.Flags().StringVar(&oCluster.Worker.Spec, "worker-spec", "", "Spec. of wroker nodes")
+	cmdCluster.Flags().BoolVar(&oCluster.Worker.Csi, "worker-csi", false, "Deploy a CSI driver to wroker nodes")
+	cmdCluster.Flags().BoolVar(&dryRun, "dry-run", false, "Validate a request and print a plan without creating anything")
 
 	cmdNode := &cobra.Command{
 		Use:   "node (NAME | --name NAME) --cluster CLUSTER_NAME [options]",
@@ -122,6 +128,7 @@ func NewCreateCmd(o *app.Options) *cobra.Command {
 	cmdNode.Flags().StringVar(&oNode.Worker.Connection, "worker-connection", "", "Connection name of wroker nodes")
 	cmdNode.Flags().IntVar(&oNode.Worker.Count, "worker-count", 1, "Count of wroker nodes")
 	cmdNode.Flags().StringVar(&oNode.Worker.Spec, "worker-spec", "", "Spec. of wroker nodes")
+	cmdNode.Flags().BoolVar(&oNode.Worker.Csi, "worker-csi", false, "Deploy a CSI driver to wroker nodes")
 	cmds.AddCommand(cmdNode)
 	/*
 		cmdCredential := &cobra.Command{
@@ -144,10 +151,10 @@ const (
    "label": "",
    "description": "",
    "controlPlane": [
-      { "connection": "{{.ControlPlane.Connection}}", "count": {{.ControlPlane.Count}}, "spec": "{{.ControlPlane.Spec}}" }
+      { "connection": "{{.ControlPlane.Connection}}", "count": {{.ControlPlane.Count}}, "spec": "{{.ControlPlane.Spec}}", "csi": {{.ControlPlane.Csi}} }
    ],
    "worker": [
-      { "connection": "{{.Worker.Connection}}", "count": {{.Worker.Count}}, "spec": "{{.Worker.Spec}}" }
+      { "connection": "{{.Worker.Connection}}", "count": {{.Worker.Count}}, "spec": "{{.Worker.Spec}}", "csi": {{.Worker.Csi}} }
     ],
     "config": {
         "kubernetes": {
@@ -160,7 +167,7 @@ const (
 }`
 	tplNode = `{
 	"worker": [
-	   { "connection": "{{.Worker.Connection}}", "count": {{.Worker.Count}}, "spec": "{{.Worker.Spec}}" }
+	   { "connection": "{{.Worker.Connection}}", "count": {{.Worker.Count}}, "spec": "{{.Worker.Spec}}", "csi": {{.Worker.Csi}} }
 	 ]
 }`
 )
@@ -44,7 +44,7 @@ func SetupAndRun(cmd *cobra.Command, o *app.Options) {
 	mcar := lb_api.NewMCARManager()
 	//cim := sp_api.NewCloudInfoManager()
 
-	if cmd.Name() == "cluster" || cmd.Name() == "node" || cmd.Name() == "healthy" {
+	if cmd.Name() == "cluster" || cmd.Name() == "node" || cmd.Name() == "spec" || cmd.Name() == "connection" || cmd.Name() == "healthy" || cmd.Name() == "apply" {
 		// LB API 설정
 		mckscli := app.Config.GetCurrentContext().Mckscli
 
@@ -100,6 +100,8 @@ func SetupAndRun(cmd *cobra.Command, o *app.Options) {
 		switch cmd.Name() {
 		case "healthy":
 			result, err = mcar.Healthy()
+		case "apply":
+			result, err = mcar.ApplyManifest(o.Data)
 		}
 	case "get":
 		switch cmd.Name() {
@@ -115,6 +117,11 @@ func SetupAndRun(cmd *cobra.Command, o *app.Options) {
 			} else {
 				result, err = mcar.GetNodeByParam(o.Namespace, clusterName, o.Name)
 			}
+		case "connection":
+			result, err = mcar.ListConnection()
+		case "spec":
+			specQry.Name = o.Name
+			result, err = mcar.ListSpecByParam(&specQry)
 		case "credential":
 			if o.Name == "" {
 				//result, err = cim.ListCredential()
@@ -125,12 +132,21 @@ func SetupAndRun(cmd *cobra.Command, o *app.Options) {
 	case "create":
 		switch cmd.Name() {
 		case "cluster":
-			result, err = mcar.CreateCluster(o.Data)
+			if dryRun {
+				result, err = mcar.PlanCluster(o.Data)
+			} else {
+				result, err = mcar.CreateCluster(o.Data)
+			}
 		case "node":
 			result, err = mcar.AddNode(o.Data)
 		case "credential":
 			// result, err = cim.CreateCredential(o.Data)
 		}
+	case "logs":
+		switch cmd.Name() {
+		case "node":
+			result, err = mcar.ListNodeLogByParam(o.Namespace, clusterName, o.Name, step)
+		}
 	case "delete":
 		switch cmd.Name() {
 		case "cluster":
@@ -6,9 +6,14 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cloud-barista/cb-mcks/src/grpc-api/cbadm/app"
+	lb_api "github.com/cloud-barista/cb-mcks/src/grpc-api/request"
 	"github.com/cloud-barista/cb-mcks/src/utils/lang"
 )
 
+var (
+	specQry lb_api.SpecQryRequest
+)
+
 func NewGetCmd(o *app.Options) *cobra.Command {
 
 	fnValidate := func() error {
@@ -54,6 +59,43 @@ func NewGetCmd(o *app.Options) *cobra.Command {
 	}
 	cmdNode.Flags().StringVar(&clusterName, "cluster", "", "Name of cluster")
 	getCmd.AddCommand(cmdNode)
+	getCmd.AddCommand(&cobra.Command{
+		Use:   "connection [options]",
+		Short: "Get connection list",
+		Long:  "This is a get command for connections (a csp, a region and a zone)",
+		Run: func(cmd *cobra.Command, args []string) {
+			SetupAndRun(cmd, o)
+		},
+	})
+	cmdSpec := &cobra.Command{
+		Use:   "spec [PATTERN | --name PATTERN] --connection CONNECTION [options]",
+		Short: "Get spec list of a connection",
+		Long:  "This is a get command for specs (filters, a sort and a cursor pagination)",
+		Args:  app.BindCommandArgs(&o.Name),
+		Run: func(cmd *cobra.Command, args []string) {
+			app.ValidateError(cmd, func() error {
+				if specQry.ConnectionName == "" {
+					return fmt.Errorf("connection name is required")
+				}
+				return nil
+			}())
+			SetupAndRun(cmd, o)
+		},
+	}
+	cmdSpec.Flags().StringVar(&specQry.ConnectionName, "connection", "", "Name of connection")
+	cmdSpec.Flags().StringVar(&specQry.ControlPlane, "control-plane", "", "Specs for control-plane nodes (Y/N)")
+	cmdSpec.Flags().StringVar(&specQry.CpuMin, "cpu-min", "", "Minimum vCPUs")
+	cmdSpec.Flags().StringVar(&specQry.CpuMax, "cpu-max", "", "Maximum vCPUs")
+	cmdSpec.Flags().StringVar(&specQry.MemoryMin, "memory-min", "", "Minimum memory (GiB)")
+	cmdSpec.Flags().StringVar(&specQry.MemoryMax, "memory-max", "", "Maximum memory (GiB)")
+	cmdSpec.Flags().StringVar(&specQry.ClockMin, "clock-min", "", "Minimum clock (GHz)")
+	cmdSpec.Flags().StringVar(&specQry.ClockMax, "clock-max", "", "Maximum clock (GHz)")
+	cmdSpec.Flags().StringVar(&specQry.Arch, "arch", "", "Architecture (x86_64/arm64)")
+	cmdSpec.Flags().StringVar(&specQry.Gpu, "gpu", "", "Specs with GPUs (Y) or without GPUs (N)")
+	cmdSpec.Flags().StringVar(&specQry.Sort, "sort", "", "Sort key (name/cpu/memory/clock, '-' prefix for descending)")
+	cmdSpec.Flags().StringVar(&specQry.Limit, "limit", "", "Page size")
+	cmdSpec.Flags().StringVar(&specQry.Cursor, "cursor", "", "Cursor of a next page")
+	getCmd.AddCommand(cmdSpec)
 	/*
 		getCmd.AddCommand(&cobra.Command{
 			Use:   "credential (NAME | --name NAME) [options]",
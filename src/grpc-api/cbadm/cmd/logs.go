@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cloud-barista/cb-mcks/src/grpc-api/cbadm/app"
+	"github.com/cloud-barista/cb-mcks/src/utils/lang"
+)
+
+var (
+	step string
+)
+
+// returns a cobra command
+func NewLogsCmd(o *app.Options) *cobra.Command {
+
+	fnValidate := func() error {
+		o.Namespace = lang.NVL(o.Namespace, app.Config.GetCurrentContext().Namespace)
+		if o.Namespace == "" {
+			return fmt.Errorf("Namespace is required.")
+		}
+		if o.Name == "" {
+			return fmt.Errorf("Name is required.")
+		}
+		return nil
+	}
+
+	// root
+	cmds := &cobra.Command{
+		Use:   "logs",
+		Short: "Logs command",
+		Long:  "This is a logs command",
+		Run: func(c *cobra.Command, args []string) {
+			c.Help()
+		},
+	}
+
+	// node
+	cmdNode := &cobra.Command{
+		Use:   "node (NAME | --name NAME) --cluster CLUSTER_NAME [--step STEP] [options]",
+		Short: "Get provisioning logs of a node",
+		Long:  "This is a logs command for node (outputs of remote commands per a provisioning step)",
+		Args:  app.BindCommandArgs(&o.Name),
+		Run: func(cmd *cobra.Command, args []string) {
+			app.ValidateError(cmd, fnValidate())
+			app.ValidateError(cmd, func() error {
+				if clusterName == "" {
+					return fmt.Errorf("ClusterName is required")
+				}
+				return nil
+			}())
+			SetupAndRun(cmd, o)
+		},
+	}
+	cmdNode.Flags().StringVar(&clusterName, "cluster", "", "Name of cluster")
+	cmdNode.Flags().StringVar(&step, "step", "", "Provisioning step (bootstrap/haproxy/init/join/cni/other, empty : all steps)")
+	cmds.AddCommand(cmdNode)
+
+	return cmds
+}
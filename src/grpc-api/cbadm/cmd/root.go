@@ -17,6 +17,7 @@ const (
 
 var (
 	clusterName string
+	dryRun      bool
 )
 
 type CbadmOptions struct {
@@ -67,6 +68,8 @@ func NewRootCmd() *cobra.Command {
 	rootCmd.AddCommand(NewGetCmd(&o.Options))
 	rootCmd.AddCommand(NewCreateCmd(&o.Options))
 	rootCmd.AddCommand(NewDeleteCmd(&o.Options))
+	rootCmd.AddCommand(NewApplyCmd(&o.Options))
+	rootCmd.AddCommand(NewLogsCmd(&o.Options))
 
 	return rootCmd
 }
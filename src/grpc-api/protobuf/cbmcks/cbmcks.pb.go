@@ -7,6 +7,8 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cloud-barista/cb-mcks/src/grpc-api/protobuf/gogoproto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	proto "github.com/golang/protobuf/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -503,31 +505,34 @@ func (m *ClusterCreateRequest) GetItem() *ClusterCreateInfo {
 	return nil
 }
 
-type ClusterCreateInfo struct {
-	Name                 string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
-	ControlPlane         []*NodeConfig `protobuf:"bytes,2,rep,name=control_plane,json=controlPlane,proto3" json:"controlPlane" yaml:"controlPlane"`
-	Worker               []*NodeConfig `protobuf:"bytes,3,rep,name=worker,proto3" json:"worker" yaml:"worker"`
-	Config               *Config       `protobuf:"bytes,4,opt,name=config,proto3" json:"config" yaml:"config"`
-	Label                string        `protobuf:"bytes,5,opt,name=label,proto3" json:"label" yaml:"label"`
-	InstallMonAgent      string        `protobuf:"bytes,6,opt,name=install_mon_agent,json=installMonAgent,proto3" json:"installMonAgent" yaml:"installMonAgent"`
-	Description          string        `protobuf:"bytes,7,opt,name=description,proto3" json:"description" yaml:"description"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+// 클러스터 생성 계획 (dry-run)
+type ClusterPlanResponse struct {
+	Kind                 string          `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind" yaml:"kind"`
+	Namespace            string          `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
+	Name                 string          `protobuf:"bytes,3,opt,name=name,proto3" json:"name" yaml:"name"`
+	Version              string          `protobuf:"bytes,4,opt,name=version,proto3" json:"version" yaml:"version"`
+	Valid                bool            `protobuf:"varint,5,opt,name=valid,proto3" json:"valid" yaml:"valid"`
+	Errors               []*PlanError    `protobuf:"bytes,6,rep,name=errors,proto3" json:"errors" yaml:"errors"`
+	Nodes                []*PlanNode     `protobuf:"bytes,7,rep,name=nodes,proto3" json:"nodes" yaml:"nodes"`
+	Resources            []*PlanResource `protobuf:"bytes,8,rep,name=resources,proto3" json:"resources" yaml:"resources"`
+	Addons               []string        `protobuf:"bytes,9,rep,name=addons,proto3" json:"addons" yaml:"addons"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ClusterCreateInfo) Reset()         { *m = ClusterCreateInfo{} }
-func (m *ClusterCreateInfo) String() string { return proto.CompactTextString(m) }
-func (*ClusterCreateInfo) ProtoMessage()    {}
-func (*ClusterCreateInfo) Descriptor() ([]byte, []int) {
+func (m *ClusterPlanResponse) Reset()         { *m = ClusterPlanResponse{} }
+func (m *ClusterPlanResponse) String() string { return proto.CompactTextString(m) }
+func (*ClusterPlanResponse) ProtoMessage()    {}
+func (*ClusterPlanResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6e98b9bfafe16c0f, []int{7}
 }
-func (m *ClusterCreateInfo) XXX_Unmarshal(b []byte) error {
+func (m *ClusterPlanResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClusterCreateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClusterPlanResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClusterCreateInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClusterPlanResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -537,88 +542,101 @@ func (m *ClusterCreateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ClusterCreateInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClusterCreateInfo.Merge(m, src)
+func (m *ClusterPlanResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterPlanResponse.Merge(m, src)
 }
-func (m *ClusterCreateInfo) XXX_Size() int {
+func (m *ClusterPlanResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClusterCreateInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClusterCreateInfo.DiscardUnknown(m)
+func (m *ClusterPlanResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterPlanResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClusterCreateInfo proto.InternalMessageInfo
+var xxx_messageInfo_ClusterPlanResponse proto.InternalMessageInfo
 
-func (m *ClusterCreateInfo) GetName() string {
+func (m *ClusterPlanResponse) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *ClusterPlanResponse) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ClusterPlanResponse) GetName() string {
 	if m != nil {
 		return m.Name
 	}
 	return ""
 }
 
-func (m *ClusterCreateInfo) GetControlPlane() []*NodeConfig {
+func (m *ClusterPlanResponse) GetVersion() string {
 	if m != nil {
-		return m.ControlPlane
+		return m.Version
 	}
-	return nil
+	return ""
 }
 
-func (m *ClusterCreateInfo) GetWorker() []*NodeConfig {
+func (m *ClusterPlanResponse) GetValid() bool {
 	if m != nil {
-		return m.Worker
+		return m.Valid
 	}
-	return nil
+	return false
 }
 
-func (m *ClusterCreateInfo) GetConfig() *Config {
+func (m *ClusterPlanResponse) GetErrors() []*PlanError {
 	if m != nil {
-		return m.Config
+		return m.Errors
 	}
 	return nil
 }
 
-func (m *ClusterCreateInfo) GetLabel() string {
+func (m *ClusterPlanResponse) GetNodes() []*PlanNode {
 	if m != nil {
-		return m.Label
+		return m.Nodes
 	}
-	return ""
+	return nil
 }
 
-func (m *ClusterCreateInfo) GetInstallMonAgent() string {
+func (m *ClusterPlanResponse) GetResources() []*PlanResource {
 	if m != nil {
-		return m.InstallMonAgent
+		return m.Resources
 	}
-	return ""
+	return nil
 }
 
-func (m *ClusterCreateInfo) GetDescription() string {
+func (m *ClusterPlanResponse) GetAddons() []string {
 	if m != nil {
-		return m.Description
+		return m.Addons
 	}
-	return ""
+	return nil
 }
 
-type NodeConfig struct {
-	Connection           string   `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection" yaml:"connection"`
-	Count                int32    `protobuf:"varint,2,opt,name=count,proto3" json:"count" yaml:"count"`
-	Spec                 string   `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec" yaml:"spec"`
+type PlanError struct {
+	Reason               string   `protobuf:"bytes,1,opt,name=reason,proto3" json:"reason" yaml:"reason"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message" yaml:"message"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeConfig) Reset()         { *m = NodeConfig{} }
-func (m *NodeConfig) String() string { return proto.CompactTextString(m) }
-func (*NodeConfig) ProtoMessage()    {}
-func (*NodeConfig) Descriptor() ([]byte, []int) {
+func (m *PlanError) Reset()         { *m = PlanError{} }
+func (m *PlanError) String() string { return proto.CompactTextString(m) }
+func (*PlanError) ProtoMessage()    {}
+func (*PlanError) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6e98b9bfafe16c0f, []int{8}
 }
-func (m *NodeConfig) XXX_Unmarshal(b []byte) error {
+func (m *PlanError) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NodeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PlanError) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NodeConfig.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PlanError.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -628,58 +646,57 @@ func (m *NodeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *NodeConfig) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeConfig.Merge(m, src)
+func (m *PlanError) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanError.Merge(m, src)
 }
-func (m *NodeConfig) XXX_Size() int {
+func (m *PlanError) XXX_Size() int {
 	return m.Size()
 }
-func (m *NodeConfig) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeConfig.DiscardUnknown(m)
+func (m *PlanError) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanError.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeConfig proto.InternalMessageInfo
+var xxx_messageInfo_PlanError proto.InternalMessageInfo
 
-func (m *NodeConfig) GetConnection() string {
+func (m *PlanError) GetReason() string {
 	if m != nil {
-		return m.Connection
+		return m.Reason
 	}
 	return ""
 }
 
-func (m *NodeConfig) GetCount() int32 {
-	if m != nil {
-		return m.Count
-	}
-	return 0
-}
-
-func (m *NodeConfig) GetSpec() string {
+func (m *PlanError) GetMessage() string {
 	if m != nil {
-		return m.Spec
+		return m.Message
 	}
 	return ""
 }
 
-type Config struct {
-	Kubernetes           *Kubernetes `protobuf:"bytes,1,opt,name=kubernetes,proto3" json:"kubernetes" yaml:"kubernetes"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type PlanNode struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role" yaml:"role"`
+	Connection           string   `protobuf:"bytes,3,opt,name=connection,proto3" json:"connection" yaml:"connection"`
+	Csp                  string   `protobuf:"bytes,4,opt,name=csp,proto3" json:"csp" yaml:"csp"`
+	Region               string   `protobuf:"bytes,5,opt,name=region,proto3" json:"region" yaml:"region"`
+	Zone                 string   `protobuf:"bytes,6,opt,name=zone,proto3" json:"zone" yaml:"zone"`
+	Spec                 string   `protobuf:"bytes,7,opt,name=spec,proto3" json:"spec" yaml:"spec"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Config) Reset()         { *m = Config{} }
-func (m *Config) String() string { return proto.CompactTextString(m) }
-func (*Config) ProtoMessage()    {}
-func (*Config) Descriptor() ([]byte, []int) {
+func (m *PlanNode) Reset()         { *m = PlanNode{} }
+func (m *PlanNode) String() string { return proto.CompactTextString(m) }
+func (*PlanNode) ProtoMessage()    {}
+func (*PlanNode) Descriptor() ([]byte, []int) {
 	return fileDescriptor_6e98b9bfafe16c0f, []int{9}
 }
-func (m *Config) XXX_Unmarshal(b []byte) error {
+func (m *PlanNode) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Config) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PlanNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Config.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PlanNode.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -689,115 +706,90 @@ func (m *Config) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Config) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Config.Merge(m, src)
+func (m *PlanNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanNode.Merge(m, src)
 }
-func (m *Config) XXX_Size() int {
+func (m *PlanNode) XXX_Size() int {
 	return m.Size()
 }
-func (m *Config) XXX_DiscardUnknown() {
-	xxx_messageInfo_Config.DiscardUnknown(m)
+func (m *PlanNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanNode.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Config proto.InternalMessageInfo
+var xxx_messageInfo_PlanNode proto.InternalMessageInfo
 
-func (m *Config) GetKubernetes() *Kubernetes {
+func (m *PlanNode) GetName() string {
 	if m != nil {
-		return m.Kubernetes
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type Kubernetes struct {
-	NetworkCni           string   `protobuf:"bytes,1,opt,name=network_cni,json=networkCni,proto3" json:"networkCni" yaml:"networkCni"`
-	PodCidr              string   `protobuf:"bytes,2,opt,name=pod_cidr,json=podCidr,proto3" json:"podCidr" yaml:"podCidr"`
-	ServiceCidr          string   `protobuf:"bytes,3,opt,name=service_cidr,json=serviceCidr,proto3" json:"serviceCidr" yaml:"serviceCidr"`
-	ServicDnsDomain      string   `protobuf:"bytes,4,opt,name=servic_dns_domain,json=serviceDnsDomain,proto3" json:"serviceDnsDomain" yaml:"serviceDnsDomain"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PlanNode) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
 }
 
-func (m *Kubernetes) Reset()         { *m = Kubernetes{} }
-func (m *Kubernetes) String() string { return proto.CompactTextString(m) }
-func (*Kubernetes) ProtoMessage()    {}
-func (*Kubernetes) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{10}
-}
-func (m *Kubernetes) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *Kubernetes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_Kubernetes.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *PlanNode) GetConnection() string {
+	if m != nil {
+		return m.Connection
 	}
+	return ""
 }
-func (m *Kubernetes) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Kubernetes.Merge(m, src)
-}
-func (m *Kubernetes) XXX_Size() int {
-	return m.Size()
-}
-func (m *Kubernetes) XXX_DiscardUnknown() {
-	xxx_messageInfo_Kubernetes.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Kubernetes proto.InternalMessageInfo
 
-func (m *Kubernetes) GetNetworkCni() string {
+func (m *PlanNode) GetCsp() string {
 	if m != nil {
-		return m.NetworkCni
+		return m.Csp
 	}
 	return ""
 }
 
-func (m *Kubernetes) GetPodCidr() string {
+func (m *PlanNode) GetRegion() string {
 	if m != nil {
-		return m.PodCidr
+		return m.Region
 	}
 	return ""
 }
 
-func (m *Kubernetes) GetServiceCidr() string {
+func (m *PlanNode) GetZone() string {
 	if m != nil {
-		return m.ServiceCidr
+		return m.Zone
 	}
 	return ""
 }
 
-func (m *Kubernetes) GetServicDnsDomain() string {
+func (m *PlanNode) GetSpec() string {
 	if m != nil {
-		return m.ServicDnsDomain
+		return m.Spec
 	}
 	return ""
 }
 
-type ClusterAllQryRequest struct {
-	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
+type PlanResource struct {
+	Kind                 string   `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind" yaml:"kind"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name" yaml:"name"`
+	Connection           string   `protobuf:"bytes,3,opt,name=connection,proto3" json:"connection" yaml:"connection"`
+	Action               string   `protobuf:"bytes,4,opt,name=action,proto3" json:"action" yaml:"action"`
+	Message              string   `protobuf:"bytes,5,opt,name=message,proto3" json:"message" yaml:"message"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ClusterAllQryRequest) Reset()         { *m = ClusterAllQryRequest{} }
-func (m *ClusterAllQryRequest) String() string { return proto.CompactTextString(m) }
-func (*ClusterAllQryRequest) ProtoMessage()    {}
-func (*ClusterAllQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{11}
+func (m *PlanResource) Reset()         { *m = PlanResource{} }
+func (m *PlanResource) String() string { return proto.CompactTextString(m) }
+func (*PlanResource) ProtoMessage()    {}
+func (*PlanResource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{10}
 }
-func (m *ClusterAllQryRequest) XXX_Unmarshal(b []byte) error {
+func (m *PlanResource) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClusterAllQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *PlanResource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClusterAllQryRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_PlanResource.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -807,45 +799,78 @@ func (m *ClusterAllQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ClusterAllQryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClusterAllQryRequest.Merge(m, src)
+func (m *PlanResource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlanResource.Merge(m, src)
 }
-func (m *ClusterAllQryRequest) XXX_Size() int {
+func (m *PlanResource) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClusterAllQryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClusterAllQryRequest.DiscardUnknown(m)
+func (m *PlanResource) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlanResource.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClusterAllQryRequest proto.InternalMessageInfo
+var xxx_messageInfo_PlanResource proto.InternalMessageInfo
 
-func (m *ClusterAllQryRequest) GetNamespace() string {
+func (m *PlanResource) GetKind() string {
 	if m != nil {
-		return m.Namespace
+		return m.Kind
 	}
 	return ""
 }
 
-type ClusterQryRequest struct {
-	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
-	Cluster              string   `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster" yaml:"cluster"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *PlanResource) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *ClusterQryRequest) Reset()         { *m = ClusterQryRequest{} }
-func (m *ClusterQryRequest) String() string { return proto.CompactTextString(m) }
-func (*ClusterQryRequest) ProtoMessage()    {}
-func (*ClusterQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{12}
+func (m *PlanResource) GetConnection() string {
+	if m != nil {
+		return m.Connection
+	}
+	return ""
 }
-func (m *ClusterQryRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *PlanResource) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *PlanResource) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type ClusterCreateInfo struct {
+	Name                 string        `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	ControlPlane         []*NodeConfig `protobuf:"bytes,2,rep,name=control_plane,json=controlPlane,proto3" json:"controlPlane" yaml:"controlPlane"`
+	Worker               []*NodeConfig `protobuf:"bytes,3,rep,name=worker,proto3" json:"worker" yaml:"worker"`
+	Config               *Config       `protobuf:"bytes,4,opt,name=config,proto3" json:"config" yaml:"config"`
+	Label                string        `protobuf:"bytes,5,opt,name=label,proto3" json:"label" yaml:"label"`
+	InstallMonAgent      string        `protobuf:"bytes,6,opt,name=install_mon_agent,json=installMonAgent,proto3" json:"installMonAgent" yaml:"installMonAgent"`
+	Description          string        `protobuf:"bytes,7,opt,name=description,proto3" json:"description" yaml:"description"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ClusterCreateInfo) Reset()         { *m = ClusterCreateInfo{} }
+func (m *ClusterCreateInfo) String() string { return proto.CompactTextString(m) }
+func (*ClusterCreateInfo) ProtoMessage()    {}
+func (*ClusterCreateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{11}
+}
+func (m *ClusterCreateInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ClusterQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ClusterCreateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ClusterQryRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ClusterCreateInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -855,114 +880,92 @@ func (m *ClusterQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *ClusterQryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClusterQryRequest.Merge(m, src)
+func (m *ClusterCreateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCreateInfo.Merge(m, src)
 }
-func (m *ClusterQryRequest) XXX_Size() int {
+func (m *ClusterCreateInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *ClusterQryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClusterQryRequest.DiscardUnknown(m)
+func (m *ClusterCreateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCreateInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ClusterQryRequest proto.InternalMessageInfo
+var xxx_messageInfo_ClusterCreateInfo proto.InternalMessageInfo
 
-func (m *ClusterQryRequest) GetNamespace() string {
+func (m *ClusterCreateInfo) GetName() string {
 	if m != nil {
-		return m.Namespace
+		return m.Name
 	}
 	return ""
 }
 
-func (m *ClusterQryRequest) GetCluster() string {
+func (m *ClusterCreateInfo) GetControlPlane() []*NodeConfig {
 	if m != nil {
-		return m.Cluster
+		return m.ControlPlane
 	}
-	return ""
+	return nil
 }
 
-type ClusterStatusInfo struct {
-	Phase                string   `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase" yaml:"phase"`
-	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason" yaml:"reason"`
-	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message" yaml:"message"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ClusterCreateInfo) GetWorker() []*NodeConfig {
+	if m != nil {
+		return m.Worker
+	}
+	return nil
 }
 
-func (m *ClusterStatusInfo) Reset()         { *m = ClusterStatusInfo{} }
-func (m *ClusterStatusInfo) String() string { return proto.CompactTextString(m) }
-func (*ClusterStatusInfo) ProtoMessage()    {}
-func (*ClusterStatusInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{13}
-}
-func (m *ClusterStatusInfo) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ClusterStatusInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ClusterStatusInfo.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *ClusterCreateInfo) GetConfig() *Config {
+	if m != nil {
+		return m.Config
 	}
+	return nil
 }
-func (m *ClusterStatusInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ClusterStatusInfo.Merge(m, src)
-}
-func (m *ClusterStatusInfo) XXX_Size() int {
-	return m.Size()
-}
-func (m *ClusterStatusInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_ClusterStatusInfo.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ClusterStatusInfo proto.InternalMessageInfo
 
-func (m *ClusterStatusInfo) GetPhase() string {
+func (m *ClusterCreateInfo) GetLabel() string {
 	if m != nil {
-		return m.Phase
+		return m.Label
 	}
 	return ""
 }
 
-func (m *ClusterStatusInfo) GetReason() string {
+func (m *ClusterCreateInfo) GetInstallMonAgent() string {
 	if m != nil {
-		return m.Reason
+		return m.InstallMonAgent
 	}
 	return ""
 }
 
-func (m *ClusterStatusInfo) GetMessage() string {
+func (m *ClusterCreateInfo) GetDescription() string {
 	if m != nil {
-		return m.Message
+		return m.Description
 	}
 	return ""
 }
 
-type NodeInfoResponse struct {
-	Item                 *NodeInfo `protobuf:"bytes,1,opt,name=item,proto3" json:"item" yaml:"item"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type NodeConfig struct {
+	Connection           string            `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection" yaml:"connection"`
+	Count                int32             `protobuf:"varint,2,opt,name=count,proto3" json:"count" yaml:"count"`
+	Spec                 string            `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec" yaml:"spec"`
+	Csi                  bool              `protobuf:"varint,4,opt,name=csi,proto3" json:"csi" yaml:"csi"`
+	Labels               map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" yaml:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Taints               []*Taint          `protobuf:"bytes,6,rep,name=taints,proto3" json:"taints,omitempty" yaml:"taints,omitempty"`
+	Ssh                  *SSH              `protobuf:"bytes,7,opt,name=ssh,proto3" json:"ssh,omitempty" yaml:"ssh,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *NodeInfoResponse) Reset()         { *m = NodeInfoResponse{} }
-func (m *NodeInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*NodeInfoResponse) ProtoMessage()    {}
-func (*NodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{14}
+func (m *NodeConfig) Reset()         { *m = NodeConfig{} }
+func (m *NodeConfig) String() string { return proto.CompactTextString(m) }
+func (*NodeConfig) ProtoMessage()    {}
+func (*NodeConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{12}
 }
-func (m *NodeInfoResponse) XXX_Unmarshal(b []byte) error {
+func (m *NodeConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *NodeConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NodeInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_NodeConfig.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -972,109 +975,92 @@ func (m *NodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *NodeInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeInfoResponse.Merge(m, src)
+func (m *NodeConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeConfig.Merge(m, src)
 }
-func (m *NodeInfoResponse) XXX_Size() int {
+func (m *NodeConfig) XXX_Size() int {
 	return m.Size()
 }
-func (m *NodeInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeInfoResponse.DiscardUnknown(m)
+func (m *NodeConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeConfig.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_NodeConfig proto.InternalMessageInfo
 
-func (m *NodeInfoResponse) GetItem() *NodeInfo {
+func (m *NodeConfig) GetConnection() string {
 	if m != nil {
-		return m.Item
+		return m.Connection
 	}
-	return nil
+	return ""
 }
 
-type ListNodeInfoResponse struct {
-	Kind                 string      `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind" yaml:"kind"`
-	Items                []*NodeInfo `protobuf:"bytes,2,rep,name=items,proto3" json:"items" yaml:"items"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *NodeConfig) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (m *ListNodeInfoResponse) Reset()         { *m = ListNodeInfoResponse{} }
-func (m *ListNodeInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*ListNodeInfoResponse) ProtoMessage()    {}
-func (*ListNodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{15}
-}
-func (m *ListNodeInfoResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *ListNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_ListNodeInfoResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
+func (m *NodeConfig) GetSpec() string {
+	if m != nil {
+		return m.Spec
 	}
+	return ""
 }
-func (m *ListNodeInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListNodeInfoResponse.Merge(m, src)
-}
-func (m *ListNodeInfoResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *ListNodeInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListNodeInfoResponse.DiscardUnknown(m)
+
+func (m *NodeConfig) GetCsi() bool {
+	if m != nil {
+		return m.Csi
+	}
+	return false
 }
 
-var xxx_messageInfo_ListNodeInfoResponse proto.InternalMessageInfo
+func (m *NodeConfig) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
 
-func (m *ListNodeInfoResponse) GetKind() string {
+func (m *NodeConfig) GetTaints() []*Taint {
 	if m != nil {
-		return m.Kind
+		return m.Taints
 	}
-	return ""
+	return nil
 }
 
-func (m *ListNodeInfoResponse) GetItems() []*NodeInfo {
+func (m *NodeConfig) GetSsh() *SSH {
 	if m != nil {
-		return m.Items
+		return m.Ssh
 	}
 	return nil
 }
 
-type NodeInfo struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
-	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind" yaml:"kind"`
-	Credential           string   `protobuf:"bytes,3,opt,name=credential,proto3" json:"credential" yaml:"credential"`
-	PublicIp             string   `protobuf:"bytes,4,opt,name=public_ip,json=publicIp,proto3" json:"publicIp" yaml:"publicIp"`
-	Role                 string   `protobuf:"bytes,5,opt,name=role,proto3" json:"role" yaml:"role"`
-	Spec                 string   `protobuf:"bytes,6,opt,name=spec,proto3" json:"spec" yaml:"spec"`
-	Csp                  string   `protobuf:"bytes,7,opt,name=csp,proto3" json:"csp" yaml:"csp"`
-	CreatedTime          string   `protobuf:"bytes,8,opt,name=created_time,json=createdTime,proto3" json:"createdTime" yaml:"createdTime"`
-	CspLabel             string   `protobuf:"bytes,9,opt,name=csp_label,json=cspLabel,proto3" json:"cspLabel" yaml:"cspLabel"`
-	RegionLabel          string   `protobuf:"bytes,10,opt,name=region_label,json=regionLabel,proto3" json:"regionLabel" yaml:"regionLabel"`
-	ZoneLabel            string   `protobuf:"bytes,11,opt,name=zone_label,json=zoneLabel,proto3" json:"zoneLabel" yaml:"zoneLabel"`
+type SSH struct {
+	Port                 int32    `protobuf:"varint,1,opt,name=port,proto3" json:"port,omitempty" yaml:"port,omitempty"`
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty" yaml:"username,omitempty"`
+	DialTimeout          int32    `protobuf:"varint,3,opt,name=dial_timeout,json=dialTimeout,proto3" json:"dialTimeout,omitempty" yaml:"dialTimeout,omitempty"`
+	Retries              int32    `protobuf:"varint,4,opt,name=retries,proto3" json:"retries,omitempty" yaml:"retries,omitempty"`
+	RetryInterval        int32    `protobuf:"varint,5,opt,name=retry_interval,json=retryInterval,proto3" json:"retryInterval,omitempty" yaml:"retryInterval,omitempty"`
+	MaxRetryInterval     int32    `protobuf:"varint,6,opt,name=max_retry_interval,json=maxRetryInterval,proto3" json:"maxRetryInterval,omitempty" yaml:"maxRetryInterval,omitempty"`
+	Keepalive            int32    `protobuf:"varint,7,opt,name=keepalive,proto3" json:"keepalive,omitempty" yaml:"keepalive,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
-func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
-func (*NodeInfo) ProtoMessage()    {}
-func (*NodeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{16}
+func (m *SSH) Reset()         { *m = SSH{} }
+func (m *SSH) String() string { return proto.CompactTextString(m) }
+func (*SSH) ProtoMessage()    {}
+func (*SSH) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{13}
 }
-func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
+func (m *SSH) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SSH) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SSH.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1084,116 +1070,149 @@ func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *NodeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeInfo.Merge(m, src)
+func (m *SSH) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SSH.Merge(m, src)
 }
-func (m *NodeInfo) XXX_Size() int {
+func (m *SSH) XXX_Size() int {
 	return m.Size()
 }
-func (m *NodeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
+func (m *SSH) XXX_DiscardUnknown() {
+	xxx_messageInfo_SSH.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
+var xxx_messageInfo_SSH proto.InternalMessageInfo
 
-func (m *NodeInfo) GetName() string {
+func (m *SSH) GetPort() int32 {
 	if m != nil {
-		return m.Name
+		return m.Port
 	}
-	return ""
+	return 0
 }
 
-func (m *NodeInfo) GetKind() string {
+func (m *SSH) GetUsername() string {
 	if m != nil {
-		return m.Kind
+		return m.Username
 	}
 	return ""
 }
 
-func (m *NodeInfo) GetCredential() string {
+func (m *SSH) GetDialTimeout() int32 {
 	if m != nil {
-		return m.Credential
+		return m.DialTimeout
 	}
-	return ""
+	return 0
 }
 
-func (m *NodeInfo) GetPublicIp() string {
+func (m *SSH) GetRetries() int32 {
 	if m != nil {
-		return m.PublicIp
+		return m.Retries
 	}
-	return ""
+	return 0
 }
 
-func (m *NodeInfo) GetRole() string {
+func (m *SSH) GetRetryInterval() int32 {
 	if m != nil {
-		return m.Role
+		return m.RetryInterval
 	}
-	return ""
+	return 0
 }
 
-func (m *NodeInfo) GetSpec() string {
+func (m *SSH) GetMaxRetryInterval() int32 {
 	if m != nil {
-		return m.Spec
+		return m.MaxRetryInterval
 	}
-	return ""
+	return 0
 }
 
-func (m *NodeInfo) GetCsp() string {
+func (m *SSH) GetKeepalive() int32 {
 	if m != nil {
-		return m.Csp
+		return m.Keepalive
 	}
-	return ""
+	return 0
 }
 
-func (m *NodeInfo) GetCreatedTime() string {
-	if m != nil {
-		return m.CreatedTime
+type Taint struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key" yaml:"key"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty" yaml:"value,omitempty"`
+	Effect               string   `protobuf:"bytes,3,opt,name=effect,proto3" json:"effect" yaml:"effect"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Taint) Reset()         { *m = Taint{} }
+func (m *Taint) String() string { return proto.CompactTextString(m) }
+func (*Taint) ProtoMessage()    {}
+func (*Taint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{14}
+}
+func (m *Taint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Taint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Taint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
+}
+func (m *Taint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Taint.Merge(m, src)
+}
+func (m *Taint) XXX_Size() int {
+	return m.Size()
+}
+func (m *Taint) XXX_DiscardUnknown() {
+	xxx_messageInfo_Taint.DiscardUnknown(m)
 }
 
-func (m *NodeInfo) GetCspLabel() string {
+var xxx_messageInfo_Taint proto.InternalMessageInfo
+
+func (m *Taint) GetKey() string {
 	if m != nil {
-		return m.CspLabel
+		return m.Key
 	}
 	return ""
 }
 
-func (m *NodeInfo) GetRegionLabel() string {
+func (m *Taint) GetValue() string {
 	if m != nil {
-		return m.RegionLabel
+		return m.Value
 	}
 	return ""
 }
 
-func (m *NodeInfo) GetZoneLabel() string {
+func (m *Taint) GetEffect() string {
 	if m != nil {
-		return m.ZoneLabel
+		return m.Effect
 	}
 	return ""
 }
 
-type NodeCreateRequest struct {
-	Namespace            string          `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
-	Cluster              string          `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster" yaml:"cluster"`
-	Item                 *NodeCreateInfo `protobuf:"bytes,3,opt,name=item,json=ReqInfo,proto3" json:"ReqInfo" yaml:"ReqInfo"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+type Config struct {
+	Kubernetes           *Kubernetes `protobuf:"bytes,1,opt,name=kubernetes,proto3" json:"kubernetes" yaml:"kubernetes"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *NodeCreateRequest) Reset()         { *m = NodeCreateRequest{} }
-func (m *NodeCreateRequest) String() string { return proto.CompactTextString(m) }
-func (*NodeCreateRequest) ProtoMessage()    {}
-func (*NodeCreateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{17}
+func (m *Config) Reset()         { *m = Config{} }
+func (m *Config) String() string { return proto.CompactTextString(m) }
+func (*Config) ProtoMessage()    {}
+func (*Config) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{15}
 }
-func (m *NodeCreateRequest) XXX_Unmarshal(b []byte) error {
+func (m *Config) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NodeCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Config) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NodeCreateRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Config.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1203,59 +1222,59 @@ func (m *NodeCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *NodeCreateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeCreateRequest.Merge(m, src)
+func (m *Config) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Config.Merge(m, src)
 }
-func (m *NodeCreateRequest) XXX_Size() int {
+func (m *Config) XXX_Size() int {
 	return m.Size()
 }
-func (m *NodeCreateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeCreateRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_NodeCreateRequest proto.InternalMessageInfo
-
-func (m *NodeCreateRequest) GetNamespace() string {
-	if m != nil {
-		return m.Namespace
-	}
-	return ""
+func (m *Config) XXX_DiscardUnknown() {
+	xxx_messageInfo_Config.DiscardUnknown(m)
 }
 
-func (m *NodeCreateRequest) GetCluster() string {
-	if m != nil {
-		return m.Cluster
-	}
-	return ""
-}
+var xxx_messageInfo_Config proto.InternalMessageInfo
 
-func (m *NodeCreateRequest) GetItem() *NodeCreateInfo {
+func (m *Config) GetKubernetes() *Kubernetes {
 	if m != nil {
-		return m.Item
+		return m.Kubernetes
 	}
 	return nil
 }
 
-type NodeCreateInfo struct {
-	ControlPlane         []*NodeConfig `protobuf:"bytes,1,rep,name=control_plane,json=controlPlane,proto3" json:"controlPlane" yaml:"controlPlane"`
-	Worker               []*NodeConfig `protobuf:"bytes,2,rep,name=worker,proto3" json:"worker" yaml:"worker"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type Kubernetes struct {
+	NetworkCni           string          `protobuf:"bytes,1,opt,name=network_cni,json=networkCni,proto3" json:"networkCni" yaml:"networkCni"`
+	PodCidr              string          `protobuf:"bytes,2,opt,name=pod_cidr,json=podCidr,proto3" json:"podCidr" yaml:"podCidr"`
+	ServiceCidr          string          `protobuf:"bytes,3,opt,name=service_cidr,json=serviceCidr,proto3" json:"serviceCidr" yaml:"serviceCidr"`
+	ServicDnsDomain      string          `protobuf:"bytes,4,opt,name=servic_dns_domain,json=serviceDnsDomain,proto3" json:"serviceDnsDomain" yaml:"serviceDnsDomain"`
+	Kilo                 *Kilo           `protobuf:"bytes,5,opt,name=kilo,proto3" json:"kilo,omitempty" yaml:"kilo,omitempty"`
+	FeatureGates         map[string]bool `protobuf:"bytes,6,rep,name=feature_gates,json=featureGates,proto3" json:"featureGates,omitempty" yaml:"featureGates,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	ApiServer            *Component      `protobuf:"bytes,7,opt,name=api_server,json=apiServer,proto3" json:"apiServer,omitempty" yaml:"apiServer,omitempty"`
+	ControllerManager    *Component      `protobuf:"bytes,8,opt,name=controller_manager,json=controllerManager,proto3" json:"controllerManager,omitempty" yaml:"controllerManager,omitempty"`
+	Scheduler            *Component      `protobuf:"bytes,9,opt,name=scheduler,proto3" json:"scheduler,omitempty" yaml:"scheduler,omitempty"`
+	Kubelet              *Kubelet        `protobuf:"bytes,10,opt,name=kubelet,proto3" json:"kubelet,omitempty" yaml:"kubelet,omitempty"`
+	CertSans             []string        `protobuf:"bytes,11,rep,name=cert_sans,json=certSANs,proto3" json:"certSANs,omitempty" yaml:"certSANs,omitempty"`
+	Etcd                 *Etcd           `protobuf:"bytes,12,opt,name=etcd,proto3" json:"etcd,omitempty" yaml:"etcd,omitempty"`
+	Endpoint             string          `protobuf:"bytes,13,opt,name=endpoint,proto3" json:"endpoint,omitempty" yaml:"endpoint,omitempty"`
+	Oidc                 *Oidc           `protobuf:"bytes,14,opt,name=oidc,proto3" json:"oidc,omitempty" yaml:"oidc,omitempty"`
+	Audit                *Audit          `protobuf:"bytes,15,opt,name=audit,proto3" json:"audit,omitempty" yaml:"audit,omitempty"`
+	Encryption           *Encryption     `protobuf:"bytes,16,opt,name=encryption,proto3" json:"encryption,omitempty" yaml:"encryption,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *NodeCreateInfo) Reset()         { *m = NodeCreateInfo{} }
-func (m *NodeCreateInfo) String() string { return proto.CompactTextString(m) }
-func (*NodeCreateInfo) ProtoMessage()    {}
-func (*NodeCreateInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{18}
+func (m *Kubernetes) Reset()         { *m = Kubernetes{} }
+func (m *Kubernetes) String() string { return proto.CompactTextString(m) }
+func (*Kubernetes) ProtoMessage()    {}
+func (*Kubernetes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{16}
 }
-func (m *NodeCreateInfo) XXX_Unmarshal(b []byte) error {
+func (m *Kubernetes) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NodeCreateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Kubernetes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NodeCreateInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Kubernetes.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1265,52 +1284,149 @@ func (m *NodeCreateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *NodeCreateInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeCreateInfo.Merge(m, src)
+func (m *Kubernetes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Kubernetes.Merge(m, src)
 }
-func (m *NodeCreateInfo) XXX_Size() int {
+func (m *Kubernetes) XXX_Size() int {
 	return m.Size()
 }
-func (m *NodeCreateInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeCreateInfo.DiscardUnknown(m)
+func (m *Kubernetes) XXX_DiscardUnknown() {
+	xxx_messageInfo_Kubernetes.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeCreateInfo proto.InternalMessageInfo
+var xxx_messageInfo_Kubernetes proto.InternalMessageInfo
 
-func (m *NodeCreateInfo) GetControlPlane() []*NodeConfig {
+func (m *Kubernetes) GetNetworkCni() string {
 	if m != nil {
-		return m.ControlPlane
+		return m.NetworkCni
+	}
+	return ""
+}
+
+func (m *Kubernetes) GetPodCidr() string {
+	if m != nil {
+		return m.PodCidr
+	}
+	return ""
+}
+
+func (m *Kubernetes) GetServiceCidr() string {
+	if m != nil {
+		return m.ServiceCidr
+	}
+	return ""
+}
+
+func (m *Kubernetes) GetServicDnsDomain() string {
+	if m != nil {
+		return m.ServicDnsDomain
+	}
+	return ""
+}
+
+func (m *Kubernetes) GetKilo() *Kilo {
+	if m != nil {
+		return m.Kilo
 	}
 	return nil
 }
 
-func (m *NodeCreateInfo) GetWorker() []*NodeConfig {
+func (m *Kubernetes) GetFeatureGates() map[string]bool {
 	if m != nil {
-		return m.Worker
+		return m.FeatureGates
 	}
 	return nil
 }
 
-type NodeAllQryRequest struct {
-	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
-	Cluster              string   `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster" yaml:"cluster"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *Kubernetes) GetApiServer() *Component {
+	if m != nil {
+		return m.ApiServer
+	}
+	return nil
 }
 
-func (m *NodeAllQryRequest) Reset()         { *m = NodeAllQryRequest{} }
-func (m *NodeAllQryRequest) String() string { return proto.CompactTextString(m) }
-func (*NodeAllQryRequest) ProtoMessage()    {}
-func (*NodeAllQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{19}
+func (m *Kubernetes) GetControllerManager() *Component {
+	if m != nil {
+		return m.ControllerManager
+	}
+	return nil
 }
-func (m *NodeAllQryRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *Kubernetes) GetScheduler() *Component {
+	if m != nil {
+		return m.Scheduler
+	}
+	return nil
+}
+
+func (m *Kubernetes) GetKubelet() *Kubelet {
+	if m != nil {
+		return m.Kubelet
+	}
+	return nil
+}
+
+func (m *Kubernetes) GetCertSans() []string {
+	if m != nil {
+		return m.CertSans
+	}
+	return nil
+}
+
+func (m *Kubernetes) GetEtcd() *Etcd {
+	if m != nil {
+		return m.Etcd
+	}
+	return nil
+}
+
+func (m *Kubernetes) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *Kubernetes) GetOidc() *Oidc {
+	if m != nil {
+		return m.Oidc
+	}
+	return nil
+}
+
+func (m *Kubernetes) GetAudit() *Audit {
+	if m != nil {
+		return m.Audit
+	}
+	return nil
+}
+
+func (m *Kubernetes) GetEncryption() *Encryption {
+	if m != nil {
+		return m.Encryption
+	}
+	return nil
+}
+
+type Component struct {
+	ExtraArgs            map[string]string `protobuf:"bytes,1,rep,name=extra_args,json=extraArgs,proto3" json:"extraArgs,omitempty" yaml:"extraArgs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *Component) Reset()         { *m = Component{} }
+func (m *Component) String() string { return proto.CompactTextString(m) }
+func (*Component) ProtoMessage()    {}
+func (*Component) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{17}
+}
+func (m *Component) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NodeAllQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Component) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NodeAllQryRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Component.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1320,53 +1436,47 @@ func (m *NodeAllQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *NodeAllQryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeAllQryRequest.Merge(m, src)
+func (m *Component) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Component.Merge(m, src)
 }
-func (m *NodeAllQryRequest) XXX_Size() int {
+func (m *Component) XXX_Size() int {
 	return m.Size()
 }
-func (m *NodeAllQryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeAllQryRequest.DiscardUnknown(m)
+func (m *Component) XXX_DiscardUnknown() {
+	xxx_messageInfo_Component.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeAllQryRequest proto.InternalMessageInfo
-
-func (m *NodeAllQryRequest) GetNamespace() string {
-	if m != nil {
-		return m.Namespace
-	}
-	return ""
-}
+var xxx_messageInfo_Component proto.InternalMessageInfo
 
-func (m *NodeAllQryRequest) GetCluster() string {
+func (m *Component) GetExtraArgs() map[string]string {
 	if m != nil {
-		return m.Cluster
+		return m.ExtraArgs
 	}
-	return ""
+	return nil
 }
 
-type NodeQryRequest struct {
-	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
-	Cluster              string   `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster" yaml:"cluster"`
-	Node                 string   `protobuf:"bytes,3,opt,name=node,proto3" json:"node" yaml:"node"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Kubelet struct {
+	MaxPods              int32             `protobuf:"varint,1,opt,name=max_pods,json=maxPods,proto3" json:"maxPods,omitempty" yaml:"maxPods,omitempty"`
+	SystemReserved       map[string]string `protobuf:"bytes,2,rep,name=system_reserved,json=systemReserved,proto3" json:"systemReserved,omitempty" yaml:"systemReserved,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	KubeReserved         map[string]string `protobuf:"bytes,3,rep,name=kube_reserved,json=kubeReserved,proto3" json:"kubeReserved,omitempty" yaml:"kubeReserved,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	EvictionHard         map[string]string `protobuf:"bytes,4,rep,name=eviction_hard,json=evictionHard,proto3" json:"evictionHard,omitempty" yaml:"evictionHard,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *NodeQryRequest) Reset()         { *m = NodeQryRequest{} }
-func (m *NodeQryRequest) String() string { return proto.CompactTextString(m) }
-func (*NodeQryRequest) ProtoMessage()    {}
-func (*NodeQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{20}
+func (m *Kubelet) Reset()         { *m = Kubelet{} }
+func (m *Kubelet) String() string { return proto.CompactTextString(m) }
+func (*Kubelet) ProtoMessage()    {}
+func (*Kubelet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{18}
 }
-func (m *NodeQryRequest) XXX_Unmarshal(b []byte) error {
+func (m *Kubelet) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *NodeQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Kubelet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_NodeQryRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Kubelet.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1376,58 +1486,66 @@ func (m *NodeQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *NodeQryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_NodeQryRequest.Merge(m, src)
+func (m *Kubelet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Kubelet.Merge(m, src)
 }
-func (m *NodeQryRequest) XXX_Size() int {
+func (m *Kubelet) XXX_Size() int {
 	return m.Size()
 }
-func (m *NodeQryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_NodeQryRequest.DiscardUnknown(m)
+func (m *Kubelet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Kubelet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_NodeQryRequest proto.InternalMessageInfo
+var xxx_messageInfo_Kubelet proto.InternalMessageInfo
 
-func (m *NodeQryRequest) GetNamespace() string {
+func (m *Kubelet) GetMaxPods() int32 {
 	if m != nil {
-		return m.Namespace
+		return m.MaxPods
 	}
-	return ""
+	return 0
 }
 
-func (m *NodeQryRequest) GetCluster() string {
+func (m *Kubelet) GetSystemReserved() map[string]string {
 	if m != nil {
-		return m.Cluster
+		return m.SystemReserved
 	}
-	return ""
+	return nil
 }
 
-func (m *NodeQryRequest) GetNode() string {
+func (m *Kubelet) GetKubeReserved() map[string]string {
 	if m != nil {
-		return m.Node
+		return m.KubeReserved
 	}
-	return ""
+	return nil
 }
 
-type SpecInfoResponse struct {
-	Item                 *SpecInfo `protobuf:"bytes,1,opt,name=item,proto3" json:"item" yaml:"item"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *Kubelet) GetEvictionHard() map[string]string {
+	if m != nil {
+		return m.EvictionHard
+	}
+	return nil
 }
 
-func (m *SpecInfoResponse) Reset()         { *m = SpecInfoResponse{} }
-func (m *SpecInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*SpecInfoResponse) ProtoMessage()    {}
-func (*SpecInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{21}
+type Etcd struct {
+	DataDir              string            `protobuf:"bytes,1,opt,name=data_dir,json=dataDir,proto3" json:"dataDir,omitempty" yaml:"dataDir,omitempty"`
+	ExtraArgs            map[string]string `protobuf:"bytes,2,rep,name=extra_args,json=extraArgs,proto3" json:"extraArgs,omitempty" yaml:"extraArgs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
-func (m *SpecInfoResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *Etcd) Reset()         { *m = Etcd{} }
+func (m *Etcd) String() string { return proto.CompactTextString(m) }
+func (*Etcd) ProtoMessage()    {}
+func (*Etcd) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{19}
+}
+func (m *Etcd) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SpecInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Etcd) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SpecInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Etcd.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1437,45 +1555,57 @@ func (m *SpecInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *SpecInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SpecInfoResponse.Merge(m, src)
+func (m *Etcd) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Etcd.Merge(m, src)
 }
-func (m *SpecInfoResponse) XXX_Size() int {
+func (m *Etcd) XXX_Size() int {
 	return m.Size()
 }
-func (m *SpecInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SpecInfoResponse.DiscardUnknown(m)
+func (m *Etcd) XXX_DiscardUnknown() {
+	xxx_messageInfo_Etcd.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SpecInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_Etcd proto.InternalMessageInfo
 
-func (m *SpecInfoResponse) GetItem() *SpecInfo {
+func (m *Etcd) GetDataDir() string {
 	if m != nil {
-		return m.Item
+		return m.DataDir
 	}
-	return nil
+	return ""
 }
 
-type ListSpecInfoResponse struct {
-	Kind                 string      `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind" yaml:"kind"`
-	Items                []*SpecInfo `protobuf:"bytes,2,rep,name=items,proto3" json:"items" yaml:"items"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *Etcd) GetExtraArgs() map[string]string {
+	if m != nil {
+		return m.ExtraArgs
+	}
+	return nil
 }
 
-func (m *ListSpecInfoResponse) Reset()         { *m = ListSpecInfoResponse{} }
-func (m *ListSpecInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*ListSpecInfoResponse) ProtoMessage()    {}
-func (*ListSpecInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{22}
+type Oidc struct {
+	IssuerUrl            string   `protobuf:"bytes,1,opt,name=issuer_url,json=issuerUrl,proto3" json:"issuerUrl" yaml:"issuerUrl"`
+	ClientId             string   `protobuf:"bytes,2,opt,name=client_id,json=clientId,proto3" json:"clientId" yaml:"clientId"`
+	UsernameClaim        string   `protobuf:"bytes,3,opt,name=username_claim,json=usernameClaim,proto3" json:"usernameClaim,omitempty" yaml:"usernameClaim,omitempty"`
+	UsernamePrefix       string   `protobuf:"bytes,4,opt,name=username_prefix,json=usernamePrefix,proto3" json:"usernamePrefix,omitempty" yaml:"usernamePrefix,omitempty"`
+	GroupsClaim          string   `protobuf:"bytes,5,opt,name=groups_claim,json=groupsClaim,proto3" json:"groupsClaim,omitempty" yaml:"groupsClaim,omitempty"`
+	GroupsPrefix         string   `protobuf:"bytes,6,opt,name=groups_prefix,json=groupsPrefix,proto3" json:"groupsPrefix,omitempty" yaml:"groupsPrefix,omitempty"`
+	Ca                   string   `protobuf:"bytes,7,opt,name=ca,proto3" json:"ca,omitempty" yaml:"ca,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *ListSpecInfoResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *Oidc) Reset()         { *m = Oidc{} }
+func (m *Oidc) String() string { return proto.CompactTextString(m) }
+func (*Oidc) ProtoMessage()    {}
+func (*Oidc) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{20}
+}
+func (m *Oidc) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ListSpecInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Oidc) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ListSpecInfoResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Oidc.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1485,53 +1615,90 @@ func (m *ListSpecInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *ListSpecInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ListSpecInfoResponse.Merge(m, src)
+func (m *Oidc) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Oidc.Merge(m, src)
 }
-func (m *ListSpecInfoResponse) XXX_Size() int {
+func (m *Oidc) XXX_Size() int {
 	return m.Size()
 }
-func (m *ListSpecInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ListSpecInfoResponse.DiscardUnknown(m)
+func (m *Oidc) XXX_DiscardUnknown() {
+	xxx_messageInfo_Oidc.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ListSpecInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_Oidc proto.InternalMessageInfo
 
-func (m *ListSpecInfoResponse) GetKind() string {
+func (m *Oidc) GetIssuerUrl() string {
 	if m != nil {
-		return m.Kind
+		return m.IssuerUrl
 	}
 	return ""
 }
 
-func (m *ListSpecInfoResponse) GetItems() []*SpecInfo {
+func (m *Oidc) GetClientId() string {
 	if m != nil {
-		return m.Items
+		return m.ClientId
 	}
-	return nil
+	return ""
 }
 
-type SpecInfo struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
-	Memory               string   `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory" yaml:"memory"`
-	Cpu                  *CpuInfo `protobuf:"bytes,3,opt,name=cpu,proto3" json:"cpu" yaml:"cpu"`
+func (m *Oidc) GetUsernameClaim() string {
+	if m != nil {
+		return m.UsernameClaim
+	}
+	return ""
+}
+
+func (m *Oidc) GetUsernamePrefix() string {
+	if m != nil {
+		return m.UsernamePrefix
+	}
+	return ""
+}
+
+func (m *Oidc) GetGroupsClaim() string {
+	if m != nil {
+		return m.GroupsClaim
+	}
+	return ""
+}
+
+func (m *Oidc) GetGroupsPrefix() string {
+	if m != nil {
+		return m.GroupsPrefix
+	}
+	return ""
+}
+
+func (m *Oidc) GetCa() string {
+	if m != nil {
+		return m.Ca
+	}
+	return ""
+}
+
+type Audit struct {
+	Level                string   `protobuf:"bytes,1,opt,name=level,proto3" json:"level" yaml:"level"`
+	Policy               string   `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty" yaml:"policy,omitempty"`
+	MaxAge               int32    `protobuf:"varint,3,opt,name=max_age,json=maxAge,proto3" json:"maxAge" yaml:"maxAge"`
+	MaxBackup            int32    `protobuf:"varint,4,opt,name=max_backup,json=maxBackup,proto3" json:"maxBackup" yaml:"maxBackup"`
+	MaxSize              int32    `protobuf:"varint,5,opt,name=max_size,json=maxSize,proto3" json:"maxSize" yaml:"maxSize"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SpecInfo) Reset()         { *m = SpecInfo{} }
-func (m *SpecInfo) String() string { return proto.CompactTextString(m) }
-func (*SpecInfo) ProtoMessage()    {}
-func (*SpecInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{23}
+func (m *Audit) Reset()         { *m = Audit{} }
+func (m *Audit) String() string { return proto.CompactTextString(m) }
+func (*Audit) ProtoMessage()    {}
+func (*Audit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{21}
 }
-func (m *SpecInfo) XXX_Unmarshal(b []byte) error {
+func (m *Audit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SpecInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Audit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SpecInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Audit.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1541,59 +1708,73 @@ func (m *SpecInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *SpecInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SpecInfo.Merge(m, src)
+func (m *Audit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Audit.Merge(m, src)
 }
-func (m *SpecInfo) XXX_Size() int {
+func (m *Audit) XXX_Size() int {
 	return m.Size()
 }
-func (m *SpecInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_SpecInfo.DiscardUnknown(m)
+func (m *Audit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Audit.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SpecInfo proto.InternalMessageInfo
+var xxx_messageInfo_Audit proto.InternalMessageInfo
 
-func (m *SpecInfo) GetName() string {
+func (m *Audit) GetLevel() string {
 	if m != nil {
-		return m.Name
+		return m.Level
 	}
 	return ""
 }
 
-func (m *SpecInfo) GetMemory() string {
+func (m *Audit) GetPolicy() string {
 	if m != nil {
-		return m.Memory
+		return m.Policy
 	}
 	return ""
 }
 
-func (m *SpecInfo) GetCpu() *CpuInfo {
+func (m *Audit) GetMaxAge() int32 {
 	if m != nil {
-		return m.Cpu
+		return m.MaxAge
 	}
-	return nil
+	return 0
 }
 
-type CpuInfo struct {
-	Clock                string   `protobuf:"bytes,1,opt,name=clock,proto3" json:"clock" yaml:"clock"`
-	Count                string   `protobuf:"bytes,2,opt,name=count,proto3" json:"count" yaml:"count"`
+func (m *Audit) GetMaxBackup() int32 {
+	if m != nil {
+		return m.MaxBackup
+	}
+	return 0
+}
+
+func (m *Audit) GetMaxSize() int32 {
+	if m != nil {
+		return m.MaxSize
+	}
+	return 0
+}
+
+type Encryption struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider" yaml:"provider"`
+	Resources            []string `protobuf:"bytes,2,rep,name=resources,proto3" json:"resources,omitempty" yaml:"resources,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CpuInfo) Reset()         { *m = CpuInfo{} }
-func (m *CpuInfo) String() string { return proto.CompactTextString(m) }
-func (*CpuInfo) ProtoMessage()    {}
-func (*CpuInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{24}
+func (m *Encryption) Reset()         { *m = Encryption{} }
+func (m *Encryption) String() string { return proto.CompactTextString(m) }
+func (*Encryption) ProtoMessage()    {}
+func (*Encryption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{22}
 }
-func (m *CpuInfo) XXX_Unmarshal(b []byte) error {
+func (m *Encryption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *CpuInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Encryption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_CpuInfo.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Encryption.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1603,56 +1784,55 @@ func (m *CpuInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *CpuInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CpuInfo.Merge(m, src)
+func (m *Encryption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Encryption.Merge(m, src)
 }
-func (m *CpuInfo) XXX_Size() int {
+func (m *Encryption) XXX_Size() int {
 	return m.Size()
 }
-func (m *CpuInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_CpuInfo.DiscardUnknown(m)
+func (m *Encryption) XXX_DiscardUnknown() {
+	xxx_messageInfo_Encryption.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CpuInfo proto.InternalMessageInfo
+var xxx_messageInfo_Encryption proto.InternalMessageInfo
 
-func (m *CpuInfo) GetClock() string {
+func (m *Encryption) GetProvider() string {
 	if m != nil {
-		return m.Clock
+		return m.Provider
 	}
 	return ""
 }
 
-func (m *CpuInfo) GetCount() string {
+func (m *Encryption) GetResources() []string {
 	if m != nil {
-		return m.Count
+		return m.Resources
 	}
-	return ""
+	return nil
 }
 
-type SpecQryRequest struct {
-	Connectionname       string   `protobuf:"bytes,1,opt,name=connectionname,json=connectionName,proto3" json:"connectionName" yaml:"connectionName"`
-	ControlPlane         string   `protobuf:"bytes,2,opt,name=control_plane,json=cluster,proto3" json:"cluster" yaml:"cluster"`
-	CpuMin               string   `protobuf:"bytes,3,opt,name=cpu_min,json=cpuMin,proto3" json:"cpuMin" yaml:"cpuMin"`
-	CpuMax               string   `protobuf:"bytes,4,opt,name=cpu_max,json=cpuMax,proto3" json:"cpuMax" yaml:"cpuMax"`
-	MemoryMin            string   `protobuf:"bytes,5,opt,name=memory_min,json=memoryMin,proto3" json:"memoryMin" yaml:"memoryMin"`
-	MemoryMax            string   `protobuf:"bytes,6,opt,name=memory_max,json=memoryMax,proto3" json:"memoryMax" yaml:"memoryMax"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type Kilo struct {
+	Topology             string            `protobuf:"bytes,1,opt,name=topology,proto3" json:"topology" yaml:"topology"`
+	Locations            map[string]string `protobuf:"bytes,2,rep,name=locations,proto3" json:"locations,omitempty" yaml:"locations,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	PersistentKeepalive  *int32            `protobuf:"bytes,3,opt,name=persistent_keepalive,json=persistentKeepalive,proto3,wktptr" json:"persistentKeepalive,omitempty" yaml:"persistentKeepalive,omitempty"`
+	Port                 *int32            `protobuf:"bytes,4,opt,name=port,proto3,wktptr" json:"port,omitempty" yaml:"port,omitempty"`
+	AllowedLocationIps   map[string]string `protobuf:"bytes,5,rep,name=allowed_location_ips,json=allowedLocationIPs,proto3" json:"allowedLocationIPs,omitempty" yaml:"allowedLocationIPs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *SpecQryRequest) Reset()         { *m = SpecQryRequest{} }
-func (m *SpecQryRequest) String() string { return proto.CompactTextString(m) }
-func (*SpecQryRequest) ProtoMessage()    {}
-func (*SpecQryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6e98b9bfafe16c0f, []int{25}
+func (m *Kilo) Reset()         { *m = Kilo{} }
+func (m *Kilo) String() string { return proto.CompactTextString(m) }
+func (*Kilo) ProtoMessage()    {}
+func (*Kilo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{23}
 }
-func (m *SpecQryRequest) XXX_Unmarshal(b []byte) error {
+func (m *Kilo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *SpecQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Kilo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_SpecQryRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Kilo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1662,2683 +1842,13372 @@ func (m *SpecQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *SpecQryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SpecQryRequest.Merge(m, src)
+func (m *Kilo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Kilo.Merge(m, src)
 }
-func (m *SpecQryRequest) XXX_Size() int {
+func (m *Kilo) XXX_Size() int {
 	return m.Size()
 }
-func (m *SpecQryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SpecQryRequest.DiscardUnknown(m)
+func (m *Kilo) XXX_DiscardUnknown() {
+	xxx_messageInfo_Kilo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SpecQryRequest proto.InternalMessageInfo
+var xxx_messageInfo_Kilo proto.InternalMessageInfo
 
-func (m *SpecQryRequest) GetConnectionname() string {
+func (m *Kilo) GetTopology() string {
 	if m != nil {
-		return m.Connectionname
+		return m.Topology
 	}
 	return ""
 }
 
-func (m *SpecQryRequest) GetControlPlane() string {
+func (m *Kilo) GetLocations() map[string]string {
 	if m != nil {
-		return m.ControlPlane
+		return m.Locations
 	}
-	return ""
+	return nil
 }
 
-func (m *SpecQryRequest) GetCpuMin() string {
+func (m *Kilo) GetPersistentKeepalive() *int32 {
 	if m != nil {
-		return m.CpuMin
+		return m.PersistentKeepalive
 	}
-	return ""
+	return nil
 }
 
-func (m *SpecQryRequest) GetCpuMax() string {
+func (m *Kilo) GetPort() *int32 {
 	if m != nil {
-		return m.CpuMax
+		return m.Port
 	}
-	return ""
+	return nil
 }
 
-func (m *SpecQryRequest) GetMemoryMin() string {
+func (m *Kilo) GetAllowedLocationIps() map[string]string {
 	if m != nil {
-		return m.MemoryMin
+		return m.AllowedLocationIps
 	}
-	return ""
+	return nil
 }
 
-func (m *SpecQryRequest) GetMemoryMax() string {
-	if m != nil {
-		return m.MemoryMax
-	}
-	return ""
+type ClusterAllQryRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() {
-	proto.RegisterType((*Empty)(nil), "cbmcks.Empty")
-	proto.RegisterType((*MessageResponse)(nil), "cbmcks.MessageResponse")
-	proto.RegisterType((*StatusResponse)(nil), "cbmcks.StatusResponse")
-	proto.RegisterType((*ClusterInfoResponse)(nil), "cbmcks.ClusterInfoResponse")
-	proto.RegisterType((*ListClusterInfoResponse)(nil), "cbmcks.ListClusterInfoResponse")
-	proto.RegisterType((*ClusterInfo)(nil), "cbmcks.ClusterInfo")
-	proto.RegisterType((*ClusterCreateRequest)(nil), "cbmcks.ClusterCreateRequest")
-	proto.RegisterType((*ClusterCreateInfo)(nil), "cbmcks.ClusterCreateInfo")
-	proto.RegisterType((*NodeConfig)(nil), "cbmcks.NodeConfig")
-	proto.RegisterType((*Config)(nil), "cbmcks.Config")
-	proto.RegisterType((*Kubernetes)(nil), "cbmcks.Kubernetes")
-	proto.RegisterType((*ClusterAllQryRequest)(nil), "cbmcks.ClusterAllQryRequest")
-	proto.RegisterType((*ClusterQryRequest)(nil), "cbmcks.ClusterQryRequest")
-	proto.RegisterType((*ClusterStatusInfo)(nil), "cbmcks.ClusterStatusInfo")
-	proto.RegisterType((*NodeInfoResponse)(nil), "cbmcks.NodeInfoResponse")
-	proto.RegisterType((*ListNodeInfoResponse)(nil), "cbmcks.ListNodeInfoResponse")
-	proto.RegisterType((*NodeInfo)(nil), "cbmcks.NodeInfo")
-	proto.RegisterType((*NodeCreateRequest)(nil), "cbmcks.NodeCreateRequest")
-	proto.RegisterType((*NodeCreateInfo)(nil), "cbmcks.NodeCreateInfo")
-	proto.RegisterType((*NodeAllQryRequest)(nil), "cbmcks.NodeAllQryRequest")
-	proto.RegisterType((*NodeQryRequest)(nil), "cbmcks.NodeQryRequest")
-	proto.RegisterType((*SpecInfoResponse)(nil), "cbmcks.SpecInfoResponse")
-	proto.RegisterType((*ListSpecInfoResponse)(nil), "cbmcks.ListSpecInfoResponse")
-	proto.RegisterType((*SpecInfo)(nil), "cbmcks.SpecInfo")
-	proto.RegisterType((*CpuInfo)(nil), "cbmcks.CpuInfo")
-	proto.RegisterType((*SpecQryRequest)(nil), "cbmcks.SpecQryRequest")
+func (m *ClusterAllQryRequest) Reset()         { *m = ClusterAllQryRequest{} }
+func (m *ClusterAllQryRequest) String() string { return proto.CompactTextString(m) }
+func (*ClusterAllQryRequest) ProtoMessage()    {}
+func (*ClusterAllQryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{24}
+}
+func (m *ClusterAllQryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterAllQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterAllQryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClusterAllQryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterAllQryRequest.Merge(m, src)
+}
+func (m *ClusterAllQryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterAllQryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterAllQryRequest.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("cbmcks/cbmcks.proto", fileDescriptor_6e98b9bfafe16c0f) }
+var xxx_messageInfo_ClusterAllQryRequest proto.InternalMessageInfo
 
-var fileDescriptor_6e98b9bfafe16c0f = []byte{
-	// 1869 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcf, 0x6f, 0xdc, 0x4e,
-	0x15, 0x8f, 0xb3, 0xd9, 0xdd, 0xe4, 0x6d, 0x76, 0x93, 0x38, 0xf9, 0x7e, 0xb3, 0xdf, 0x7c, 0xbf,
-	0xc4, 0x65, 0x10, 0x6a, 0x51, 0x45, 0x23, 0x35, 0x48, 0x44, 0x88, 0x42, 0xd3, 0x4d, 0x49, 0x4b,
-	0x93, 0xd0, 0x4e, 0x11, 0x15, 0x12, 0xd2, 0xe2, 0xd8, 0xd3, 0xd4, 0x5a, 0xaf, 0xed, 0xda, 0xde,
-	0x92, 0x70, 0x87, 0x13, 0xdc, 0x38, 0x70, 0xaf, 0xc4, 0x15, 0x09, 0x21, 0xf5, 0xc0, 0x8d, 0x13,
-	0x47, 0xee, 0x48, 0x16, 0x2a, 0xb7, 0x3d, 0xee, 0x1f, 0x80, 0xd0, 0xbc, 0x19, 0x7b, 0xc6, 0xbb,
-	0x49, 0xf3, 0xa3, 0xa9, 0xc4, 0x69, 0xf3, 0x3e, 0xef, 0xcd, 0xc7, 0x6f, 0xc6, 0x33, 0xef, 0x7d,
-	0x3c, 0x81, 0x65, 0xe7, 0xb0, 0xef, 0xf4, 0x92, 0x0d, 0xf1, 0x73, 0x27, 0x8a, 0xc3, 0x34, 0x34,
-	0x6b, 0xc2, 0x5a, 0x5b, 0x39, 0x0a, 0x8f, 0x42, 0x84, 0x36, 0xf8, 0x5f, 0xc2, 0x4b, 0xea, 0x50,
-	0x7d, 0xd8, 0x8f, 0xd2, 0x13, 0xf2, 0x63, 0x58, 0xd8, 0x67, 0x49, 0x62, 0x1f, 0x31, 0xca, 0x92,
-	0x28, 0x0c, 0x12, 0x66, 0x7e, 0x17, 0xea, 0x7d, 0x01, 0xb5, 0x8d, 0x1b, 0xc6, 0xad, 0xb9, 0x07,
-	0x5f, 0x1b, 0x66, 0x56, 0x0e, 0x8d, 0x32, 0xab, 0x75, 0x62, 0xf7, 0xfd, 0xef, 0x11, 0x09, 0x10,
-	0x9a, 0xbb, 0xc8, 0x5b, 0x03, 0x5a, 0xcf, 0x53, 0x3b, 0x1d, 0x24, 0x05, 0xd7, 0x6d, 0x98, 0xe9,
-	0x79, 0x81, 0x2b, 0x89, 0x56, 0x87, 0x99, 0x85, 0xf6, 0x28, 0xb3, 0x1a, 0x82, 0x85, 0x5b, 0x84,
-	0x22, 0xc8, 0x83, 0x9d, 0xd0, 0x65, 0xed, 0xe9, 0x1b, 0xc6, 0xad, 0xaa, 0x08, 0xe6, 0xb6, 0x0a,
-	0xe6, 0x16, 0xa1, 0x08, 0xea, 0x59, 0x56, 0x2e, 0x95, 0xe5, 0x0b, 0x58, 0xee, 0xf8, 0x83, 0x24,
-	0x65, 0xf1, 0xe3, 0xe0, 0x65, 0x58, 0x64, 0x7a, 0x1f, 0x66, 0xbc, 0x94, 0xf5, 0x31, 0xd3, 0xc6,
-	0xdd, 0xe5, 0x3b, 0x72, 0x31, 0xb5, 0x50, 0x91, 0x11, 0x0f, 0x52, 0x19, 0x71, 0x8b, 0x50, 0x04,
-	0xc9, 0xef, 0x0c, 0x58, 0xdd, 0xf3, 0x92, 0xf4, 0x34, 0xf6, 0x4b, 0xad, 0xc3, 0x0e, 0x54, 0x39,
-	0x61, 0xd2, 0x9e, 0xbe, 0x51, 0x39, 0x2b, 0x97, 0x2f, 0x86, 0x99, 0x25, 0xa2, 0x46, 0x99, 0x35,
-	0xaf, 0x92, 0x49, 0x08, 0x15, 0x30, 0x79, 0x5b, 0x87, 0x86, 0x36, 0x82, 0xa7, 0x10, 0xd8, 0x7d,
-	0xa6, 0xa7, 0xc0, 0x6d, 0x95, 0x02, 0xb7, 0x08, 0x45, 0xb0, 0xc8, 0x77, 0xfa, 0x22, 0xf9, 0x1e,
-	0x40, 0x2d, 0xc1, 0xd7, 0x8e, 0x6f, 0xa2, 0x71, 0xf7, 0x8b, 0xb1, 0x84, 0xc5, 0x9e, 0xc0, 0xb4,
-	0xbf, 0x1c, 0x66, 0x96, 0x0c, 0x1e, 0x65, 0x56, 0x53, 0x70, 0x09, 0x9b, 0x50, 0xe9, 0xe0, 0x0f,
-	0xef, 0x3b, 0x5e, 0xd2, 0x9e, 0x51, 0x0f, 0xe7, 0xb6, 0x7a, 0x38, 0xb7, 0x08, 0x45, 0xd0, 0xfc,
-	0x21, 0xcc, 0xf1, 0x8c, 0x93, 0xc8, 0x76, 0x58, 0xbb, 0x8a, 0x23, 0xbe, 0x3e, 0xcc, 0x2c, 0x05,
-	0x8e, 0x32, 0x6b, 0x51, 0x4d, 0x10, 0x21, 0x42, 0x95, 0xdb, 0xdc, 0x81, 0x46, 0x6f, 0x2b, 0xe9,
-	0xbe, 0x61, 0x71, 0xe2, 0x85, 0x41, 0xbb, 0x86, 0x14, 0xdf, 0x18, 0x66, 0x16, 0xf4, 0xb6, 0x92,
-	0x9f, 0x09, 0x74, 0x94, 0x59, 0x4b, 0x72, 0xde, 0x05, 0x46, 0xa8, 0x16, 0x60, 0x3e, 0x85, 0x96,
-	0x23, 0x66, 0xdb, 0x75, 0xc2, 0xe0, 0xa5, 0x77, 0xd4, 0xae, 0x23, 0xd1, 0xb7, 0x86, 0x99, 0xd5,
-	0x94, 0x9e, 0x0e, 0x3a, 0x46, 0x99, 0xb5, 0x22, 0xb7, 0xb3, 0x0e, 0x13, 0x5a, 0x0e, 0x33, 0xbf,
-	0x0f, 0x73, 0x4e, 0xd4, 0xf5, 0x99, 0xed, 0xb2, 0xb8, 0x3d, 0x8b, 0x64, 0xd6, 0x30, 0xb3, 0x66,
-	0x9d, 0x68, 0x0f, 0xb1, 0x51, 0x66, 0x2d, 0x48, 0x1e, 0x89, 0x10, 0x5a, 0x38, 0xf9, 0xac, 0x02,
-	0x96, 0xfe, 0x2a, 0x8c, 0x7b, 0x5d, 0x27, 0xf0, 0xda, 0x73, 0x6a, 0x56, 0x12, 0xee, 0x04, 0x9e,
-	0x9a, 0x95, 0xc2, 0x08, 0xd5, 0x02, 0xcc, 0x0d, 0xa8, 0xfa, 0xf6, 0x21, 0xf3, 0xdb, 0x80, 0xe3,
-	0x71, 0xd3, 0x21, 0xa0, 0x36, 0x1d, 0x9a, 0x84, 0x0a, 0xd8, 0xfc, 0x39, 0x2c, 0x79, 0x41, 0x92,
-	0xda, 0xbe, 0xdf, 0xed, 0x87, 0x41, 0xd7, 0x3e, 0x62, 0x41, 0xda, 0x6e, 0xe0, 0xe0, 0x6f, 0x0f,
-	0x33, 0x6b, 0x41, 0x3a, 0xf7, 0xc3, 0x60, 0x9b, 0xbb, 0x46, 0x99, 0xf5, 0xb9, 0xdc, 0xbb, 0x65,
-	0x07, 0xa1, 0xe3, 0xa1, 0xe6, 0x2e, 0x34, 0x5c, 0x96, 0x38, 0xb1, 0x17, 0xa5, 0xfc, 0x3d, 0xcd,
-	0x23, 0xe9, 0x37, 0x87, 0x99, 0xa5, 0xc3, 0xa3, 0xcc, 0x32, 0x05, 0xa1, 0x06, 0x12, 0xaa, 0x87,
-	0x98, 0x8f, 0x60, 0xde, 0x89, 0x99, 0x9d, 0x32, 0xb7, 0x9b, 0x7a, 0x7d, 0xd6, 0x6e, 0x2a, 0x26,
-	0x89, 0xff, 0xd4, 0xc3, 0x73, 0x21, 0x99, 0x34, 0x90, 0x50, 0x3d, 0xc4, 0xdc, 0x86, 0x6a, 0x10,
-	0xba, 0x2c, 0x69, 0xb7, 0xf0, 0xa0, 0x2e, 0xe6, 0xfb, 0xfe, 0x20, 0x74, 0x99, 0x3a, 0xa5, 0x18,
-	0xa2, 0x16, 0x0c, 0x4d, 0x42, 0x05, 0x4c, 0xfe, 0x3e, 0x0d, 0x2b, 0xf2, 0x98, 0x74, 0x90, 0x99,
-	0xb2, 0xd7, 0x03, 0x96, 0xa4, 0xe5, 0x7d, 0x6d, 0x5c, 0x61, 0x5f, 0x3f, 0x81, 0xf9, 0xbe, 0x17,
-	0x84, 0x71, 0xbe, 0xb1, 0xc5, 0x51, 0xbe, 0x39, 0xcc, 0xac, 0x12, 0x3e, 0xca, 0xac, 0x65, 0x79,
-	0xaa, 0x34, 0x94, 0xd0, 0x52, 0x10, 0x27, 0x8b, 0xec, 0xd4, 0x79, 0x95, 0x93, 0x55, 0x14, 0x99,
-	0x8e, 0x2b, 0x32, 0x1d, 0x25, 0xb4, 0x14, 0x64, 0xfe, 0x44, 0x96, 0xda, 0x99, 0x53, 0xab, 0x85,
-	0x58, 0x06, 0x5c, 0x3e, 0x2c, 0xe9, 0x94, 0xbd, 0xe6, 0x86, 0x2a, 0xe9, 0x12, 0x20, 0x34, 0x77,
-	0x91, 0xdf, 0xcc, 0xc0, 0xd2, 0xc4, 0xe8, 0xcb, 0x15, 0xbc, 0x5f, 0x42, 0xd3, 0x09, 0x83, 0x34,
-	0x0e, 0xfd, 0x6e, 0xe4, 0xdb, 0x01, 0x93, 0xb5, 0xd7, 0xd4, 0x5f, 0xa9, 0x38, 0x98, 0x62, 0xd6,
-	0x32, 0xf8, 0x29, 0x8f, 0x55, 0xb3, 0xd6, 0x51, 0x42, 0x4b, 0x41, 0xe6, 0x2e, 0xd4, 0xf8, 0xb1,
-	0x62, 0x71, 0xbb, 0x72, 0x26, 0x35, 0x96, 0x47, 0x11, 0xa5, 0xca, 0xa3, 0xb0, 0x09, 0x95, 0x0e,
-	0xb3, 0x03, 0x35, 0x59, 0x62, 0xc4, 0x02, 0xb6, 0x8a, 0x05, 0xd4, 0x48, 0x9c, 0xbc, 0xd6, 0x34,
-	0x8b, 0xcc, 0xb0, 0xc8, 0x48, 0x87, 0x3a, 0xd9, 0xd5, 0x8f, 0x39, 0xd9, 0xb5, 0x4f, 0x71, 0xb2,
-	0xeb, 0x57, 0x3d, 0xd9, 0xe4, 0xcf, 0x06, 0x80, 0x5a, 0x4d, 0xb3, 0x03, 0xe0, 0x84, 0x41, 0xc0,
-	0x1c, 0xa4, 0x35, 0x54, 0x09, 0x54, 0xa8, 0x2a, 0x81, 0x0a, 0x23, 0x54, 0x0b, 0xe0, 0x0b, 0xe5,
-	0x84, 0x83, 0x20, 0x95, 0xaa, 0x04, 0x17, 0x0a, 0x01, 0xb5, 0x50, 0x68, 0x12, 0x2a, 0x60, 0xbe,
-	0xed, 0x92, 0x88, 0x39, 0xf2, 0x88, 0xe0, 0xb6, 0xe3, 0xb6, 0xda, 0x76, 0xdc, 0x22, 0x14, 0x41,
-	0x62, 0x43, 0x4d, 0x26, 0xfb, 0x02, 0xa0, 0x37, 0x38, 0x64, 0x71, 0xc0, 0x52, 0x96, 0x48, 0x15,
-	0x52, 0x6c, 0x91, 0x27, 0x85, 0x47, 0x76, 0xa6, 0xc2, 0xd6, 0x3a, 0x53, 0x81, 0xf1, 0xce, 0xa4,
-	0x8c, 0xbf, 0x4e, 0x03, 0xa8, 0xf1, 0xe3, 0x8d, 0xc1, 0xb8, 0x5a, 0x63, 0xd8, 0x82, 0xd9, 0x28,
-	0x74, 0xbb, 0x8e, 0xe7, 0xc6, 0xb2, 0xb0, 0xe0, 0x59, 0x8d, 0x42, 0xb7, 0xe3, 0xb9, 0xb1, 0x3a,
-	0xab, 0x12, 0x20, 0x34, 0x77, 0xf1, 0xea, 0x9b, 0xb0, 0xf8, 0x8d, 0xe7, 0x30, 0x31, 0xba, 0xa2,
-	0xde, 0xb6, 0xc4, 0x25, 0x83, 0x7c, 0xdb, 0x1a, 0x48, 0xa8, 0x1e, 0x62, 0xfe, 0x02, 0x96, 0x84,
-	0xd9, 0x75, 0x83, 0xa4, 0xeb, 0x86, 0x7d, 0xdb, 0x0b, 0xa4, 0x66, 0xd8, 0x18, 0x66, 0xd6, 0xa2,
-	0x8c, 0xdd, 0x09, 0x92, 0x1d, 0xf4, 0x8d, 0x32, 0x6b, 0xb5, 0xc4, 0x59, 0x78, 0x08, 0x9d, 0x08,
-	0x26, 0x2f, 0x8a, 0xba, 0xbc, 0xed, 0xfb, 0xcf, 0xe2, 0x93, 0xeb, 0xaa, 0xcb, 0xe4, 0xf7, 0x46,
-	0x51, 0xac, 0xae, 0x91, 0x96, 0xeb, 0x61, 0xa9, 0x1f, 0xf4, 0x17, 0x22, 0x21, 0xf5, 0x42, 0x24,
-	0x40, 0x68, 0xee, 0x22, 0x7f, 0x51, 0xf9, 0x28, 0xa1, 0xc6, 0xb7, 0x7d, 0xf4, 0xca, 0x4e, 0xf2,
-	0x5c, 0x70, 0xdb, 0x23, 0xa0, 0xb6, 0x3d, 0x9a, 0x84, 0x0a, 0xd8, 0xdc, 0x84, 0x5a, 0xcc, 0xec,
-	0xa4, 0x68, 0x34, 0x58, 0x85, 0x04, 0xa2, 0xaa, 0x90, 0xb0, 0x09, 0x95, 0x8e, 0xab, 0x8b, 0xf8,
-	0x67, 0xb0, 0x98, 0x37, 0xd9, 0x42, 0x63, 0xdf, 0x2b, 0x29, 0xf8, 0xc9, 0x66, 0x7c, 0x8e, 0x7c,
-	0xff, 0xad, 0x01, 0x2b, 0x5c, 0xbe, 0x4f, 0xf0, 0x5e, 0x4a, 0xbb, 0x6f, 0x97, 0xb5, 0xfb, 0x19,
-	0x92, 0xe0, 0x83, 0xc2, 0xfd, 0xbf, 0x33, 0x30, 0x9b, 0x87, 0x7f, 0x42, 0xd5, 0xce, 0xab, 0x63,
-	0xcc, 0x5c, 0x16, 0xa4, 0x9e, 0xed, 0xcb, 0xe5, 0x17, 0xd5, 0xb1, 0x40, 0xb5, 0xea, 0x58, 0x60,
-	0xbc, 0x3a, 0x16, 0x06, 0x17, 0xa9, 0xd1, 0xe0, 0xd0, 0xf7, 0x9c, 0xae, 0x17, 0xc9, 0xb3, 0x87,
-	0x22, 0x55, 0x80, 0x8f, 0x23, 0x25, 0x52, 0x73, 0x84, 0xd0, 0xc2, 0xc9, 0xf3, 0x8d, 0x43, 0x3f,
-	0x97, 0xed, 0x98, 0x2f, 0xb7, 0x55, 0xbe, 0xdc, 0x22, 0x14, 0xc1, 0xa2, 0xae, 0xd6, 0x2e, 0x50,
-	0x57, 0xcd, 0x9b, 0x50, 0x71, 0x92, 0x48, 0xb6, 0x92, 0xcf, 0x86, 0x99, 0xc5, 0xcd, 0x51, 0x66,
-	0x81, 0x9c, 0x4e, 0x12, 0x11, 0xca, 0xa1, 0x09, 0x31, 0x38, 0x7b, 0x65, 0x31, 0xc8, 0xf5, 0x7a,
-	0x12, 0x75, 0x45, 0x57, 0x9d, 0xd3, 0xf4, 0x7a, 0x12, 0xed, 0xc9, 0xc6, 0xba, 0x50, 0x3c, 0x7d,
-	0x4f, 0xf4, 0xd6, 0xc2, 0xc9, 0xf3, 0x88, 0xd9, 0x91, 0x17, 0x06, 0x5d, 0x5d, 0x70, 0x63, 0x1e,
-	0x02, 0xcf, 0x39, 0xcc, 0xfc, 0x24, 0x15, 0x20, 0xa1, 0x7a, 0x88, 0x79, 0x1f, 0xe0, 0xd7, 0x61,
-	0xc0, 0x24, 0x4f, 0x43, 0x95, 0x12, 0x8e, 0xe6, 0x2c, 0xb2, 0x94, 0x14, 0x10, 0xa1, 0xca, 0x4d,
-	0xfe, 0x65, 0xc0, 0x12, 0xb6, 0xd1, 0xeb, 0x15, 0xa4, 0x57, 0xad, 0x50, 0xe6, 0x9e, 0x3c, 0xd8,
-	0xe2, 0xeb, 0xf2, 0xf3, 0x92, 0x6e, 0xba, 0xbc, 0x58, 0xfc, 0x9b, 0x01, 0xad, 0xf2, 0xd0, 0x49,
-	0xf1, 0x67, 0x7c, 0x3a, 0xf1, 0x37, 0xfd, 0x51, 0xe2, 0x0f, 0xbb, 0x07, 0x1f, 0x73, 0xbd, 0x4d,
-	0xe9, 0xea, 0xdd, 0xe3, 0x9d, 0x5c, 0xcd, 0xff, 0x87, 0x64, 0xb0, 0x58, 0x86, 0x2e, 0xd3, 0xa5,
-	0x57, 0x50, 0xba, 0x40, 0x0a, 0xc4, 0x05, 0x12, 0xfe, 0x3c, 0x83, 0xc5, 0xe7, 0x11, 0x73, 0x2e,
-	0xd2, 0x42, 0xf2, 0xb8, 0x8b, 0xb6, 0x90, 0x09, 0xde, 0x6b, 0x69, 0x21, 0x45, 0x16, 0xe7, 0xb7,
-	0x90, 0x3f, 0x19, 0x30, 0x9b, 0x87, 0x5f, 0xae, 0x85, 0x6c, 0x42, 0xad, 0xcf, 0xfa, 0x61, 0x7c,
-	0xa2, 0xb7, 0x71, 0x81, 0xa8, 0x4d, 0x29, 0x6c, 0x42, 0xa5, 0xc3, 0xdc, 0x82, 0x8a, 0x13, 0x0d,
-	0xe4, 0xf9, 0x5c, 0x28, 0x3e, 0x47, 0xa2, 0x01, 0xa6, 0x2b, 0xca, 0x6f, 0x34, 0xd0, 0xca, 0x6f,
-	0x34, 0xe0, 0xe5, 0x37, 0x1a, 0x90, 0x1e, 0xd4, 0x65, 0x18, 0x0a, 0x6d, 0x3f, 0x74, 0x7a, 0xba,
-	0xe2, 0x40, 0x40, 0x13, 0xda, 0xdc, 0xe4, 0x42, 0x9b, 0xff, 0x96, 0x95, 0xf9, 0xdc, 0xf9, 0xca,
-	0x9c, 0xfc, 0xa1, 0x02, 0x2d, 0xbe, 0x2a, 0xda, 0x5e, 0x7d, 0x0e, 0x2d, 0xa5, 0xf5, 0xb5, 0x55,
-	0xba, 0x3d, 0xcc, 0x2c, 0xcd, 0x73, 0x20, 0xd6, 0xeb, 0xb3, 0xf1, 0x4f, 0x85, 0x03, 0x5c, 0xb9,
-	0xb1, 0x40, 0xf3, 0xde, 0xe4, 0xb7, 0xe4, 0x65, 0x76, 0xf1, 0x77, 0xa0, 0xee, 0x44, 0x83, 0x6e,
-	0xdf, 0xcb, 0x3f, 0xb3, 0xc5, 0x07, 0x5d, 0x34, 0xd8, 0xf7, 0x34, 0x29, 0x25, 0x6c, 0xfe, 0x41,
-	0x87, 0x7f, 0x14, 0xa3, 0xec, 0x63, 0xd9, 0x87, 0x8b, 0x51, 0xf6, 0x71, 0x79, 0x94, 0x7d, 0x2c,
-	0x47, 0xd9, 0xc7, 0xbc, 0x59, 0x88, 0x77, 0x88, 0x8f, 0xd3, 0xae, 0xcf, 0x04, 0x2a, 0x9e, 0xb8,
-	0xa8, 0xbf, 0x75, 0x7c, 0xa8, 0x72, 0xeb, 0x0c, 0xf6, 0xb1, 0x6c, 0xce, 0x3a, 0x03, 0x3e, 0xbd,
-	0xcc, 0xc0, 0x13, 0x50, 0xee, 0xbb, 0xef, 0xaa, 0x30, 0xb3, 0xdf, 0xd9, 0xa6, 0xe6, 0x26, 0xd4,
-	0x1f, 0x31, 0xdb, 0x4f, 0x5f, 0x9d, 0x98, 0xcd, 0x7c, 0x13, 0xe1, 0x2d, 0xf5, 0xda, 0x6a, 0x6e,
-	0x8e, 0xdd, 0x55, 0x93, 0x29, 0x73, 0x0f, 0x9a, 0xa2, 0x92, 0x4b, 0x0d, 0x6b, 0x7e, 0x75, 0xea,
-	0x7d, 0x82, 0x7c, 0xe1, 0x6b, 0x5f, 0x9e, 0x72, 0x99, 0xaa, 0xb1, 0x1d, 0x40, 0x43, 0xbb, 0xc2,
-	0x9d, 0xe0, 0x2a, 0x55, 0xdd, 0x35, 0x2b, 0xf7, 0x9e, 0x71, 0xeb, 0x4b, 0xa6, 0xcc, 0x1f, 0x01,
-	0xec, 0xb2, 0x82, 0x6e, 0xfc, 0xaa, 0x43, 0xe3, 0x3a, 0x27, 0xaf, 0x1d, 0x68, 0xee, 0x30, 0x9f,
-	0xa9, 0x59, 0x7e, 0x80, 0xaa, 0x68, 0x90, 0xe5, 0xbb, 0x78, 0x64, 0xa9, 0x6f, 0xbb, 0x2e, 0x2f,
-	0xd7, 0x6a, 0xfc, 0x44, 0xa3, 0x5f, 0xfb, 0x4a, 0x9f, 0xd6, 0xb8, 0x1a, 0x26, 0x53, 0xe6, 0x43,
-	0x98, 0xcd, 0x3d, 0x65, 0x9a, 0xf2, 0xea, 0x9c, 0x47, 0x73, 0x0f, 0xea, 0xbb, 0x4c, 0xb0, 0x94,
-	0x5a, 0xba, 0x46, 0xd1, 0x1e, 0x57, 0xcf, 0xda, 0xf0, 0x1f, 0x00, 0x50, 0xd6, 0x0f, 0xdf, 0xb0,
-	0x0f, 0x32, 0x9c, 0xbd, 0x16, 0x0f, 0xc4, 0x2c, 0x78, 0x3d, 0x50, 0xa3, 0xcb, 0xd5, 0xa1, 0x3c,
-	0x85, 0xf1, 0xa2, 0x4e, 0xa6, 0x1e, 0x2c, 0xfe, 0xe3, 0xfd, 0xba, 0xf1, 0xcf, 0xf7, 0xeb, 0xc6,
-	0xbf, 0xdf, 0xaf, 0x1b, 0x7f, 0xfc, 0xcf, 0xfa, 0xd4, 0x61, 0x0d, 0xff, 0xbd, 0xb2, 0xf9, 0xbf,
-	0x00, 0x00, 0x00, 0xff, 0xff, 0x99, 0xf9, 0x54, 0x19, 0x93, 0x19, 0x00, 0x00,
+func (m *ClusterAllQryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type ClusterQryRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
+	Cluster              string   `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster" yaml:"cluster"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
 
-// MCARClient is the client API for MCAR service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MCARClient interface {
-	Healthy(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MessageResponse, error)
-	CreateCluster(ctx context.Context, in *ClusterCreateRequest, opts ...grpc.CallOption) (*ClusterInfoResponse, error)
-	ListCluster(ctx context.Context, in *ClusterAllQryRequest, opts ...grpc.CallOption) (*ListClusterInfoResponse, error)
-	GetCluster(ctx context.Context, in *ClusterQryRequest, opts ...grpc.CallOption) (*ClusterInfoResponse, error)
-	DeleteCluster(ctx context.Context, in *ClusterQryRequest, opts ...grpc.CallOption) (*StatusResponse, error)
-	AddNode(ctx context.Context, in *NodeCreateRequest, opts ...grpc.CallOption) (*ListNodeInfoResponse, error)
-	ListNode(ctx context.Context, in *NodeAllQryRequest, opts ...grpc.CallOption) (*ListNodeInfoResponse, error)
-	GetNode(ctx context.Context, in *NodeQryRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
-	RemoveNode(ctx context.Context, in *NodeQryRequest, opts ...grpc.CallOption) (*StatusResponse, error)
-	ListSpec(ctx context.Context, in *SpecQryRequest, opts ...grpc.CallOption) (*ListSpecInfoResponse, error)
-}
-
-type mCARClient struct {
-	cc *grpc.ClientConn
+func (m *ClusterQryRequest) Reset()         { *m = ClusterQryRequest{} }
+func (m *ClusterQryRequest) String() string { return proto.CompactTextString(m) }
+func (*ClusterQryRequest) ProtoMessage()    {}
+func (*ClusterQryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{25}
 }
-
-func NewMCARClient(cc *grpc.ClientConn) MCARClient {
-	return &mCARClient{cc}
+func (m *ClusterQryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *mCARClient) Healthy(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MessageResponse, error) {
-	out := new(MessageResponse)
-	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/Healthy", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClusterQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterQryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *mCARClient) CreateCluster(ctx context.Context, in *ClusterCreateRequest, opts ...grpc.CallOption) (*ClusterInfoResponse, error) {
-	out := new(ClusterInfoResponse)
-	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/CreateCluster", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ClusterQryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterQryRequest.Merge(m, src)
 }
-
-func (c *mCARClient) ListCluster(ctx context.Context, in *ClusterAllQryRequest, opts ...grpc.CallOption) (*ListClusterInfoResponse, error) {
-	out := new(ListClusterInfoResponse)
-	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/ListCluster", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ClusterQryRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *mCARClient) GetCluster(ctx context.Context, in *ClusterQryRequest, opts ...grpc.CallOption) (*ClusterInfoResponse, error) {
-	out := new(ClusterInfoResponse)
-	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/GetCluster", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ClusterQryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterQryRequest.DiscardUnknown(m)
 }
 
-func (c *mCARClient) DeleteCluster(ctx context.Context, in *ClusterQryRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
-	out := new(StatusResponse)
-	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/DeleteCluster", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClusterQryRequest proto.InternalMessageInfo
+
+func (m *ClusterQryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return out, nil
+	return ""
 }
 
-func (c *mCARClient) AddNode(ctx context.Context, in *NodeCreateRequest, opts ...grpc.CallOption) (*ListNodeInfoResponse, error) {
-	out := new(ListNodeInfoResponse)
-	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/AddNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClusterQryRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
 	}
-	return out, nil
+	return ""
 }
 
-func (c *mCARClient) ListNode(ctx context.Context, in *NodeAllQryRequest, opts ...grpc.CallOption) (*ListNodeInfoResponse, error) {
-	out := new(ListNodeInfoResponse)
-	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/ListNode", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ClusterStatusInfo struct {
+	Phase                string   `protobuf:"bytes,1,opt,name=phase,proto3" json:"phase" yaml:"phase"`
+	Reason               string   `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason" yaml:"reason"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message" yaml:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *mCARClient) GetNode(ctx context.Context, in *NodeQryRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error) {
-	out := new(NodeInfoResponse)
-	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/GetNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClusterStatusInfo) Reset()         { *m = ClusterStatusInfo{} }
+func (m *ClusterStatusInfo) String() string { return proto.CompactTextString(m) }
+func (*ClusterStatusInfo) ProtoMessage()    {}
+func (*ClusterStatusInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{26}
+}
+func (m *ClusterStatusInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClusterStatusInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClusterStatusInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *ClusterStatusInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterStatusInfo.Merge(m, src)
+}
+func (m *ClusterStatusInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClusterStatusInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterStatusInfo.DiscardUnknown(m)
 }
 
-func (c *mCARClient) RemoveNode(ctx context.Context, in *NodeQryRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
-	out := new(StatusResponse)
-	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/RemoveNode", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ClusterStatusInfo proto.InternalMessageInfo
+
+func (m *ClusterStatusInfo) GetPhase() string {
+	if m != nil {
+		return m.Phase
 	}
-	return out, nil
+	return ""
 }
 
-func (c *mCARClient) ListSpec(ctx context.Context, in *SpecQryRequest, opts ...grpc.CallOption) (*ListSpecInfoResponse, error) {
-	out := new(ListSpecInfoResponse)
-	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/ListSpec", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ClusterStatusInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-// MCARServer is the server API for MCAR service.
-type MCARServer interface {
-	Healthy(context.Context, *Empty) (*MessageResponse, error)
-	CreateCluster(context.Context, *ClusterCreateRequest) (*ClusterInfoResponse, error)
-	ListCluster(context.Context, *ClusterAllQryRequest) (*ListClusterInfoResponse, error)
-	GetCluster(context.Context, *ClusterQryRequest) (*ClusterInfoResponse, error)
-	DeleteCluster(context.Context, *ClusterQryRequest) (*StatusResponse, error)
-	AddNode(context.Context, *NodeCreateRequest) (*ListNodeInfoResponse, error)
-	ListNode(context.Context, *NodeAllQryRequest) (*ListNodeInfoResponse, error)
-	GetNode(context.Context, *NodeQryRequest) (*NodeInfoResponse, error)
-	RemoveNode(context.Context, *NodeQryRequest) (*StatusResponse, error)
-	ListSpec(context.Context, *SpecQryRequest) (*ListSpecInfoResponse, error)
+func (m *ClusterStatusInfo) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
 }
 
-// UnimplementedMCARServer can be embedded to have forward compatible implementations.
-type UnimplementedMCARServer struct {
+type NodeInfoResponse struct {
+	Item                 *NodeInfo `protobuf:"bytes,1,opt,name=item,proto3" json:"item" yaml:"item"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (*UnimplementedMCARServer) Healthy(ctx context.Context, req *Empty) (*MessageResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Healthy not implemented")
-}
-func (*UnimplementedMCARServer) CreateCluster(ctx context.Context, req *ClusterCreateRequest) (*ClusterInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateCluster not implemented")
-}
-func (*UnimplementedMCARServer) ListCluster(ctx context.Context, req *ClusterAllQryRequest) (*ListClusterInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListCluster not implemented")
-}
-func (*UnimplementedMCARServer) GetCluster(ctx context.Context, req *ClusterQryRequest) (*ClusterInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCluster not implemented")
-}
-func (*UnimplementedMCARServer) DeleteCluster(ctx context.Context, req *ClusterQryRequest) (*StatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteCluster not implemented")
+func (m *NodeInfoResponse) Reset()         { *m = NodeInfoResponse{} }
+func (m *NodeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*NodeInfoResponse) ProtoMessage()    {}
+func (*NodeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{27}
 }
-func (*UnimplementedMCARServer) AddNode(ctx context.Context, req *NodeCreateRequest) (*ListNodeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddNode not implemented")
+func (m *NodeInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMCARServer) ListNode(ctx context.Context, req *NodeAllQryRequest) (*ListNodeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListNode not implemented")
+func (m *NodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMCARServer) GetNode(ctx context.Context, req *NodeQryRequest) (*NodeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetNode not implemented")
+func (m *NodeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInfoResponse.Merge(m, src)
 }
-func (*UnimplementedMCARServer) RemoveNode(ctx context.Context, req *NodeQryRequest) (*StatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveNode not implemented")
+func (m *NodeInfoResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMCARServer) ListSpec(ctx context.Context, req *SpecQryRequest) (*ListSpecInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListSpec not implemented")
+func (m *NodeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInfoResponse.DiscardUnknown(m)
 }
 
-func RegisterMCARServer(s *grpc.Server, srv MCARServer) {
-	s.RegisterService(&_MCAR_serviceDesc, srv)
-}
+var xxx_messageInfo_NodeInfoResponse proto.InternalMessageInfo
 
-func _MCAR_Healthy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MCARServer).Healthy(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cbmcks.MCAR/Healthy",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MCARServer).Healthy(ctx, req.(*Empty))
+func (m *NodeInfoResponse) GetItem() *NodeInfo {
+	if m != nil {
+		return m.Item
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _MCAR_CreateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterCreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MCARServer).CreateCluster(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cbmcks.MCAR/CreateCluster",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MCARServer).CreateCluster(ctx, req.(*ClusterCreateRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type ListNodeInfoResponse struct {
+	Kind                 string         `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind" yaml:"kind"`
+	Items                []*NodeInfo    `protobuf:"bytes,2,rep,name=items,proto3" json:"items" yaml:"items"`
+	Failed               []*NodeFailure `protobuf:"bytes,3,rep,name=failed,proto3" json:"failed,omitempty" yaml:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
 
-func _MCAR_ListCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterAllQryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MCARServer).ListCluster(ctx, in)
+func (m *ListNodeInfoResponse) Reset()         { *m = ListNodeInfoResponse{} }
+func (m *ListNodeInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*ListNodeInfoResponse) ProtoMessage()    {}
+func (*ListNodeInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{28}
+}
+func (m *ListNodeInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListNodeInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListNodeInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cbmcks.MCAR/ListCluster",
+}
+func (m *ListNodeInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNodeInfoResponse.Merge(m, src)
+}
+func (m *ListNodeInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListNodeInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNodeInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNodeInfoResponse proto.InternalMessageInfo
+
+func (m *ListNodeInfoResponse) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MCARServer).ListCluster(ctx, req.(*ClusterAllQryRequest))
+	return ""
+}
+
+func (m *ListNodeInfoResponse) GetItems() []*NodeInfo {
+	if m != nil {
+		return m.Items
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _MCAR_GetCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterQryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *ListNodeInfoResponse) GetFailed() []*NodeFailure {
+	if m != nil {
+		return m.Failed
 	}
-	if interceptor == nil {
-		return srv.(MCARServer).GetCluster(ctx, in)
+	return nil
+}
+
+type NodeFailure struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message" yaml:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeFailure) Reset()         { *m = NodeFailure{} }
+func (m *NodeFailure) String() string { return proto.CompactTextString(m) }
+func (*NodeFailure) ProtoMessage()    {}
+func (*NodeFailure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{29}
+}
+func (m *NodeFailure) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeFailure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeFailure.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cbmcks.MCAR/GetCluster",
+}
+func (m *NodeFailure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeFailure.Merge(m, src)
+}
+func (m *NodeFailure) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeFailure) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeFailure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeFailure proto.InternalMessageInfo
+
+func (m *NodeFailure) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MCARServer).GetCluster(ctx, req.(*ClusterQryRequest))
+	return ""
+}
+
+func (m *NodeFailure) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MCAR_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ClusterQryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type NodeInfo struct {
+	Name                 string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	Kind                 string            `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind" yaml:"kind"`
+	Credential           string            `protobuf:"bytes,3,opt,name=credential,proto3" json:"credential" yaml:"credential"`
+	PublicIp             string            `protobuf:"bytes,4,opt,name=public_ip,json=publicIp,proto3" json:"publicIp" yaml:"publicIp"`
+	Role                 string            `protobuf:"bytes,5,opt,name=role,proto3" json:"role" yaml:"role"`
+	Spec                 string            `protobuf:"bytes,6,opt,name=spec,proto3" json:"spec" yaml:"spec"`
+	Csp                  string            `protobuf:"bytes,7,opt,name=csp,proto3" json:"csp" yaml:"csp"`
+	CreatedTime          string            `protobuf:"bytes,8,opt,name=created_time,json=createdTime,proto3" json:"createdTime" yaml:"createdTime"`
+	CspLabel             string            `protobuf:"bytes,9,opt,name=csp_label,json=cspLabel,proto3" json:"cspLabel" yaml:"cspLabel"`
+	RegionLabel          string            `protobuf:"bytes,10,opt,name=region_label,json=regionLabel,proto3" json:"regionLabel" yaml:"regionLabel"`
+	ZoneLabel            string            `protobuf:"bytes,11,opt,name=zone_label,json=zoneLabel,proto3" json:"zoneLabel" yaml:"zoneLabel"`
+	Labels               map[string]string `protobuf:"bytes,12,rep,name=labels,proto3" json:"labels,omitempty" yaml:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Taints               []*Taint          `protobuf:"bytes,13,rep,name=taints,proto3" json:"taints,omitempty" yaml:"taints,omitempty"`
+	InstanceTypeLabel    string            `protobuf:"bytes,14,opt,name=instance_type_label,json=instanceTypeLabel,proto3" json:"instanceTypeLabel" yaml:"instanceTypeLabel"`
+	ConnectionLabel      string            `protobuf:"bytes,15,opt,name=connection_label,json=connectionLabel,proto3" json:"connectionLabel" yaml:"connectionLabel"`
+	ProviderId           string            `protobuf:"bytes,16,opt,name=provider_id,json=providerId,proto3" json:"providerId" yaml:"providerId"`
+	HostKey              string            `protobuf:"bytes,17,opt,name=host_key,json=hostKey,proto3" json:"hostKey,omitempty" yaml:"hostKey,omitempty"`
+	PrivateIp            string            `protobuf:"bytes,18,opt,name=private_ip,json=privateIp,proto3" json:"privateIp,omitempty" yaml:"privateIp,omitempty"`
+	Connection           string            `protobuf:"bytes,19,opt,name=connection,proto3" json:"connection,omitempty" yaml:"connection,omitempty"`
+	Ssh                  *SSH              `protobuf:"bytes,20,opt,name=ssh,proto3" json:"ssh,omitempty" yaml:"ssh,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *NodeInfo) Reset()         { *m = NodeInfo{} }
+func (m *NodeInfo) String() string { return proto.CompactTextString(m) }
+func (*NodeInfo) ProtoMessage()    {}
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{30}
+}
+func (m *NodeInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(MCARServer).DeleteCluster(ctx, in)
+}
+func (m *NodeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeInfo.Merge(m, src)
+}
+func (m *NodeInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeInfo proto.InternalMessageInfo
+
+func (m *NodeInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cbmcks.MCAR/DeleteCluster",
+	return ""
+}
+
+func (m *NodeInfo) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MCARServer).DeleteCluster(ctx, req.(*ClusterQryRequest))
+	return ""
+}
+
+func (m *NodeInfo) GetCredential() string {
+	if m != nil {
+		return m.Credential
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MCAR_AddNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NodeCreateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *NodeInfo) GetPublicIp() string {
+	if m != nil {
+		return m.PublicIp
 	}
-	if interceptor == nil {
-		return srv.(MCARServer).AddNode(ctx, in)
+	return ""
+}
+
+func (m *NodeInfo) GetRole() string {
+	if m != nil {
+		return m.Role
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cbmcks.MCAR/AddNode",
+	return ""
+}
+
+func (m *NodeInfo) GetSpec() string {
+	if m != nil {
+		return m.Spec
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MCARServer).AddNode(ctx, req.(*NodeCreateRequest))
+	return ""
+}
+
+func (m *NodeInfo) GetCsp() string {
+	if m != nil {
+		return m.Csp
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MCAR_ListNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NodeAllQryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *NodeInfo) GetCreatedTime() string {
+	if m != nil {
+		return m.CreatedTime
 	}
-	if interceptor == nil {
-		return srv.(MCARServer).ListNode(ctx, in)
+	return ""
+}
+
+func (m *NodeInfo) GetCspLabel() string {
+	if m != nil {
+		return m.CspLabel
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cbmcks.MCAR/ListNode",
+	return ""
+}
+
+func (m *NodeInfo) GetRegionLabel() string {
+	if m != nil {
+		return m.RegionLabel
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MCARServer).ListNode(ctx, req.(*NodeAllQryRequest))
+	return ""
+}
+
+func (m *NodeInfo) GetZoneLabel() string {
+	if m != nil {
+		return m.ZoneLabel
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _MCAR_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NodeQryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *NodeInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
 	}
-	if interceptor == nil {
-		return srv.(MCARServer).GetNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cbmcks.MCAR/GetNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MCARServer).GetNode(ctx, req.(*NodeQryRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _MCAR_RemoveNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NodeQryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MCARServer).RemoveNode(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cbmcks.MCAR/RemoveNode",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MCARServer).RemoveNode(ctx, req.(*NodeQryRequest))
+func (m *NodeInfo) GetTaints() []*Taint {
+	if m != nil {
+		return m.Taints
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _MCAR_ListSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SpecQryRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MCARServer).ListSpec(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cbmcks.MCAR/ListSpec",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MCARServer).ListSpec(ctx, req.(*SpecQryRequest))
+func (m *NodeInfo) GetInstanceTypeLabel() string {
+	if m != nil {
+		return m.InstanceTypeLabel
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _MCAR_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cbmcks.MCAR",
-	HandlerType: (*MCARServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Healthy",
-			Handler:    _MCAR_Healthy_Handler,
-		},
-		{
-			MethodName: "CreateCluster",
-			Handler:    _MCAR_CreateCluster_Handler,
-		},
-		{
-			MethodName: "ListCluster",
-			Handler:    _MCAR_ListCluster_Handler,
-		},
-		{
-			MethodName: "GetCluster",
-			Handler:    _MCAR_GetCluster_Handler,
-		},
-		{
-			MethodName: "DeleteCluster",
-			Handler:    _MCAR_DeleteCluster_Handler,
-		},
-		{
-			MethodName: "AddNode",
-			Handler:    _MCAR_AddNode_Handler,
-		},
-		{
-			MethodName: "ListNode",
-			Handler:    _MCAR_ListNode_Handler,
-		},
-		{
-			MethodName: "GetNode",
-			Handler:    _MCAR_GetNode_Handler,
-		},
-		{
-			MethodName: "RemoveNode",
-			Handler:    _MCAR_RemoveNode_Handler,
-		},
-		{
-			MethodName: "ListSpec",
-			Handler:    _MCAR_ListSpec_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cbmcks/cbmcks.proto",
+func (m *NodeInfo) GetConnectionLabel() string {
+	if m != nil {
+		return m.ConnectionLabel
+	}
+	return ""
 }
 
-func (m *Empty) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NodeInfo) GetProviderId() string {
+	if m != nil {
+		return m.ProviderId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NodeInfo) GetHostKey() string {
+	if m != nil {
+		return m.HostKey
+	}
+	return ""
 }
 
-func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *NodeInfo) GetPrivateIp() string {
+	if m != nil {
+		return m.PrivateIp
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MessageResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NodeInfo) GetConnection() string {
+	if m != nil {
+		return m.Connection
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MessageResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NodeInfo) GetSsh() *SSH {
+	if m != nil {
+		return m.Ssh
+	}
+	return nil
 }
 
-func (m *MessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type NodeCreateRequest struct {
+	Namespace            string          `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
+	Cluster              string          `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster" yaml:"cluster"`
+	Item                 *NodeCreateInfo `protobuf:"bytes,3,opt,name=item,json=ReqInfo,proto3" json:"ReqInfo" yaml:"ReqInfo"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NodeCreateRequest) Reset()         { *m = NodeCreateRequest{} }
+func (m *NodeCreateRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeCreateRequest) ProtoMessage()    {}
+func (*NodeCreateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{31}
+}
+func (m *NodeCreateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeCreateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeCreateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NodeCreateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeCreateRequest.Merge(m, src)
+}
+func (m *NodeCreateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeCreateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeCreateRequest.DiscardUnknown(m)
 }
 
-func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Code != 0 {
-		i = encodeVarintCbmcks(dAtA, i, uint64(m.Code))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_NodeCreateRequest proto.InternalMessageInfo
+
+func (m *NodeCreateRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ClusterInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NodeCreateRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ClusterInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NodeCreateRequest) GetItem() *NodeCreateInfo {
+	if m != nil {
+		return m.Item
+	}
+	return nil
 }
 
-func (m *ClusterInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Item != nil {
-		{
-			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+type NodeCreateInfo struct {
+	ControlPlane         []*NodeConfig `protobuf:"bytes,1,rep,name=control_plane,json=controlPlane,proto3" json:"controlPlane" yaml:"controlPlane"`
+	Worker               []*NodeConfig `protobuf:"bytes,2,rep,name=worker,proto3" json:"worker" yaml:"worker"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *NodeCreateInfo) Reset()         { *m = NodeCreateInfo{} }
+func (m *NodeCreateInfo) String() string { return proto.CompactTextString(m) }
+func (*NodeCreateInfo) ProtoMessage()    {}
+func (*NodeCreateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{32}
+}
+func (m *NodeCreateInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeCreateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeCreateInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *NodeCreateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeCreateInfo.Merge(m, src)
+}
+func (m *NodeCreateInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeCreateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeCreateInfo.DiscardUnknown(m)
 }
 
-func (m *ListClusterInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_NodeCreateInfo proto.InternalMessageInfo
+
+func (m *NodeCreateInfo) GetControlPlane() []*NodeConfig {
+	if m != nil {
+		return m.ControlPlane
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ListClusterInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NodeCreateInfo) GetWorker() []*NodeConfig {
+	if m != nil {
+		return m.Worker
+	}
+	return nil
 }
 
-func (m *ListClusterInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCbmcks(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+type NodeAllQryRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
+	Cluster              string   `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster" yaml:"cluster"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeAllQryRequest) Reset()         { *m = NodeAllQryRequest{} }
+func (m *NodeAllQryRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeAllQryRequest) ProtoMessage()    {}
+func (*NodeAllQryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{33}
+}
+func (m *NodeAllQryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeAllQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeAllQryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *NodeAllQryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeAllQryRequest.Merge(m, src)
+}
+func (m *NodeAllQryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeAllQryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeAllQryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeAllQryRequest proto.InternalMessageInfo
+
+func (m *NodeAllQryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NodeAllQryRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ClusterInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type NodeQryRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
+	Cluster              string   `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster" yaml:"cluster"`
+	Node                 string   `protobuf:"bytes,3,opt,name=node,proto3" json:"node" yaml:"node"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Nodes) > 0 {
-		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCbmcks(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x72
+func (m *NodeQryRequest) Reset()         { *m = NodeQryRequest{} }
+func (m *NodeQryRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeQryRequest) ProtoMessage()    {}
+func (*NodeQryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{34}
+}
+func (m *NodeQryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeQryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.CreatedTime) > 0 {
-		i -= len(m.CreatedTime)
-		copy(dAtA[i:], m.CreatedTime)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CreatedTime)))
-		i--
-		dAtA[i] = 0x6a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x62
+}
+func (m *NodeQryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeQryRequest.Merge(m, src)
+}
+func (m *NodeQryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeQryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeQryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeQryRequest proto.InternalMessageInfo
+
+func (m *NodeQryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if len(m.InstallMonAgent) > 0 {
-		i -= len(m.InstallMonAgent)
-		copy(dAtA[i:], m.InstallMonAgent)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.InstallMonAgent)))
-		i--
-		dAtA[i] = 0x5a
+	return ""
+}
+
+func (m *NodeQryRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
 	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x52
+	return ""
+}
+
+func (m *NodeQryRequest) GetNode() string {
+	if m != nil {
+		return m.Node
 	}
-	if len(m.NetworkCni) > 0 {
-		i -= len(m.NetworkCni)
-		copy(dAtA[i:], m.NetworkCni)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.NetworkCni)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.CpLeader) > 0 {
-		i -= len(m.CpLeader)
-		copy(dAtA[i:], m.CpLeader)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CpLeader)))
-		i--
-		dAtA[i] = 0x42
+	return ""
+}
+
+type NodeLogQryRequest struct {
+	Namespace            string   `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
+	Cluster              string   `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster" yaml:"cluster"`
+	Node                 string   `protobuf:"bytes,3,opt,name=node,proto3" json:"node" yaml:"node"`
+	Step                 string   `protobuf:"bytes,4,opt,name=step,proto3" json:"step" yaml:"step"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NodeLogQryRequest) Reset()         { *m = NodeLogQryRequest{} }
+func (m *NodeLogQryRequest) String() string { return proto.CompactTextString(m) }
+func (*NodeLogQryRequest) ProtoMessage()    {}
+func (*NodeLogQryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{35}
+}
+func (m *NodeLogQryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeLogQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeLogQryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ClusterConfig) > 0 {
-		i -= len(m.ClusterConfig)
-		copy(dAtA[i:], m.ClusterConfig)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ClusterConfig)))
-		i--
-		dAtA[i] = 0x3a
+}
+func (m *NodeLogQryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeLogQryRequest.Merge(m, src)
+}
+func (m *NodeLogQryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeLogQryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeLogQryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeLogQryRequest proto.InternalMessageInfo
+
+func (m *NodeLogQryRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if len(m.K8SVersion) > 0 {
-		i -= len(m.K8SVersion)
-		copy(dAtA[i:], m.K8SVersion)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.K8SVersion)))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *NodeLogQryRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *NodeLogQryRequest) GetNode() string {
+	if m != nil {
+		return m.Node
 	}
-	if len(m.Mcis) > 0 {
-		i -= len(m.Mcis)
-		copy(dAtA[i:], m.Mcis)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Mcis)))
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *NodeLogQryRequest) GetStep() string {
+	if m != nil {
+		return m.Step
 	}
-	if m.Status != nil {
-		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+	return ""
+}
+
+type ListNodeLogInfoResponse struct {
+	Kind                 string         `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind" yaml:"kind"`
+	Items                []*NodeLogInfo `protobuf:"bytes,2,rep,name=items,proto3" json:"items" yaml:"items"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListNodeLogInfoResponse) Reset()         { *m = ListNodeLogInfoResponse{} }
+func (m *ListNodeLogInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*ListNodeLogInfoResponse) ProtoMessage()    {}
+func (*ListNodeLogInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{36}
+}
+func (m *ListNodeLogInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListNodeLogInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListNodeLogInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ListNodeLogInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNodeLogInfoResponse.Merge(m, src)
+}
+func (m *ListNodeLogInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListNodeLogInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNodeLogInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNodeLogInfoResponse proto.InternalMessageInfo
+
+func (m *ListNodeLogInfoResponse) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ClusterCreateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListNodeLogInfoResponse) GetItems() []*NodeLogInfo {
+	if m != nil {
+		return m.Items
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ClusterCreateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type NodeLogInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	Kind                 string   `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind" yaml:"kind"`
+	Node                 string   `protobuf:"bytes,3,opt,name=node,proto3" json:"node" yaml:"node"`
+	Step                 string   `protobuf:"bytes,4,opt,name=step,proto3" json:"step" yaml:"step"`
+	Size_                int32    `protobuf:"varint,5,opt,name=size,proto3" json:"size" yaml:"size"`
+	Truncated            bool     `protobuf:"varint,6,opt,name=truncated,proto3" json:"truncated" yaml:"truncated"`
+	Output               string   `protobuf:"bytes,7,opt,name=output,proto3" json:"output" yaml:"output"`
+	UpdatedTime          string   `protobuf:"bytes,8,opt,name=updated_time,json=updatedTime,proto3" json:"updatedTime" yaml:"updatedTime"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ClusterCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Item != nil {
-		{
-			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+func (m *NodeLogInfo) Reset()         { *m = NodeLogInfo{} }
+func (m *NodeLogInfo) String() string { return proto.CompactTextString(m) }
+func (*NodeLogInfo) ProtoMessage()    {}
+func (*NodeLogInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{37}
+}
+func (m *NodeLogInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NodeLogInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NodeLogInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if len(m.Patchversion) > 0 {
-		i -= len(m.Patchversion)
-		copy(dAtA[i:], m.Patchversion)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Patchversion)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *NodeLogInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeLogInfo.Merge(m, src)
+}
+func (m *NodeLogInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *NodeLogInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeLogInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeLogInfo proto.InternalMessageInfo
+
+func (m *NodeLogInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	if len(m.Minorversion) > 0 {
-		i -= len(m.Minorversion)
-		copy(dAtA[i:], m.Minorversion)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Minorversion)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *NodeLogInfo) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *NodeLogInfo) GetNode() string {
+	if m != nil {
+		return m.Node
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ClusterCreateInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *NodeLogInfo) GetStep() string {
+	if m != nil {
+		return m.Step
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ClusterCreateInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *NodeLogInfo) GetSize_() int32 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
 }
 
-func (m *ClusterCreateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *NodeLogInfo) GetTruncated() bool {
+	if m != nil {
+		return m.Truncated
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x3a
+	return false
+}
+
+func (m *NodeLogInfo) GetOutput() string {
+	if m != nil {
+		return m.Output
 	}
-	if len(m.InstallMonAgent) > 0 {
-		i -= len(m.InstallMonAgent)
-		copy(dAtA[i:], m.InstallMonAgent)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.InstallMonAgent)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Label) > 0 {
-		i -= len(m.Label)
-		copy(dAtA[i:], m.Label)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Label)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Config != nil {
-		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCbmcks(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	return ""
+}
+
+func (m *NodeLogInfo) GetUpdatedTime() string {
+	if m != nil {
+		return m.UpdatedTime
 	}
-	if len(m.Worker) > 0 {
-		for iNdEx := len(m.Worker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Worker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCbmcks(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+	return ""
+}
+
+type ManifestApplyRequest struct {
+	Namespace            string             `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace" yaml:"namespace"`
+	Cluster              string             `protobuf:"bytes,2,opt,name=cluster,proto3" json:"cluster" yaml:"cluster"`
+	Item                 *ManifestApplyInfo `protobuf:"bytes,3,opt,name=item,json=ReqInfo,proto3" json:"ReqInfo" yaml:"ReqInfo"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *ManifestApplyRequest) Reset()         { *m = ManifestApplyRequest{} }
+func (m *ManifestApplyRequest) String() string { return proto.CompactTextString(m) }
+func (*ManifestApplyRequest) ProtoMessage()    {}
+func (*ManifestApplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{38}
+}
+func (m *ManifestApplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestApplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestApplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ControlPlane) > 0 {
-		for iNdEx := len(m.ControlPlane) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ControlPlane[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCbmcks(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+}
+func (m *ManifestApplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestApplyRequest.Merge(m, src)
+}
+func (m *ManifestApplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestApplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestApplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestApplyRequest proto.InternalMessageInfo
+
+func (m *ManifestApplyRequest) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ManifestApplyRequest) GetCluster() string {
+	if m != nil {
+		return m.Cluster
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NodeConfig) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ManifestApplyRequest) GetItem() *ManifestApplyInfo {
+	if m != nil {
+		return m.Item
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *NodeConfig) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ManifestApplyInfo struct {
+	Manifest             string   `protobuf:"bytes,1,opt,name=manifest,proto3" json:"manifest" yaml:"manifest"`
+	PruneSelector        string   `protobuf:"bytes,2,opt,name=prune_selector,json=pruneSelector,proto3" json:"pruneSelector" yaml:"pruneSelector"`
+	ForceConflicts       bool     `protobuf:"varint,3,opt,name=force_conflicts,json=forceConflicts,proto3" json:"forceConflicts" yaml:"forceConflicts"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ManifestApplyInfo) Reset()         { *m = ManifestApplyInfo{} }
+func (m *ManifestApplyInfo) String() string { return proto.CompactTextString(m) }
+func (*ManifestApplyInfo) ProtoMessage()    {}
+func (*ManifestApplyInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{39}
+}
+func (m *ManifestApplyInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestApplyInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestApplyInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Spec) > 0 {
-		i -= len(m.Spec)
-		copy(dAtA[i:], m.Spec)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Spec)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *ManifestApplyInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestApplyInfo.Merge(m, src)
+}
+func (m *ManifestApplyInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestApplyInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestApplyInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManifestApplyInfo proto.InternalMessageInfo
+
+func (m *ManifestApplyInfo) GetManifest() string {
+	if m != nil {
+		return m.Manifest
 	}
-	if m.Count != 0 {
-		i = encodeVarintCbmcks(dAtA, i, uint64(m.Count))
-		i--
-		dAtA[i] = 0x10
+	return ""
+}
+
+func (m *ManifestApplyInfo) GetPruneSelector() string {
+	if m != nil {
+		return m.PruneSelector
 	}
-	if len(m.Connection) > 0 {
-		i -= len(m.Connection)
-		copy(dAtA[i:], m.Connection)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Connection)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *ManifestApplyInfo) GetForceConflicts() bool {
+	if m != nil {
+		return m.ForceConflicts
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *Config) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+type ManifestResultResponse struct {
+	Kind                 string                `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind" yaml:"kind"`
+	Items                []*ManifestObjectInfo `protobuf:"bytes,2,rep,name=items,proto3" json:"items" yaml:"items"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *ManifestResultResponse) Reset()         { *m = ManifestResultResponse{} }
+func (m *ManifestResultResponse) String() string { return proto.CompactTextString(m) }
+func (*ManifestResultResponse) ProtoMessage()    {}
+func (*ManifestResultResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{40}
+}
+func (m *ManifestResultResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestResultResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestResultResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *ManifestResultResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestResultResponse.Merge(m, src)
+}
+func (m *ManifestResultResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestResultResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestResultResponse.DiscardUnknown(m)
 }
 
-func (m *Config) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_ManifestResultResponse proto.InternalMessageInfo
+
+func (m *ManifestResultResponse) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
 }
 
-func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *ManifestResultResponse) GetItems() []*ManifestObjectInfo {
+	if m != nil {
+		return m.Items
 	}
-	if m.Kubernetes != nil {
-		{
-			size, err := m.Kubernetes.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+	return nil
+}
+
+type ManifestObjectInfo struct {
+	Resource             string   `protobuf:"bytes,1,opt,name=resource,proto3" json:"resource" yaml:"resource"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name" yaml:"name"`
+	Action               string   `protobuf:"bytes,3,opt,name=action,proto3" json:"action" yaml:"action"`
+	Message              string   `protobuf:"bytes,4,opt,name=message,proto3" json:"message" yaml:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManifestObjectInfo) Reset()         { *m = ManifestObjectInfo{} }
+func (m *ManifestObjectInfo) String() string { return proto.CompactTextString(m) }
+func (*ManifestObjectInfo) ProtoMessage()    {}
+func (*ManifestObjectInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{41}
+}
+func (m *ManifestObjectInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ManifestObjectInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ManifestObjectInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ManifestObjectInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManifestObjectInfo.Merge(m, src)
+}
+func (m *ManifestObjectInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ManifestObjectInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManifestObjectInfo.DiscardUnknown(m)
 }
 
-func (m *Kubernetes) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ManifestObjectInfo proto.InternalMessageInfo
+
+func (m *ManifestObjectInfo) GetResource() string {
+	if m != nil {
+		return m.Resource
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Kubernetes) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ManifestObjectInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func (m *Kubernetes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ServicDnsDomain) > 0 {
-		i -= len(m.ServicDnsDomain)
-		copy(dAtA[i:], m.ServicDnsDomain)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ServicDnsDomain)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ServiceCidr) > 0 {
-		i -= len(m.ServiceCidr)
-		copy(dAtA[i:], m.ServiceCidr)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ServiceCidr)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.PodCidr) > 0 {
-		i -= len(m.PodCidr)
-		copy(dAtA[i:], m.PodCidr)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.PodCidr)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.NetworkCni) > 0 {
-		i -= len(m.NetworkCni)
-		copy(dAtA[i:], m.NetworkCni)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.NetworkCni)))
-		i--
-		dAtA[i] = 0xa
+func (m *ManifestObjectInfo) GetAction() string {
+	if m != nil {
+		return m.Action
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ClusterAllQryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ManifestObjectInfo) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ClusterAllQryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SpecInfoResponse struct {
+	Item                 *SpecInfo `protobuf:"bytes,1,opt,name=item,proto3" json:"item" yaml:"item"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *ClusterAllQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+func (m *SpecInfoResponse) Reset()         { *m = SpecInfoResponse{} }
+func (m *SpecInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*SpecInfoResponse) ProtoMessage()    {}
+func (*SpecInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{42}
+}
+func (m *SpecInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *SpecInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecInfoResponse.Merge(m, src)
+}
+func (m *SpecInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecInfoResponse.DiscardUnknown(m)
 }
 
-func (m *ClusterQryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SpecInfoResponse proto.InternalMessageInfo
+
+func (m *SpecInfoResponse) GetItem() *SpecInfo {
+	if m != nil {
+		return m.Item
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ClusterQryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ListSpecInfoResponse struct {
+	Kind                 string      `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind" yaml:"kind"`
+	Items                []*SpecInfo `protobuf:"bytes,2,rep,name=items,proto3" json:"items" yaml:"items"`
+	ConnectionName       string      `protobuf:"bytes,3,opt,name=connection_name,json=connectionName,proto3" json:"connectionName" yaml:"connectionName"`
+	NextCursor           string      `protobuf:"bytes,4,opt,name=next_cursor,json=nextCursor,proto3" json:"nextCursor" yaml:"nextCursor"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (m *ClusterQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Cluster) > 0 {
-		i -= len(m.Cluster)
-		copy(dAtA[i:], m.Cluster)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Cluster)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *ListSpecInfoResponse) Reset()         { *m = ListSpecInfoResponse{} }
+func (m *ListSpecInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSpecInfoResponse) ProtoMessage()    {}
+func (*ListSpecInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{43}
 }
-
-func (m *ClusterStatusInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListSpecInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListSpecInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListSpecInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ClusterStatusInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListSpecInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSpecInfoResponse.Merge(m, src)
+}
+func (m *ListSpecInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListSpecInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSpecInfoResponse.DiscardUnknown(m)
 }
 
-func (m *ClusterStatusInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Message) > 0 {
-		i -= len(m.Message)
-		copy(dAtA[i:], m.Message)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Message)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Phase) > 0 {
-		i -= len(m.Phase)
-		copy(dAtA[i:], m.Phase)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Phase)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_ListSpecInfoResponse proto.InternalMessageInfo
+
+func (m *ListSpecInfoResponse) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NodeInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListSpecInfoResponse) GetItems() []*SpecInfo {
+	if m != nil {
+		return m.Items
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *NodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ListSpecInfoResponse) GetConnectionName() string {
+	if m != nil {
+		return m.ConnectionName
+	}
+	return ""
 }
 
-func (m *NodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Item != nil {
-		{
-			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCbmcks(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *ListSpecInfoResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ListNodeInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type SpecInfo struct {
+	Name                 string     `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	Memory               string     `protobuf:"bytes,2,opt,name=memory,proto3" json:"memory" yaml:"memory"`
+	Cpu                  *CpuInfo   `protobuf:"bytes,3,opt,name=cpu,proto3" json:"cpu" yaml:"cpu"`
+	Arch                 string     `protobuf:"bytes,4,opt,name=arch,proto3" json:"arch" yaml:"arch"`
+	Gpus                 []*GpuInfo `protobuf:"bytes,5,rep,name=gpus,proto3" json:"gpus" yaml:"gpus"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *ListNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpecInfo) Reset()         { *m = SpecInfo{} }
+func (m *SpecInfo) String() string { return proto.CompactTextString(m) }
+func (*SpecInfo) ProtoMessage()    {}
+func (*SpecInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{44}
 }
-
-func (m *ListNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCbmcks(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *SpecInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *SpecInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecInfo.Merge(m, src)
+}
+func (m *SpecInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecInfo.DiscardUnknown(m)
 }
 
-func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SpecInfo proto.InternalMessageInfo
+
+func (m *SpecInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NodeInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpecInfo) GetMemory() string {
+	if m != nil {
+		return m.Memory
+	}
+	return ""
 }
 
-func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ZoneLabel) > 0 {
-		i -= len(m.ZoneLabel)
-		copy(dAtA[i:], m.ZoneLabel)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ZoneLabel)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.RegionLabel) > 0 {
-		i -= len(m.RegionLabel)
-		copy(dAtA[i:], m.RegionLabel)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.RegionLabel)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if len(m.CspLabel) > 0 {
-		i -= len(m.CspLabel)
-		copy(dAtA[i:], m.CspLabel)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CspLabel)))
-		i--
-		dAtA[i] = 0x4a
-	}
-	if len(m.CreatedTime) > 0 {
-		i -= len(m.CreatedTime)
-		copy(dAtA[i:], m.CreatedTime)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CreatedTime)))
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.Csp) > 0 {
-		i -= len(m.Csp)
-		copy(dAtA[i:], m.Csp)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Csp)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.Spec) > 0 {
-		i -= len(m.Spec)
-		copy(dAtA[i:], m.Spec)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Spec)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.PublicIp) > 0 {
-		i -= len(m.PublicIp)
-		copy(dAtA[i:], m.PublicIp)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.PublicIp)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Credential) > 0 {
-		i -= len(m.Credential)
-		copy(dAtA[i:], m.Credential)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Credential)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
-		i--
-		dAtA[i] = 0x12
+func (m *SpecInfo) GetCpu() *CpuInfo {
+	if m != nil {
+		return m.Cpu
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *SpecInfo) GetArch() string {
+	if m != nil {
+		return m.Arch
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NodeCreateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpecInfo) GetGpus() []*GpuInfo {
+	if m != nil {
+		return m.Gpus
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *NodeCreateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type GpuInfo struct {
+	Count                string   `protobuf:"bytes,1,opt,name=count,proto3" json:"count" yaml:"count"`
+	Mfr                  string   `protobuf:"bytes,2,opt,name=mfr,proto3" json:"mfr" yaml:"mfr"`
+	Model                string   `protobuf:"bytes,3,opt,name=model,proto3" json:"model" yaml:"model"`
+	Memory               string   `protobuf:"bytes,4,opt,name=memory,proto3" json:"memory" yaml:"memory"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Item != nil {
-		{
-			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+func (m *GpuInfo) Reset()         { *m = GpuInfo{} }
+func (m *GpuInfo) String() string { return proto.CompactTextString(m) }
+func (*GpuInfo) ProtoMessage()    {}
+func (*GpuInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{45}
+}
+func (m *GpuInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GpuInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GpuInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Cluster) > 0 {
-		i -= len(m.Cluster)
-		copy(dAtA[i:], m.Cluster)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Cluster)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *GpuInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GpuInfo.Merge(m, src)
+}
+func (m *GpuInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *GpuInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_GpuInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GpuInfo proto.InternalMessageInfo
+
+func (m *GpuInfo) GetCount() string {
+	if m != nil {
+		return m.Count
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NodeCreateInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GpuInfo) GetMfr() string {
+	if m != nil {
+		return m.Mfr
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NodeCreateInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GpuInfo) GetModel() string {
+	if m != nil {
+		return m.Model
+	}
+	return ""
 }
 
-func (m *NodeCreateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GpuInfo) GetMemory() string {
+	if m != nil {
+		return m.Memory
 	}
-	if len(m.Worker) > 0 {
-		for iNdEx := len(m.Worker) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Worker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCbmcks(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return ""
+}
+
+type CpuInfo struct {
+	Clock                string   `protobuf:"bytes,1,opt,name=clock,proto3" json:"clock" yaml:"clock"`
+	Count                string   `protobuf:"bytes,2,opt,name=count,proto3" json:"count" yaml:"count"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CpuInfo) Reset()         { *m = CpuInfo{} }
+func (m *CpuInfo) String() string { return proto.CompactTextString(m) }
+func (*CpuInfo) ProtoMessage()    {}
+func (*CpuInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{46}
+}
+func (m *CpuInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CpuInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CpuInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.ControlPlane) > 0 {
-		for iNdEx := len(m.ControlPlane) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ControlPlane[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCbmcks(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *CpuInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CpuInfo.Merge(m, src)
+}
+func (m *CpuInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *CpuInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CpuInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CpuInfo proto.InternalMessageInfo
+
+func (m *CpuInfo) GetClock() string {
+	if m != nil {
+		return m.Clock
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NodeAllQryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *CpuInfo) GetCount() string {
+	if m != nil {
+		return m.Count
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NodeAllQryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type SpecQryRequest struct {
+	Connectionname       string   `protobuf:"bytes,1,opt,name=connectionname,json=connectionName,proto3" json:"connectionName" yaml:"connectionName"`
+	ControlPlane         string   `protobuf:"bytes,2,opt,name=control_plane,json=cluster,proto3" json:"cluster" yaml:"cluster"`
+	CpuMin               string   `protobuf:"bytes,3,opt,name=cpu_min,json=cpuMin,proto3" json:"cpuMin" yaml:"cpuMin"`
+	CpuMax               string   `protobuf:"bytes,4,opt,name=cpu_max,json=cpuMax,proto3" json:"cpuMax" yaml:"cpuMax"`
+	MemoryMin            string   `protobuf:"bytes,5,opt,name=memory_min,json=memoryMin,proto3" json:"memoryMin" yaml:"memoryMin"`
+	MemoryMax            string   `protobuf:"bytes,6,opt,name=memory_max,json=memoryMax,proto3" json:"memoryMax" yaml:"memoryMax"`
+	ClockMin             string   `protobuf:"bytes,7,opt,name=clock_min,json=clockMin,proto3" json:"clockMin" yaml:"clockMin"`
+	ClockMax             string   `protobuf:"bytes,8,opt,name=clock_max,json=clockMax,proto3" json:"clockMax" yaml:"clockMax"`
+	Arch                 string   `protobuf:"bytes,9,opt,name=arch,proto3" json:"arch" yaml:"arch"`
+	Gpu                  string   `protobuf:"bytes,10,opt,name=gpu,proto3" json:"gpu" yaml:"gpu"`
+	Name                 string   `protobuf:"bytes,11,opt,name=name,proto3" json:"name" yaml:"name"`
+	Sort                 string   `protobuf:"bytes,12,opt,name=sort,proto3" json:"sort" yaml:"sort"`
+	Limit                string   `protobuf:"bytes,13,opt,name=limit,proto3" json:"limit" yaml:"limit"`
+	Cursor               string   `protobuf:"bytes,14,opt,name=cursor,proto3" json:"cursor" yaml:"cursor"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *NodeAllQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SpecQryRequest) Reset()         { *m = SpecQryRequest{} }
+func (m *SpecQryRequest) String() string { return proto.CompactTextString(m) }
+func (*SpecQryRequest) ProtoMessage()    {}
+func (*SpecQryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{47}
+}
+func (m *SpecQryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SpecQryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SpecQryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Cluster) > 0 {
-		i -= len(m.Cluster)
-		copy(dAtA[i:], m.Cluster)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Cluster)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *SpecQryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SpecQryRequest.Merge(m, src)
+}
+func (m *SpecQryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SpecQryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SpecQryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SpecQryRequest proto.InternalMessageInfo
+
+func (m *SpecQryRequest) GetConnectionname() string {
+	if m != nil {
+		return m.Connectionname
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SpecQryRequest) GetControlPlane() string {
+	if m != nil {
+		return m.ControlPlane
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *NodeQryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpecQryRequest) GetCpuMin() string {
+	if m != nil {
+		return m.CpuMin
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *NodeQryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpecQryRequest) GetCpuMax() string {
+	if m != nil {
+		return m.CpuMax
+	}
+	return ""
 }
 
-func (m *NodeQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SpecQryRequest) GetMemoryMin() string {
+	if m != nil {
+		return m.MemoryMin
 	}
-	if len(m.Node) > 0 {
-		i -= len(m.Node)
-		copy(dAtA[i:], m.Node)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Node)))
-		i--
-		dAtA[i] = 0x1a
+	return ""
+}
+
+func (m *SpecQryRequest) GetMemoryMax() string {
+	if m != nil {
+		return m.MemoryMax
 	}
-	if len(m.Cluster) > 0 {
-		i -= len(m.Cluster)
-		copy(dAtA[i:], m.Cluster)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Cluster)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *SpecQryRequest) GetClockMin() string {
+	if m != nil {
+		return m.ClockMin
 	}
-	if len(m.Namespace) > 0 {
-		i -= len(m.Namespace)
-		copy(dAtA[i:], m.Namespace)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *SpecQryRequest) GetClockMax() string {
+	if m != nil {
+		return m.ClockMax
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SpecInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpecQryRequest) GetArch() string {
+	if m != nil {
+		return m.Arch
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SpecInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpecQryRequest) GetGpu() string {
+	if m != nil {
+		return m.Gpu
+	}
+	return ""
 }
 
-func (m *SpecInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Item != nil {
-		{
-			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCbmcks(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *SpecQryRequest) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *ListSpecInfoResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *SpecQryRequest) GetSort() string {
+	if m != nil {
+		return m.Sort
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ListSpecInfoResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *SpecQryRequest) GetLimit() string {
+	if m != nil {
+		return m.Limit
+	}
+	return ""
 }
 
-func (m *ListSpecInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *SpecQryRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintCbmcks(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+	return ""
+}
+
+type ListConnectionInfoResponse struct {
+	Kind                 string            `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind" yaml:"kind"`
+	Items                []*ConnectionInfo `protobuf:"bytes,2,rep,name=items,proto3" json:"items" yaml:"items"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ListConnectionInfoResponse) Reset()         { *m = ListConnectionInfoResponse{} }
+func (m *ListConnectionInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*ListConnectionInfoResponse) ProtoMessage()    {}
+func (*ListConnectionInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{48}
+}
+func (m *ListConnectionInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ListConnectionInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ListConnectionInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Kind) > 0 {
-		i -= len(m.Kind)
-		copy(dAtA[i:], m.Kind)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *ListConnectionInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListConnectionInfoResponse.Merge(m, src)
+}
+func (m *ListConnectionInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ListConnectionInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListConnectionInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListConnectionInfoResponse proto.InternalMessageInfo
+
+func (m *ListConnectionInfoResponse) GetKind() string {
+	if m != nil {
+		return m.Kind
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SpecInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ListConnectionInfoResponse) GetItems() []*ConnectionInfo {
+	if m != nil {
+		return m.Items
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *SpecInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ConnectionInfo struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name" yaml:"name"`
+	Csp                  string   `protobuf:"bytes,2,opt,name=csp,proto3" json:"csp" yaml:"csp"`
+	Region               string   `protobuf:"bytes,3,opt,name=region,proto3" json:"region" yaml:"region"`
+	Zone                 string   `protobuf:"bytes,4,opt,name=zone,proto3" json:"zone" yaml:"zone"`
+	RegionName           string   `protobuf:"bytes,5,opt,name=region_name,json=regionName,proto3" json:"regionName" yaml:"regionName"`
+	CredentialName       string   `protobuf:"bytes,6,opt,name=credential_name,json=credentialName,proto3" json:"credentialName" yaml:"credentialName"`
+	Supported            bool     `protobuf:"varint,7,opt,name=supported,proto3" json:"supported" yaml:"supported"`
+	ImageResolvable      bool     `protobuf:"varint,8,opt,name=image_resolvable,json=imageResolvable,proto3" json:"imageResolvable" yaml:"imageResolvable"`
+	Message              string   `protobuf:"bytes,9,opt,name=message,proto3" json:"message" yaml:"message"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SpecInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Cpu != nil {
-		{
-			size, err := m.Cpu.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+func (m *ConnectionInfo) Reset()         { *m = ConnectionInfo{} }
+func (m *ConnectionInfo) String() string { return proto.CompactTextString(m) }
+func (*ConnectionInfo) ProtoMessage()    {}
+func (*ConnectionInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6e98b9bfafe16c0f, []int{49}
+}
+func (m *ConnectionInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConnectionInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConnectionInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Memory) > 0 {
-		i -= len(m.Memory)
-		copy(dAtA[i:], m.Memory)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Memory)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ConnectionInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConnectionInfo.Merge(m, src)
+}
+func (m *ConnectionInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConnectionInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConnectionInfo.DiscardUnknown(m)
 }
 
-func (m *CpuInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ConnectionInfo proto.InternalMessageInfo
+
+func (m *ConnectionInfo) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *CpuInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConnectionInfo) GetCsp() string {
+	if m != nil {
+		return m.Csp
+	}
+	return ""
 }
 
-func (m *CpuInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Count) > 0 {
-		i -= len(m.Count)
-		copy(dAtA[i:], m.Count)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Count)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Clock) > 0 {
-		i -= len(m.Clock)
-		copy(dAtA[i:], m.Clock)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Clock)))
-		i--
-		dAtA[i] = 0xa
+func (m *ConnectionInfo) GetRegion() string {
+	if m != nil {
+		return m.Region
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *SpecQryRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ConnectionInfo) GetZone() string {
+	if m != nil {
+		return m.Zone
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *SpecQryRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ConnectionInfo) GetRegionName() string {
+	if m != nil {
+		return m.RegionName
+	}
+	return ""
 }
 
-func (m *SpecQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.MemoryMax) > 0 {
-		i -= len(m.MemoryMax)
-		copy(dAtA[i:], m.MemoryMax)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.MemoryMax)))
-		i--
-		dAtA[i] = 0x32
+func (m *ConnectionInfo) GetCredentialName() string {
+	if m != nil {
+		return m.CredentialName
 	}
-	if len(m.MemoryMin) > 0 {
-		i -= len(m.MemoryMin)
-		copy(dAtA[i:], m.MemoryMin)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.MemoryMin)))
-		i--
-		dAtA[i] = 0x2a
+	return ""
+}
+
+func (m *ConnectionInfo) GetSupported() bool {
+	if m != nil {
+		return m.Supported
 	}
-	if len(m.CpuMax) > 0 {
-		i -= len(m.CpuMax)
-		copy(dAtA[i:], m.CpuMax)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CpuMax)))
-		i--
-		dAtA[i] = 0x22
+	return false
+}
+
+func (m *ConnectionInfo) GetImageResolvable() bool {
+	if m != nil {
+		return m.ImageResolvable
 	}
-	if len(m.CpuMin) > 0 {
-		i -= len(m.CpuMin)
-		copy(dAtA[i:], m.CpuMin)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CpuMin)))
-		i--
-		dAtA[i] = 0x1a
+	return false
+}
+
+func (m *ConnectionInfo) GetMessage() string {
+	if m != nil {
+		return m.Message
 	}
-	if len(m.ControlPlane) > 0 {
-		i -= len(m.ControlPlane)
-		copy(dAtA[i:], m.ControlPlane)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ControlPlane)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Connectionname) > 0 {
-		i -= len(m.Connectionname)
-		copy(dAtA[i:], m.Connectionname)
-		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Connectionname)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func encodeVarintCbmcks(dAtA []byte, offset int, v uint64) int {
-	offset -= sovCbmcks(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func init() {
+	proto.RegisterType((*Empty)(nil), "cbmcks.Empty")
+	proto.RegisterType((*MessageResponse)(nil), "cbmcks.MessageResponse")
+	proto.RegisterType((*StatusResponse)(nil), "cbmcks.StatusResponse")
+	proto.RegisterType((*ClusterInfoResponse)(nil), "cbmcks.ClusterInfoResponse")
+	proto.RegisterType((*ListClusterInfoResponse)(nil), "cbmcks.ListClusterInfoResponse")
+	proto.RegisterType((*ClusterInfo)(nil), "cbmcks.ClusterInfo")
+	proto.RegisterType((*ClusterCreateRequest)(nil), "cbmcks.ClusterCreateRequest")
+	proto.RegisterType((*ClusterPlanResponse)(nil), "cbmcks.ClusterPlanResponse")
+	proto.RegisterType((*PlanError)(nil), "cbmcks.PlanError")
+	proto.RegisterType((*PlanNode)(nil), "cbmcks.PlanNode")
+	proto.RegisterType((*PlanResource)(nil), "cbmcks.PlanResource")
+	proto.RegisterType((*ClusterCreateInfo)(nil), "cbmcks.ClusterCreateInfo")
+	proto.RegisterType((*NodeConfig)(nil), "cbmcks.NodeConfig")
+	proto.RegisterMapType((map[string]string)(nil), "cbmcks.NodeConfig.LabelsEntry")
+	proto.RegisterType((*SSH)(nil), "cbmcks.SSH")
+	proto.RegisterType((*Taint)(nil), "cbmcks.Taint")
+	proto.RegisterType((*Config)(nil), "cbmcks.Config")
+	proto.RegisterType((*Kubernetes)(nil), "cbmcks.Kubernetes")
+	proto.RegisterMapType((map[string]bool)(nil), "cbmcks.Kubernetes.FeatureGatesEntry")
+	proto.RegisterType((*Component)(nil), "cbmcks.Component")
+	proto.RegisterMapType((map[string]string)(nil), "cbmcks.Component.ExtraArgsEntry")
+	proto.RegisterType((*Kubelet)(nil), "cbmcks.Kubelet")
+	proto.RegisterMapType((map[string]string)(nil), "cbmcks.Kubelet.EvictionHardEntry")
+	proto.RegisterMapType((map[string]string)(nil), "cbmcks.Kubelet.KubeReservedEntry")
+	proto.RegisterMapType((map[string]string)(nil), "cbmcks.Kubelet.SystemReservedEntry")
+	proto.RegisterType((*Etcd)(nil), "cbmcks.Etcd")
+	proto.RegisterMapType((map[string]string)(nil), "cbmcks.Etcd.ExtraArgsEntry")
+	proto.RegisterType((*Oidc)(nil), "cbmcks.Oidc")
+	proto.RegisterType((*Audit)(nil), "cbmcks.Audit")
+	proto.RegisterType((*Encryption)(nil), "cbmcks.Encryption")
+	proto.RegisterType((*Kilo)(nil), "cbmcks.Kilo")
+	proto.RegisterMapType((map[string]string)(nil), "cbmcks.Kilo.AllowedLocationIpsEntry")
+	proto.RegisterMapType((map[string]string)(nil), "cbmcks.Kilo.LocationsEntry")
+	proto.RegisterType((*ClusterAllQryRequest)(nil), "cbmcks.ClusterAllQryRequest")
+	proto.RegisterType((*ClusterQryRequest)(nil), "cbmcks.ClusterQryRequest")
+	proto.RegisterType((*ClusterStatusInfo)(nil), "cbmcks.ClusterStatusInfo")
+	proto.RegisterType((*NodeInfoResponse)(nil), "cbmcks.NodeInfoResponse")
+	proto.RegisterType((*ListNodeInfoResponse)(nil), "cbmcks.ListNodeInfoResponse")
+	proto.RegisterType((*NodeFailure)(nil), "cbmcks.NodeFailure")
+	proto.RegisterType((*NodeInfo)(nil), "cbmcks.NodeInfo")
+	proto.RegisterMapType((map[string]string)(nil), "cbmcks.NodeInfo.LabelsEntry")
+	proto.RegisterType((*NodeCreateRequest)(nil), "cbmcks.NodeCreateRequest")
+	proto.RegisterType((*NodeCreateInfo)(nil), "cbmcks.NodeCreateInfo")
+	proto.RegisterType((*NodeAllQryRequest)(nil), "cbmcks.NodeAllQryRequest")
+	proto.RegisterType((*NodeQryRequest)(nil), "cbmcks.NodeQryRequest")
+	proto.RegisterType((*NodeLogQryRequest)(nil), "cbmcks.NodeLogQryRequest")
+	proto.RegisterType((*ListNodeLogInfoResponse)(nil), "cbmcks.ListNodeLogInfoResponse")
+	proto.RegisterType((*NodeLogInfo)(nil), "cbmcks.NodeLogInfo")
+	proto.RegisterType((*ManifestApplyRequest)(nil), "cbmcks.ManifestApplyRequest")
+	proto.RegisterType((*ManifestApplyInfo)(nil), "cbmcks.ManifestApplyInfo")
+	proto.RegisterType((*ManifestResultResponse)(nil), "cbmcks.ManifestResultResponse")
+	proto.RegisterType((*ManifestObjectInfo)(nil), "cbmcks.ManifestObjectInfo")
+	proto.RegisterType((*SpecInfoResponse)(nil), "cbmcks.SpecInfoResponse")
+	proto.RegisterType((*ListSpecInfoResponse)(nil), "cbmcks.ListSpecInfoResponse")
+	proto.RegisterType((*SpecInfo)(nil), "cbmcks.SpecInfo")
+	proto.RegisterType((*GpuInfo)(nil), "cbmcks.GpuInfo")
+	proto.RegisterType((*CpuInfo)(nil), "cbmcks.CpuInfo")
+	proto.RegisterType((*SpecQryRequest)(nil), "cbmcks.SpecQryRequest")
+	proto.RegisterType((*ListConnectionInfoResponse)(nil), "cbmcks.ListConnectionInfoResponse")
+	proto.RegisterType((*ConnectionInfo)(nil), "cbmcks.ConnectionInfo")
 }
-func (m *Empty) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+
+func init() { proto.RegisterFile("cbmcks/cbmcks.proto", fileDescriptor_6e98b9bfafe16c0f) }
+
+var fileDescriptor_6e98b9bfafe16c0f = []byte{
+	// 4788 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5c, 0x4d, 0x8c, 0x24, 0x47,
+	0x56, 0x9e, 0xaa, 0xae, 0x9f, 0xae, 0xa8, 0xfe, 0x8d, 0x69, 0xcf, 0x94, 0x7b, 0xec, 0xc9, 0x71,
+	0xd8, 0xc8, 0x46, 0x0b, 0x33, 0x62, 0x66, 0x91, 0xbd, 0xbb, 0x36, 0xde, 0xee, 0x9e, 0xf1, 0xcc,
+	0xec, 0xfc, 0x6e, 0xf4, 0xac, 0x0d, 0x92, 0xa5, 0xda, 0x9c, 0xcc, 0xe8, 0xea, 0xa4, 0xb3, 0x32,
+	0xd3, 0x99, 0x59, 0xed, 0x2e, 0x5f, 0xd1, 0x02, 0x07, 0xf6, 0x00, 0x27, 0x24, 0xc4, 0x82, 0x58,
+	0x89, 0x03, 0x07, 0x24, 0xe0, 0xc0, 0x4a, 0x9c, 0xd8, 0x13, 0x9c, 0xe0, 0x8e, 0x94, 0xb0, 0x06,
+	0x09, 0x54, 0xe2, 0x80, 0xfa, 0xc4, 0x11, 0xbd, 0xf8, 0xc9, 0x88, 0xa8, 0xca, 0x9e, 0xe9, 0x6a,
+	0x8f, 0x85, 0xf7, 0xd4, 0x15, 0xdf, 0x7b, 0xf1, 0xe2, 0xef, 0xc5, 0x8b, 0x17, 0xef, 0x45, 0x36,
+	0x3a, 0xef, 0x3d, 0x1d, 0x7a, 0x07, 0xd9, 0x35, 0xf1, 0xe7, 0x6a, 0x92, 0xc6, 0x79, 0x8c, 0x5b,
+	0xa2, 0xb4, 0xb9, 0x31, 0x88, 0x07, 0x31, 0x87, 0xae, 0xc1, 0x2f, 0x41, 0xdd, 0xbc, 0x3c, 0x88,
+	0xe3, 0x41, 0xc8, 0xae, 0xf1, 0xd2, 0xd3, 0xd1, 0xde, 0xb5, 0x4f, 0x53, 0x37, 0x49, 0x58, 0x2a,
+	0x6b, 0x93, 0x36, 0x6a, 0xde, 0x1a, 0x26, 0xf9, 0x98, 0x7c, 0x07, 0xad, 0x3e, 0x60, 0x59, 0xe6,
+	0x0e, 0x18, 0x65, 0x59, 0x12, 0x47, 0x19, 0xc3, 0x6f, 0xa3, 0xf6, 0x50, 0x40, 0xbd, 0xda, 0x95,
+	0xda, 0x5b, 0x9d, 0xed, 0x57, 0x27, 0x85, 0xa3, 0xa0, 0xe3, 0xc2, 0x59, 0x19, 0xbb, 0xc3, 0xf0,
+	0x9b, 0x44, 0x02, 0x84, 0x2a, 0x12, 0xf9, 0x71, 0x0d, 0xad, 0xec, 0xe6, 0x6e, 0x3e, 0xca, 0x4a,
+	0x59, 0x5f, 0x43, 0x8d, 0x83, 0x20, 0xf2, 0xa5, 0xa0, 0x8b, 0x93, 0xc2, 0xe1, 0xe5, 0xe3, 0xc2,
+	0xe9, 0x0a, 0x29, 0x50, 0x22, 0x94, 0x83, 0xc0, 0xec, 0xc5, 0x3e, 0xeb, 0xd5, 0xaf, 0xd4, 0xde,
+	0x6a, 0x0a, 0x66, 0x28, 0x6b, 0x66, 0x28, 0x11, 0xca, 0x41, 0xb3, 0x97, 0x0b, 0x73, 0xf5, 0xf2,
+	0x23, 0x74, 0x7e, 0x27, 0x1c, 0x65, 0x39, 0x4b, 0xef, 0x46, 0x7b, 0x71, 0xd9, 0xd3, 0x6f, 0xa3,
+	0x46, 0x90, 0xb3, 0x21, 0xef, 0x69, 0xf7, 0xfa, 0xf9, 0xab, 0x72, 0xb2, 0x0d, 0x56, 0xd1, 0x23,
+	0x60, 0xd2, 0x3d, 0x82, 0x12, 0xa1, 0x1c, 0x24, 0xbf, 0x57, 0x43, 0x17, 0xef, 0x07, 0x59, 0x5e,
+	0x25, 0x7d, 0xae, 0x79, 0xb8, 0x89, 0x9a, 0x20, 0x30, 0xeb, 0xd5, 0xaf, 0x2c, 0x9c, 0xd4, 0x97,
+	0x97, 0x27, 0x85, 0x23, 0xb8, 0x8e, 0x0b, 0x67, 0x49, 0x77, 0x26, 0x23, 0x54, 0xc0, 0xe4, 0xc7,
+	0x6d, 0xd4, 0x35, 0x6a, 0x40, 0x17, 0x22, 0x77, 0xc8, 0xcc, 0x2e, 0x40, 0x59, 0x77, 0x01, 0x4a,
+	0x84, 0x72, 0xb0, 0xec, 0x6f, 0xfd, 0x34, 0xfd, 0x7d, 0x88, 0x5a, 0x19, 0x5f, 0x76, 0xbe, 0x12,
+	0xdd, 0xeb, 0x2f, 0x4f, 0x75, 0x58, 0xe8, 0x04, 0xef, 0xf6, 0xa5, 0x49, 0xe1, 0x48, 0xe6, 0xe3,
+	0xc2, 0x59, 0x16, 0xb2, 0x44, 0x99, 0x50, 0x49, 0x80, 0xc6, 0x87, 0x5e, 0x90, 0xf5, 0x1a, 0xba,
+	0x71, 0x28, 0xeb, 0xc6, 0xa1, 0x44, 0x28, 0x07, 0xf1, 0xfb, 0xa8, 0x03, 0x3d, 0xce, 0x12, 0xd7,
+	0x63, 0xbd, 0x26, 0xaf, 0xf1, 0xda, 0xa4, 0x70, 0x34, 0x78, 0x5c, 0x38, 0x6b, 0x7a, 0x80, 0x1c,
+	0x22, 0x54, 0x93, 0xf1, 0x4d, 0xd4, 0x3d, 0x78, 0x27, 0xeb, 0x1f, 0xb2, 0x34, 0x0b, 0xe2, 0xa8,
+	0xd7, 0xe2, 0x22, 0x5e, 0x9f, 0x14, 0x0e, 0x3a, 0x78, 0x27, 0xfb, 0x50, 0xa0, 0xc7, 0x85, 0xb3,
+	0x2e, 0xc7, 0x5d, 0x62, 0x84, 0x1a, 0x0c, 0xf8, 0x31, 0x5a, 0xf1, 0xc4, 0x68, 0xfb, 0x5e, 0x1c,
+	0xed, 0x05, 0x83, 0x5e, 0x9b, 0x0b, 0xfa, 0xc5, 0x49, 0xe1, 0x2c, 0x4b, 0xca, 0x0e, 0x27, 0x1c,
+	0x17, 0xce, 0x86, 0x54, 0x67, 0x13, 0x26, 0xd4, 0x66, 0xc3, 0xef, 0xa2, 0x8e, 0x97, 0xf4, 0x43,
+	0xe6, 0xfa, 0x2c, 0xed, 0x2d, 0x72, 0x61, 0xce, 0xa4, 0x70, 0x16, 0xbd, 0xe4, 0x3e, 0xc7, 0x8e,
+	0x0b, 0x67, 0x55, 0xca, 0x91, 0x08, 0xa1, 0x25, 0x11, 0x46, 0x15, 0xb1, 0xfc, 0xd3, 0x38, 0x3d,
+	0xe8, 0x7b, 0x51, 0xd0, 0xeb, 0xe8, 0x51, 0x49, 0x78, 0x27, 0x0a, 0xf4, 0xa8, 0x34, 0x46, 0xa8,
+	0xc1, 0x80, 0xaf, 0xa1, 0x66, 0xe8, 0x3e, 0x65, 0x61, 0x0f, 0xf1, 0xfa, 0x5c, 0xe9, 0x38, 0xa0,
+	0x95, 0x8e, 0x17, 0x09, 0x15, 0x30, 0xfe, 0x0d, 0xb4, 0x1e, 0x44, 0x59, 0xee, 0x86, 0x61, 0x7f,
+	0x18, 0x47, 0x7d, 0x77, 0xc0, 0xa2, 0xbc, 0xd7, 0xe5, 0x95, 0x7f, 0x79, 0x52, 0x38, 0xab, 0x92,
+	0xf8, 0x20, 0x8e, 0xb6, 0x80, 0x74, 0x5c, 0x38, 0x17, 0xa4, 0xee, 0xda, 0x04, 0x42, 0xa7, 0x59,
+	0xf1, 0x6d, 0xd4, 0xf5, 0x59, 0xe6, 0xa5, 0x41, 0x92, 0xc3, 0x3a, 0x2d, 0x71, 0xa1, 0xbf, 0x30,
+	0x29, 0x1c, 0x13, 0x3e, 0x2e, 0x1c, 0x2c, 0x04, 0x1a, 0x20, 0xa1, 0x26, 0x0b, 0xbe, 0x83, 0x96,
+	0xbc, 0x94, 0xb9, 0x39, 0xf3, 0xfb, 0x79, 0x30, 0x64, 0xbd, 0x65, 0x2d, 0x49, 0xe2, 0x4f, 0x82,
+	0x21, 0xd3, 0x92, 0x0c, 0x90, 0x50, 0x93, 0x05, 0x6f, 0xa1, 0x66, 0x14, 0xfb, 0x2c, 0xeb, 0xad,
+	0xf0, 0x8d, 0xba, 0xa6, 0xf4, 0xfe, 0x61, 0xec, 0x33, 0xbd, 0x4b, 0x39, 0x8b, 0x9e, 0x30, 0x5e,
+	0x24, 0x54, 0xc0, 0xe4, 0xa7, 0x75, 0xb4, 0x21, 0xb7, 0xc9, 0x0e, 0x97, 0x4c, 0xd9, 0x27, 0x23,
+	0x96, 0xe5, 0xb6, 0x5e, 0xd7, 0xce, 0xa0, 0xd7, 0xf7, 0xd0, 0xd2, 0x30, 0x88, 0xe2, 0x54, 0x29,
+	0xb6, 0xd8, 0xca, 0x6f, 0x4e, 0x0a, 0xc7, 0xc2, 0x8f, 0x0b, 0xe7, 0xbc, 0xdc, 0x55, 0x06, 0x4a,
+	0xa8, 0xc5, 0x04, 0xc2, 0x12, 0x37, 0xf7, 0xf6, 0x95, 0xb0, 0x05, 0x2d, 0xcc, 0xc4, 0xb5, 0x30,
+	0x13, 0x25, 0xd4, 0x62, 0xc2, 0x8f, 0xa4, 0xa9, 0x6d, 0x54, 0x5a, 0x0b, 0x31, 0x0d, 0x7c, 0xfa,
+	0xb8, 0x49, 0xa7, 0xec, 0x13, 0x28, 0x68, 0x93, 0x2e, 0x01, 0x42, 0x15, 0x89, 0xfc, 0x55, 0xa3,
+	0xb4, 0xe9, 0x8f, 0x43, 0x37, 0x3a, 0x9b, 0xd5, 0xb5, 0x26, 0xbc, 0x7e, 0x86, 0x09, 0x57, 0x06,
+	0x76, 0xe1, 0x34, 0x06, 0xf6, 0x6d, 0xd4, 0x56, 0x73, 0xd9, 0xd0, 0xc7, 0x97, 0x9e, 0x46, 0x39,
+	0xd6, 0x72, 0x06, 0x15, 0x09, 0xb6, 0xe4, 0xa1, 0x1b, 0x06, 0x3e, 0xb7, 0x75, 0x8b, 0x42, 0xc3,
+	0x38, 0xa0, 0x35, 0x8c, 0x17, 0x09, 0x15, 0x30, 0xfe, 0x00, 0xb5, 0x58, 0x9a, 0xc6, 0x69, 0xd6,
+	0x6b, 0x71, 0x2d, 0x5d, 0x57, 0xf3, 0x0d, 0x53, 0x75, 0x0b, 0x28, 0xc2, 0x2a, 0x0b, 0x26, 0x6d,
+	0x95, 0x45, 0x99, 0x50, 0x49, 0xd0, 0xca, 0xde, 0xb6, 0x95, 0x1d, 0xc4, 0x80, 0xc2, 0x3f, 0x5f,
+	0xd9, 0xf1, 0xf7, 0x50, 0x27, 0x65, 0x59, 0x3c, 0x4a, 0x3d, 0x96, 0xf5, 0x16, 0xb9, 0x98, 0x0d,
+	0x53, 0x0c, 0x95, 0x44, 0x31, 0xf1, 0x25, 0xab, 0x9e, 0xf8, 0x12, 0x22, 0x54, 0x93, 0xf1, 0x0d,
+	0xd4, 0x72, 0x7d, 0x3f, 0x8e, 0xb2, 0x5e, 0xe7, 0xca, 0xc2, 0x5b, 0x1d, 0x31, 0x1c, 0x81, 0xe8,
+	0xe1, 0x88, 0x32, 0xa1, 0x92, 0x40, 0xc6, 0xa8, 0x53, 0x4e, 0x00, 0x48, 0x48, 0x99, 0x9b, 0xc5,
+	0x91, 0x54, 0x15, 0x2e, 0x41, 0x20, 0x5a, 0x82, 0x28, 0x13, 0x2a, 0x09, 0xa6, 0x07, 0x52, 0x9f,
+	0xcb, 0x03, 0xf9, 0x59, 0x1d, 0x2d, 0xaa, 0x59, 0x9b, 0xfb, 0x58, 0x4e, 0xe3, 0x90, 0x99, 0xc7,
+	0x32, 0x94, 0x35, 0x33, 0x94, 0x08, 0xe5, 0x20, 0xde, 0x41, 0xc8, 0x8b, 0xa3, 0x88, 0x79, 0xb9,
+	0xde, 0xb1, 0xfc, 0x04, 0xd0, 0xa8, 0x3e, 0x01, 0x34, 0x46, 0xa8, 0xc1, 0x80, 0xdf, 0x44, 0x0b,
+	0x5e, 0x96, 0x48, 0x1d, 0x7d, 0x69, 0x52, 0x38, 0x50, 0x3c, 0x2e, 0x1c, 0x24, 0xab, 0x65, 0x09,
+	0xa1, 0x00, 0x89, 0x29, 0x1c, 0x40, 0x4b, 0x4d, 0x73, 0x0a, 0x07, 0x81, 0x3d, 0x85, 0x83, 0x40,
+	0x4e, 0x21, 0xfc, 0x80, 0xf1, 0x7c, 0x16, 0x47, 0xac, 0xd7, 0xd2, 0xe3, 0x81, 0xb2, 0x1e, 0x0f,
+	0x94, 0x08, 0xe5, 0x20, 0x30, 0x67, 0x09, 0xf3, 0x7a, 0x6d, 0xcd, 0x0c, 0x65, 0xcd, 0x0c, 0x25,
+	0x42, 0x39, 0x48, 0xfe, 0xa8, 0x8e, 0x96, 0x4c, 0x95, 0x9a, 0xdb, 0x13, 0xe5, 0x8b, 0x52, 0x3f,
+	0xcd, 0xa2, 0xbc, 0x90, 0x79, 0x06, 0x1d, 0x16, 0x02, 0x1a, 0x7a, 0xfa, 0x5c, 0x2f, 0xb7, 0xa6,
+	0xcf, 0x95, 0x15, 0x25, 0xc1, 0xd4, 0xc0, 0xe6, 0x5c, 0x1a, 0xf8, 0x83, 0x06, 0x5a, 0x9f, 0x31,
+	0xb7, 0xf3, 0xa9, 0xe2, 0xf7, 0xd1, 0xb2, 0x17, 0x47, 0x79, 0x1a, 0x87, 0xfd, 0x24, 0x74, 0x23,
+	0x26, 0x9d, 0x55, 0x6c, 0x9e, 0x81, 0xc2, 0x93, 0x11, 0xc7, 0x84, 0x64, 0x86, 0x35, 0x61, 0xfa,
+	0x98, 0x30, 0x51, 0x42, 0x2d, 0x26, 0x7c, 0x1b, 0xb5, 0xc0, 0x0f, 0x61, 0x69, 0x6f, 0xe1, 0x44,
+	0xd1, 0x7c, 0x9a, 0x04, 0x97, 0x9e, 0x26, 0x51, 0x26, 0x54, 0x12, 0xf0, 0x0e, 0x6a, 0x49, 0x9f,
+	0x4c, 0x9c, 0x38, 0x2b, 0xe5, 0x89, 0x63, 0x08, 0xf1, 0x94, 0x73, 0xb6, 0x5c, 0xf6, 0x8c, 0x7b,
+	0x65, 0x92, 0xa0, 0x5d, 0xa1, 0xe6, 0x17, 0x71, 0x85, 0x5a, 0x5f, 0x86, 0x2b, 0xd4, 0x3e, 0xab,
+	0x2b, 0x44, 0x7e, 0xd4, 0x40, 0x48, 0xcf, 0xe6, 0x94, 0x26, 0xd7, 0xce, 0xa6, 0xc9, 0xd7, 0x50,
+	0xd3, 0x8b, 0x47, 0x51, 0x2e, 0xaf, 0x71, 0x7c, 0xa2, 0x38, 0xa0, 0x27, 0x8a, 0x17, 0x09, 0x15,
+	0x70, 0xb9, 0xaf, 0x17, 0x4e, 0xb1, 0xaf, 0x85, 0x3d, 0x0a, 0xf8, 0x42, 0x2e, 0x2a, 0x7b, 0x14,
+	0x98, 0xf6, 0x28, 0xe0, 0xf6, 0x28, 0xc0, 0x03, 0xd4, 0xe2, 0xeb, 0x90, 0xf5, 0x9a, 0x5c, 0x7b,
+	0x2e, 0xcf, 0x6a, 0xcf, 0xd5, 0xfb, 0x9c, 0xe1, 0x56, 0x94, 0xa7, 0xe3, 0xed, 0x6b, 0x93, 0xc2,
+	0x59, 0x13, 0x35, 0x7e, 0x29, 0x1e, 0x82, 0x7f, 0x92, 0xe4, 0xe3, 0xe3, 0xc2, 0xb9, 0x68, 0xac,
+	0xad, 0x41, 0x21, 0x54, 0x8a, 0xc7, 0x1f, 0xa2, 0x56, 0xee, 0x06, 0x51, 0xae, 0xce, 0xd7, 0x65,
+	0xd5, 0xd0, 0x13, 0x40, 0x85, 0x5c, 0xc1, 0x50, 0x25, 0x77, 0x9a, 0x42, 0xa8, 0x94, 0x86, 0xef,
+	0xa0, 0x85, 0x2c, 0xdb, 0xe7, 0x8b, 0xdb, 0xbd, 0xde, 0x55, 0x42, 0x77, 0x77, 0xef, 0x88, 0x3b,
+	0x45, 0x96, 0xed, 0x5b, 0xf2, 0xe4, 0x9d, 0xc2, 0x82, 0x09, 0x05, 0x11, 0x9b, 0xdf, 0x40, 0x5d,
+	0x63, 0xa4, 0x78, 0x0d, 0x2d, 0x1c, 0xb0, 0xb1, 0x58, 0x5e, 0x0a, 0x3f, 0xf1, 0x06, 0xf7, 0x29,
+	0x46, 0xd2, 0xde, 0x51, 0x51, 0xf8, 0x66, 0xfd, 0x9d, 0x1a, 0xf9, 0x9f, 0x06, 0x5a, 0xd8, 0xdd,
+	0xbd, 0x83, 0xdf, 0x47, 0x8d, 0x24, 0x4e, 0x73, 0x5e, 0xa9, 0xb9, 0xfd, 0xb5, 0x49, 0xe1, 0xac,
+	0x40, 0xd9, 0xea, 0xc1, 0x4b, 0xd2, 0xf3, 0xb3, 0x70, 0x42, 0x79, 0x45, 0xfc, 0x08, 0x2d, 0x8e,
+	0x32, 0x96, 0x1a, 0x56, 0xf5, 0xc6, 0xa4, 0x70, 0xb0, 0xc2, 0x2c, 0x41, 0x2f, 0x0b, 0x41, 0xb3,
+	0x34, 0x42, 0x4b, 0x21, 0xf8, 0x63, 0xb4, 0xe4, 0x07, 0x6e, 0xc8, 0x5d, 0xf8, 0x78, 0x94, 0x73,
+	0xed, 0x69, 0x6e, 0x7f, 0x63, 0x52, 0x38, 0x2f, 0x01, 0xfe, 0x44, 0xc0, 0x96, 0xdc, 0x57, 0xe4,
+	0x76, 0xa8, 0x22, 0xc3, 0xc6, 0xd0, 0x38, 0xbe, 0x87, 0xda, 0x29, 0xcb, 0xd3, 0x80, 0x89, 0x5b,
+	0x68, 0x73, 0xfb, 0x57, 0x26, 0x85, 0xb3, 0x2e, 0x21, 0x4b, 0x68, 0x4f, 0x9d, 0x6c, 0x53, 0x24,
+	0x42, 0x95, 0x04, 0xec, 0xa3, 0x15, 0xf8, 0x39, 0xee, 0x07, 0x51, 0xce, 0xd2, 0x43, 0x57, 0xd8,
+	0x90, 0xe6, 0xf6, 0x7b, 0x93, 0xc2, 0xb9, 0xc8, 0x29, 0x77, 0x25, 0xc1, 0x92, 0x7c, 0x59, 0x4b,
+	0xae, 0x60, 0x20, 0x74, 0xd9, 0xa2, 0xe0, 0x4f, 0x10, 0x1e, 0xba, 0x47, 0xfd, 0xa9, 0x96, 0x5a,
+	0xbc, 0xa5, 0x9d, 0x49, 0xe1, 0x6c, 0x0e, 0xdd, 0x23, 0x7a, 0x62, 0x63, 0xaf, 0x89, 0xc6, 0x4e,
+	0xe6, 0x21, 0x74, 0x6d, 0x9a, 0x88, 0x77, 0x51, 0xe7, 0x80, 0xb1, 0xc4, 0x0d, 0x83, 0x43, 0xc6,
+	0x15, 0xb5, 0xb9, 0xfd, 0xab, 0x93, 0xc2, 0x39, 0x5f, 0x82, 0x56, 0x13, 0x9b, 0xf2, 0x9c, 0x9d,
+	0x25, 0x12, 0xaa, 0xe5, 0x90, 0xbf, 0xa8, 0xa1, 0x26, 0xdf, 0x3a, 0xf8, 0x4d, 0x43, 0x51, 0xc5,
+	0x5e, 0x3f, 0x60, 0x63, 0xbd, 0xd7, 0x0f, 0xd8, 0x98, 0x08, 0xfd, 0xdd, 0xb1, 0xf4, 0x57, 0x98,
+	0x57, 0x0e, 0x58, 0xed, 0x5f, 0x28, 0xbd, 0xe3, 0x91, 0xd5, 0xb6, 0xa8, 0x0b, 0x27, 0x30, 0xdb,
+	0xdb, 0x63, 0x5e, 0x2e, 0x0d, 0x91, 0x70, 0x8a, 0x39, 0x62, 0x38, 0xc5, 0xbc, 0x0c, 0x4e, 0xb1,
+	0xf8, 0xe1, 0xa2, 0x96, 0xb4, 0x9d, 0x1f, 0x21, 0x74, 0x30, 0x7a, 0xca, 0xd2, 0x88, 0xe5, 0x2c,
+	0x93, 0x51, 0xa4, 0xf2, 0xc4, 0xba, 0x57, 0x52, 0x64, 0x64, 0xa1, 0x2c, 0x1b, 0x91, 0x85, 0x12,
+	0x83, 0xc8, 0x82, 0x2e, 0xfc, 0xee, 0x12, 0x42, 0xba, 0xfe, 0xf4, 0xc5, 0xbe, 0x76, 0xb6, 0x8b,
+	0xfd, 0x3b, 0x68, 0x31, 0x89, 0xfd, 0xbe, 0x17, 0xf8, 0xa9, 0xe9, 0xbc, 0x26, 0xb1, 0xbf, 0x13,
+	0xf8, 0xa9, 0x76, 0x1d, 0x24, 0x40, 0xa8, 0x22, 0xc1, 0xed, 0x39, 0x63, 0xe9, 0x61, 0xe0, 0x31,
+	0x51, 0x7b, 0x41, 0x1f, 0x3e, 0x12, 0x97, 0x12, 0xe4, 0xe1, 0x63, 0x80, 0x84, 0x9a, 0x2c, 0xf8,
+	0x63, 0xb4, 0x2e, 0x8a, 0x7d, 0x3f, 0xca, 0xfa, 0x7e, 0x3c, 0x74, 0x03, 0xe5, 0xfd, 0x70, 0xa3,
+	0x29, 0x79, 0x6f, 0x46, 0xd9, 0x4d, 0x4e, 0xd3, 0x46, 0x73, 0x9a, 0x42, 0xe8, 0x0c, 0x33, 0x7e,
+	0x00, 0xfe, 0x5e, 0x18, 0xf3, 0xad, 0xd6, 0xbd, 0xbe, 0x54, 0xae, 0x44, 0x10, 0xc6, 0xc2, 0x7e,
+	0x01, 0xb5, 0xca, 0x7e, 0xd9, 0x38, 0xf7, 0x08, 0xc3, 0x18, 0xff, 0x76, 0x0d, 0x2d, 0xef, 0x31,
+	0x37, 0x1f, 0xa5, 0xac, 0x3f, 0x70, 0x73, 0xa6, 0xac, 0xfd, 0x1b, 0xb3, 0x4b, 0x7c, 0xf5, 0x03,
+	0xc1, 0x77, 0x1b, 0xd8, 0xc4, 0xe1, 0xf2, 0xad, 0x49, 0xe1, 0x5c, 0xd8, 0x33, 0x60, 0xab, 0xe1,
+	0x57, 0x45, 0xc3, 0xd5, 0x74, 0x42, 0x97, 0x4c, 0x02, 0x1e, 0x20, 0xe4, 0x26, 0x41, 0x1f, 0xc6,
+	0xcb, 0x52, 0x79, 0x3a, 0xac, 0x6b, 0x87, 0x66, 0x98, 0xc4, 0x11, 0x8b, 0x72, 0xb1, 0x0f, 0xdd,
+	0x24, 0xd8, 0xe5, 0x7c, 0x55, 0xfb, 0xb0, 0x82, 0x48, 0x68, 0xa7, 0x44, 0xf1, 0xef, 0xd4, 0x10,
+	0x96, 0xfe, 0x58, 0xc8, 0xd2, 0xfe, 0xd0, 0x8d, 0xdc, 0x81, 0x8c, 0x44, 0x55, 0xb6, 0x78, 0x6b,
+	0x52, 0x38, 0x97, 0x74, 0x85, 0x07, 0x82, 0xdf, 0x6a, 0x99, 0x58, 0x4e, 0x5f, 0x15, 0x13, 0xa1,
+	0xeb, 0x33, 0x54, 0xbc, 0x87, 0x3a, 0x99, 0xb7, 0xcf, 0xfc, 0x51, 0xc8, 0xd2, 0x5e, 0xe7, 0xa4,
+	0xf6, 0xf9, 0x88, 0x4b, 0xbe, 0xaa, 0x11, 0x57, 0x10, 0x09, 0xd5, 0xa2, 0xf1, 0xc7, 0xa8, 0x0d,
+	0xfb, 0x2e, 0x64, 0x39, 0x8f, 0x77, 0x75, 0xaf, 0xaf, 0x9a, 0x8b, 0x1b, 0xb2, 0x5c, 0x9c, 0x02,
+	0x92, 0xa7, 0xea, 0x14, 0x98, 0x21, 0x11, 0xaa, 0x44, 0xe2, 0xc7, 0xa8, 0xe3, 0xb1, 0x34, 0xef,
+	0x67, 0x6e, 0x94, 0xf5, 0xba, 0x57, 0x16, 0xd4, 0x11, 0x08, 0xe0, 0xee, 0xd6, 0xc3, 0xac, 0xea,
+	0x08, 0x9c, 0xa5, 0x41, 0x8c, 0x4f, 0x82, 0xa0, 0xe2, 0x2c, 0xf7, 0xfc, 0xde, 0x92, 0xad, 0xe2,
+	0xb7, 0x72, 0xcf, 0x17, 0x2a, 0x0e, 0xd4, 0x2a, 0x15, 0xb7, 0x71, 0x42, 0xb9, 0x18, 0x38, 0xa2,
+	0x59, 0xe4, 0x27, 0x71, 0x10, 0xe5, 0x32, 0x26, 0xc6, 0xfb, 0xa7, 0xb0, 0xaa, 0xfe, 0xcd, 0xd2,
+	0x08, 0x2d, 0x85, 0x40, 0xff, 0xe2, 0xc0, 0xf7, 0x7a, 0x2b, 0x76, 0xff, 0x1e, 0x05, 0xbe, 0x27,
+	0xfa, 0x07, 0xd4, 0xaa, 0xfe, 0xd9, 0x38, 0xa1, 0x5c, 0x0c, 0xa6, 0xa8, 0xe9, 0x8e, 0xfc, 0x20,
+	0xef, 0xad, 0x5e, 0xa9, 0x99, 0x7e, 0xd6, 0x16, 0x80, 0xc2, 0xe8, 0x73, 0x7a, 0x95, 0xd1, 0x9f,
+	0x22, 0x10, 0x2a, 0x44, 0xe1, 0x03, 0x84, 0x58, 0xe4, 0xa5, 0x63, 0xe1, 0x48, 0xaf, 0xd9, 0x56,
+	0xfb, 0x56, 0x49, 0xd9, 0x7e, 0x7b, 0x52, 0x38, 0x1b, 0x9a, 0xd3, 0x6a, 0xe2, 0x92, 0x9a, 0x8b,
+	0x59, 0x2a, 0xa1, 0x86, 0xf8, 0xcd, 0xf7, 0xd1, 0xfa, 0x8c, 0x69, 0x78, 0x9e, 0x37, 0xb6, 0x68,
+	0x7a, 0x63, 0x7f, 0x5f, 0x43, 0x9d, 0x52, 0xe1, 0xf1, 0x21, 0x42, 0xec, 0x28, 0x4f, 0xdd, 0xbe,
+	0x9b, 0x0e, 0xe0, 0xc4, 0x01, 0x73, 0x74, 0x65, 0x66, 0x5f, 0x5c, 0xbd, 0x05, 0x3c, 0x5b, 0xe9,
+	0x40, 0x9a, 0x22, 0xbe, 0x4d, 0x98, 0xc2, 0xaa, 0xb6, 0x49, 0x05, 0x91, 0xd0, 0x4e, 0x89, 0x6e,
+	0xbe, 0x8b, 0x56, 0x6c, 0x99, 0x73, 0x79, 0x94, 0x3f, 0x69, 0xa2, 0xb6, 0xdc, 0x4e, 0xf8, 0x3e,
+	0x5a, 0x04, 0x97, 0x25, 0x89, 0xfd, 0x4c, 0x7a, 0x96, 0x7c, 0x83, 0x0d, 0xdd, 0xa3, 0xc7, 0xb1,
+	0x5f, 0xe9, 0x66, 0xcd, 0x90, 0xe0, 0x52, 0x2b, 0x30, 0xfc, 0xc3, 0x1a, 0x5a, 0xcd, 0xc6, 0x59,
+	0xce, 0x86, 0xfd, 0x94, 0x71, 0xfb, 0xe8, 0xcb, 0x4b, 0xe9, 0xeb, 0x53, 0xfb, 0xf8, 0xea, 0x2e,
+	0x67, 0xa3, 0x92, 0x4b, 0x4c, 0xcc, 0xfb, 0x93, 0xc2, 0xe9, 0x65, 0x16, 0xc1, 0xea, 0x81, 0x23,
+	0x8d, 0xc8, 0x09, 0x1c, 0x84, 0xae, 0xd8, 0x24, 0xfc, 0x5b, 0x35, 0xb4, 0x0c, 0x9b, 0x5f, 0xf7,
+	0x46, 0xdc, 0x63, 0x5f, 0x9b, 0xee, 0x0d, 0xfc, 0xb5, 0xfb, 0xc2, 0xcf, 0x8b, 0x03, 0x03, 0xae,
+	0x3a, 0x2f, 0xaa, 0xe9, 0x84, 0x2e, 0x99, 0x04, 0xde, 0x0b, 0x76, 0x18, 0xf0, 0xbb, 0x59, 0x7f,
+	0xdf, 0x4d, 0xfd, 0x5e, 0xa3, 0xba, 0x17, 0xb7, 0x24, 0xd3, 0x1d, 0x37, 0x35, 0x7b, 0xc1, 0x0c,
+	0xb8, 0xaa, 0x17, 0xd5, 0x74, 0x42, 0x97, 0x4c, 0xc2, 0xe6, 0x16, 0x3a, 0x5f, 0x31, 0xe7, 0xf3,
+	0x28, 0x0e, 0xec, 0x9e, 0x99, 0x89, 0x9a, 0x57, 0xc0, 0xcc, 0x18, 0xe7, 0x52, 0xdd, 0x1f, 0xd4,
+	0x51, 0x03, 0x8c, 0x2b, 0xe8, 0xad, 0xef, 0xe6, 0x6e, 0xdf, 0x0f, 0x52, 0x51, 0x53, 0xe8, 0x2d,
+	0x60, 0x37, 0x83, 0xb4, 0x4a, 0x6f, 0x67, 0x48, 0x84, 0xb6, 0x25, 0x86, 0x3f, 0xb1, 0xf6, 0xb1,
+	0xd0, 0xd8, 0x4b, 0xa6, 0x31, 0xff, 0xaa, 0x6d, 0xe1, 0x7f, 0x6a, 0xa0, 0x06, 0x18, 0x71, 0xfc,
+	0x6d, 0x84, 0x82, 0x2c, 0x1b, 0xb1, 0xb4, 0x3f, 0x4a, 0x43, 0x33, 0x49, 0x21, 0xd0, 0xef, 0xa5,
+	0xa1, 0x0e, 0xdd, 0x96, 0x10, 0xa1, 0x9a, 0xcc, 0x93, 0x5c, 0x61, 0xc0, 0xa2, 0xbc, 0x1f, 0xa8,
+	0x64, 0xa3, 0x48, 0x72, 0x71, 0xf0, 0xae, 0x6f, 0x24, 0xb9, 0x24, 0x02, 0x07, 0xa0, 0xfc, 0x09,
+	0x17, 0x2b, 0x75, 0x1f, 0xec, 0x7b, 0xa1, 0x1b, 0x0c, 0xa5, 0x37, 0xca, 0x2f, 0x56, 0x8a, 0xb2,
+	0x03, 0x84, 0xaa, 0x8b, 0xd5, 0x09, 0x0c, 0x84, 0x2e, 0x5b, 0x14, 0xbc, 0x8f, 0x56, 0xcb, 0x56,
+	0x92, 0x94, 0xed, 0x05, 0x47, 0xd2, 0x4b, 0xe5, 0x16, 0x43, 0x91, 0x1e, 0x73, 0x4a, 0x95, 0xc5,
+	0x38, 0x89, 0x83, 0xd0, 0x15, 0x9b, 0x04, 0x77, 0xda, 0x41, 0x1a, 0x8f, 0x92, 0x4c, 0x8e, 0x46,
+	0x84, 0x9a, 0xf8, 0x9d, 0x56, 0xe0, 0xb3, 0x63, 0x91, 0x77, 0xda, 0x4a, 0x32, 0xa1, 0x5d, 0x03,
+	0x87, 0x88, 0x9d, 0x94, 0x2e, 0x47, 0x21, 0x82, 0x51, 0x7c, 0x97, 0x0b, 0x42, 0xc5, 0x18, 0x5e,
+	0x35, 0xe5, 0xcf, 0x8e, 0x60, 0xc9, 0x24, 0xe0, 0xb7, 0x51, 0xdd, 0x73, 0x65, 0x38, 0x4a, 0x04,
+	0xfd, 0x5c, 0x4b, 0x98, 0x0a, 0xfa, 0xb9, 0xa6, 0x88, 0xba, 0xe7, 0x92, 0xbf, 0xa9, 0xa3, 0x26,
+	0x3f, 0xc6, 0x79, 0x98, 0x8d, 0x1d, 0x32, 0xa5, 0x4d, 0x22, 0xcc, 0x06, 0x80, 0x11, 0x66, 0x63,
+	0x87, 0x22, 0xcc, 0x06, 0x7f, 0x21, 0x4a, 0x98, 0xc4, 0x61, 0xe0, 0x8d, 0x7b, 0x75, 0x7d, 0x75,
+	0x10, 0x48, 0x55, 0xbc, 0x65, 0x9a, 0x42, 0xa8, 0xac, 0x8e, 0xbf, 0x8e, 0xe0, 0x24, 0xe9, 0xab,
+	0x07, 0x05, 0x4d, 0x71, 0x01, 0x1c, 0xba, 0x47, 0x5b, 0x03, 0xa6, 0x2f, 0x80, 0xa2, 0x4c, 0xa8,
+	0x24, 0xc0, 0x16, 0x80, 0x5a, 0x4f, 0x5d, 0xef, 0x60, 0x94, 0xc8, 0x58, 0x01, 0xdf, 0x02, 0x43,
+	0xf7, 0x68, 0x9b, 0x83, 0x7a, 0x0b, 0x94, 0x10, 0xa1, 0x9a, 0x0c, 0x57, 0x31, 0x90, 0x90, 0x05,
+	0x9f, 0x31, 0x19, 0x17, 0x10, 0x51, 0x5c, 0xf7, 0x68, 0x37, 0xf8, 0xcc, 0x8c, 0xe2, 0x0a, 0x40,
+	0x1c, 0x78, 0xfc, 0xd7, 0x8f, 0x6a, 0x08, 0x69, 0x1f, 0x05, 0x7f, 0x0b, 0x2d, 0x26, 0x69, 0x7c,
+	0x18, 0x40, 0xbe, 0xb8, 0xa6, 0xb7, 0x92, 0xc2, 0xf4, 0x56, 0x52, 0x08, 0xa1, 0x25, 0x11, 0xae,
+	0xf2, 0x3a, 0x35, 0x53, 0xe7, 0xde, 0x29, 0x37, 0x33, 0x25, 0x58, 0x65, 0x66, 0x2a, 0x88, 0x66,
+	0x62, 0x86, 0x14, 0x4d, 0xd4, 0x80, 0x0b, 0x17, 0x74, 0x2d, 0x8f, 0x93, 0x38, 0x8c, 0x07, 0x63,
+	0xb3, 0x6b, 0x0a, 0xd3, 0x5d, 0x53, 0x08, 0xa1, 0x25, 0x11, 0x27, 0xa8, 0x13, 0xc6, 0x9e, 0x0b,
+	0x63, 0x9c, 0x31, 0x8f, 0x20, 0xfd, 0xea, 0x7d, 0x45, 0x35, 0xcc, 0x63, 0x59, 0xa3, 0xaa, 0xdf,
+	0x15, 0x44, 0x42, 0x75, 0x23, 0xf8, 0x8f, 0x6b, 0x68, 0x23, 0x81, 0x7c, 0x5b, 0x96, 0x83, 0x69,
+	0xd2, 0x31, 0x0e, 0xf1, 0xbe, 0xe1, 0xd2, 0x55, 0xf1, 0xba, 0xe6, 0xaa, 0x7a, 0x5d, 0x73, 0xf5,
+	0x6e, 0x94, 0xdf, 0xb8, 0xfe, 0x21, 0x18, 0xc8, 0xed, 0x07, 0x93, 0xc2, 0x79, 0x55, 0x57, 0xbe,
+	0x57, 0x19, 0x0a, 0x79, 0x43, 0x2e, 0xc4, 0xb3, 0xd8, 0xc8, 0x9f, 0xfe, 0xab, 0x53, 0xa3, 0xe7,
+	0x2b, 0x78, 0xf0, 0xf7, 0x65, 0x34, 0xae, 0xf1, 0xfc, 0xee, 0x5c, 0x9b, 0x23, 0x54, 0xc7, 0x1b,
+	0xe4, 0x92, 0xf1, 0x9f, 0xd4, 0xd0, 0x86, 0x1b, 0x86, 0xf1, 0xa7, 0xcc, 0xef, 0xab, 0x79, 0xe9,
+	0x07, 0x89, 0x0a, 0xa6, 0xbe, 0x61, 0xcd, 0xff, 0x96, 0x60, 0x54, 0xcb, 0x70, 0x37, 0x91, 0x0b,
+	0x71, 0x7b, 0x52, 0x38, 0xaf, 0xb8, 0x53, 0xc4, 0xc7, 0xf6, 0x8a, 0xbc, 0x2e, 0xfd, 0xf3, 0x67,
+	0x70, 0x11, 0x8a, 0x67, 0xc9, 0x70, 0x84, 0xd9, 0xeb, 0x3e, 0x97, 0x2f, 0x70, 0x0b, 0x5d, 0x3c,
+	0xa1, 0xd7, 0x73, 0x9d, 0x84, 0x1f, 0x95, 0xc9, 0xfb, 0xad, 0x30, 0xfc, 0x6e, 0x3a, 0x7e, 0x51,
+	0xc9, 0x7b, 0xf2, 0xc3, 0x5a, 0x99, 0xa0, 0x79, 0x81, 0x62, 0x21, 0x61, 0x24, 0x1f, 0x99, 0x98,
+	0x51, 0x1f, 0x09, 0x69, 0x53, 0x23, 0x01, 0x42, 0x15, 0x89, 0xfc, 0xb5, 0xee, 0x8f, 0x7e, 0xcd,
+	0x03, 0xc6, 0x3a, 0xd9, 0x77, 0x33, 0x66, 0x1a, 0x6b, 0x0e, 0x68, 0x63, 0xcd, 0x8b, 0x84, 0x0a,
+	0xd8, 0xc8, 0xb3, 0xd6, 0xcf, 0x94, 0x67, 0x9d, 0xef, 0xa5, 0xd7, 0x77, 0xd1, 0x9a, 0x7a, 0x89,
+	0x51, 0x3e, 0x09, 0x78, 0xcf, 0x7a, 0xe6, 0x35, 0xfb, 0x62, 0xe3, 0x39, 0x6f, 0xbc, 0xfe, 0xa3,
+	0x86, 0x36, 0xe0, 0x8d, 0xd7, 0x8c, 0xdc, 0xb9, 0xd2, 0x8b, 0x5b, 0xf6, 0x03, 0xaf, 0x13, 0xde,
+	0x8d, 0x3c, 0xeb, 0x75, 0x17, 0xfe, 0x18, 0xb5, 0xf6, 0xdc, 0x20, 0x2c, 0x2f, 0x15, 0xe7, 0x4d,
+	0x19, 0x1f, 0xb8, 0x41, 0x38, 0x4a, 0xc5, 0xe6, 0x5f, 0x13, 0x6c, 0x55, 0x67, 0xe1, 0x34, 0x85,
+	0x50, 0x29, 0x93, 0x64, 0xa8, 0x6b, 0xc8, 0x99, 0x2f, 0x31, 0x78, 0xe6, 0xb4, 0xf8, 0x4f, 0xbb,
+	0x68, 0x51, 0xcd, 0xc0, 0x97, 0xf8, 0x5a, 0x0d, 0x92, 0x5c, 0x29, 0xf3, 0x59, 0x94, 0x07, 0x6e,
+	0x68, 0xa5, 0x6b, 0x4b, 0xd4, 0x48, 0x72, 0x95, 0x18, 0x24, 0xb9, 0xca, 0x02, 0xf8, 0xad, 0xc9,
+	0xe8, 0x69, 0x18, 0x78, 0xfd, 0x40, 0x25, 0xc7, 0xc5, 0x61, 0xcb, 0xc1, 0xbb, 0x89, 0x71, 0xd8,
+	0x4a, 0x04, 0x0e, 0x5b, 0xf9, 0xb3, 0x4c, 0xe3, 0x37, 0x4f, 0x93, 0xc6, 0x57, 0xe9, 0xb1, 0xd6,
+	0xa9, 0xd3, 0x63, 0x89, 0x74, 0xc1, 0x9e, 0x95, 0xae, 0x9f, 0x7e, 0x04, 0xb5, 0x78, 0xe6, 0x47,
+	0x50, 0xe0, 0xc2, 0x67, 0x49, 0x5f, 0x24, 0x47, 0x3b, 0x86, 0x0b, 0x9f, 0x25, 0xf7, 0x65, 0x7e,
+	0x74, 0xb5, 0x6c, 0xfd, 0xbe, 0x48, 0x91, 0x96, 0x44, 0xe8, 0x87, 0x78, 0x0b, 0xd0, 0x37, 0x1f,
+	0x9a, 0xf1, 0x7e, 0x08, 0x5c, 0xc9, 0xc0, 0xe6, 0x0b, 0x02, 0x29, 0xc6, 0x64, 0x01, 0x4f, 0x0c,
+	0x9e, 0x09, 0x48, 0x39, 0x5d, 0x6d, 0x1d, 0x01, 0x55, 0x52, 0xd6, 0xf4, 0xb3, 0x02, 0x29, 0x43,
+	0x93, 0x31, 0x2b, 0x53, 0x86, 0x4b, 0x7c, 0x4f, 0xbd, 0x32, 0xbd, 0x2f, 0x5f, 0x74, 0xc2, 0x70,
+	0xf9, 0x85, 0x26, 0x0c, 0x5d, 0x74, 0x9e, 0x27, 0x8a, 0x23, 0x8f, 0xf5, 0xf3, 0x71, 0xa2, 0x66,
+	0x62, 0x45, 0x5f, 0x50, 0x15, 0xf9, 0xc9, 0x38, 0x29, 0x67, 0xa4, 0x67, 0x24, 0x9d, 0x4d, 0x12,
+	0xa1, 0xb3, 0xec, 0xf8, 0xd7, 0xd1, 0x9a, 0xce, 0xf4, 0x4a, 0xf9, 0xab, 0x3a, 0xe7, 0xa2, 0x69,
+	0x4a, 0xfa, 0x85, 0xe9, 0x5c, 0xb1, 0x94, 0x3d, 0xcd, 0x0a, 0x69, 0x0d, 0xe5, 0x8b, 0xc2, 0x55,
+	0x70, 0x4d, 0x6f, 0x4b, 0x05, 0xdf, 0xf5, 0xf5, 0xb6, 0xd4, 0x18, 0xa1, 0x06, 0x03, 0x5c, 0xcc,
+	0xf7, 0xe3, 0x0c, 0x3c, 0xb6, 0x71, 0x6f, 0x5d, 0x8f, 0x1b, 0xb0, 0x7b, 0x6c, 0x5c, 0x75, 0x31,
+	0x9f, 0x21, 0x11, 0xda, 0x96, 0x18, 0x7e, 0x82, 0x50, 0x92, 0x06, 0x87, 0x6e, 0xce, 0x60, 0x97,
+	0x63, 0x2e, 0x8f, 0x3b, 0x97, 0x12, 0xbd, 0x9b, 0x54, 0x39, 0x97, 0x15, 0x44, 0x42, 0x3b, 0x25,
+	0x0a, 0x89, 0x22, 0x3d, 0xf8, 0xde, 0x79, 0x2e, 0x95, 0x87, 0x17, 0x35, 0x5a, 0x15, 0x5e, 0xac,
+	0xa2, 0xda, 0x89, 0x77, 0x99, 0x30, 0xde, 0xf8, 0x7f, 0x4d, 0x18, 0xff, 0x4b, 0x0d, 0xad, 0xf3,
+	0x0c, 0xfb, 0x8b, 0x7d, 0xcc, 0x78, 0x56, 0xc7, 0x05, 0xdf, 0x97, 0xe7, 0xbd, 0xf0, 0xdc, 0x2f,
+	0x58, 0x8f, 0x00, 0xe6, 0x7f, 0x68, 0xf8, 0x77, 0x35, 0xb4, 0x62, 0x57, 0x9d, 0x7d, 0x07, 0x53,
+	0xfb, 0xf2, 0xde, 0xc1, 0xd4, 0xbf, 0xd0, 0x3b, 0x18, 0xee, 0x54, 0x42, 0x9d, 0x17, 0xeb, 0xab,
+	0x9e, 0xdd, 0xa9, 0xfc, 0x5b, 0x39, 0x9b, 0x5f, 0x85, 0xce, 0x70, 0x87, 0x03, 0x3e, 0x3e, 0x30,
+	0x5f, 0x6f, 0x5a, 0x1f, 0x1f, 0x44, 0xe2, 0xe3, 0x03, 0xfe, 0xe7, 0x3f, 0xe5, 0x4c, 0xde, 0x8f,
+	0x07, 0x3f, 0x77, 0x9d, 0x07, 0xe6, 0x2c, 0x67, 0x89, 0xf9, 0xbc, 0x1e, 0xca, 0x9a, 0x19, 0x4a,
+	0xe0, 0x50, 0xc0, 0x1f, 0xf5, 0x51, 0x83, 0x1c, 0xed, 0x8b, 0xff, 0xa8, 0xc1, 0x10, 0x7c, 0x8a,
+	0x8f, 0x1a, 0xfe, 0x72, 0x01, 0x75, 0x8d, 0x1a, 0x5f, 0xa2, 0x9b, 0xf8, 0xa5, 0x4d, 0x29, 0x67,
+	0xd6, 0xc1, 0x1e, 0xc1, 0x2c, 0x22, 0x3d, 0x8a, 0x99, 0x87, 0x79, 0x38, 0x08, 0x3a, 0x95, 0xa7,
+	0xa3, 0xc8, 0x03, 0x77, 0x8b, 0xbb, 0x80, 0x8b, 0x42, 0xa7, 0x4a, 0x50, 0xeb, 0x54, 0x09, 0x11,
+	0xaa, 0xc9, 0x70, 0xe5, 0x8a, 0x47, 0x79, 0x32, 0xca, 0xa5, 0x53, 0xc8, 0x2d, 0x85, 0x40, 0xb4,
+	0xa5, 0x10, 0x65, 0x42, 0x25, 0x01, 0xbc, 0xb2, 0x51, 0xe2, 0x57, 0x7a, 0x87, 0x12, 0xb7, 0xbd,
+	0x43, 0x03, 0x24, 0xd4, 0x64, 0x21, 0x3f, 0xab, 0xa1, 0x8d, 0x07, 0x6e, 0x14, 0xec, 0xb1, 0x2c,
+	0xdf, 0x4a, 0x92, 0xf0, 0x2b, 0xb0, 0x59, 0x1e, 0x59, 0x47, 0x42, 0xf9, 0xfc, 0xdc, 0xea, 0xe5,
+	0x5c, 0xa7, 0xc2, 0xff, 0xd6, 0xd0, 0xfa, 0x4c, 0x6d, 0x88, 0x79, 0x0d, 0x25, 0x68, 0xc6, 0xbc,
+	0x14, 0xa6, 0xdd, 0x62, 0x85, 0x10, 0x5a, 0x12, 0xe1, 0x73, 0x92, 0x24, 0x1d, 0x45, 0xac, 0x9f,
+	0xb1, 0x90, 0x79, 0x79, 0xac, 0xc6, 0xc8, 0x4f, 0x72, 0x4e, 0xd9, 0x95, 0x04, 0x7d, 0x92, 0x5b,
+	0x30, 0xa1, 0x36, 0x1b, 0x7e, 0x82, 0x56, 0xf7, 0xe2, 0x14, 0x5e, 0x6d, 0xc4, 0xd1, 0x5e, 0x18,
+	0x78, 0xb9, 0xf8, 0x5a, 0x67, 0x51, 0x64, 0x62, 0x39, 0x69, 0x47, 0x51, 0x74, 0x84, 0xc8, 0xc6,
+	0x09, 0x9d, 0x62, 0x24, 0x7f, 0x50, 0x43, 0x17, 0xd4, 0xd0, 0x29, 0xcb, 0x46, 0x61, 0x7e, 0x36,
+	0xeb, 0x70, 0xcf, 0xb6, 0x0e, 0x9b, 0xd3, 0x8b, 0xf2, 0xe8, 0xe9, 0x6f, 0x32, 0x2f, 0x3f, 0xa5,
+	0x91, 0xf8, 0xef, 0x1a, 0xc2, 0xb3, 0x15, 0x61, 0x41, 0x54, 0x68, 0xd2, 0x5c, 0x10, 0x85, 0xe9,
+	0x05, 0x51, 0x08, 0xa1, 0x25, 0x71, 0xbe, 0x17, 0xc1, 0xfa, 0x31, 0xef, 0xc2, 0x99, 0x1e, 0xf3,
+	0x36, 0xe6, 0x0d, 0x73, 0xec, 0x26, 0xcc, 0x3b, 0x4d, 0x98, 0x43, 0xf1, 0x3d, 0x2f, 0xcc, 0xf1,
+	0x67, 0x75, 0x11, 0xe6, 0x98, 0x91, 0xfb, 0x42, 0xc2, 0x1c, 0x65, 0x2f, 0x9e, 0x1f, 0xe6, 0x78,
+	0x82, 0x8c, 0x9b, 0x42, 0xdf, 0xf8, 0xbc, 0x82, 0x6b, 0xad, 0x26, 0x3d, 0x14, 0x6b, 0xf1, 0xd2,
+	0xb4, 0xaf, 0xfc, 0x90, 0xaf, 0xca, 0x14, 0xa3, 0x78, 0x43, 0x75, 0x94, 0xf7, 0xbd, 0x51, 0x9a,
+	0xc5, 0x69, 0xaf, 0xa1, 0x2f, 0x1b, 0x00, 0xef, 0x70, 0xd4, 0x7c, 0x43, 0xa5, 0x30, 0xfe, 0x86,
+	0xaa, 0x2c, 0xfc, 0x7e, 0x1d, 0x2d, 0xaa, 0xa1, 0xcc, 0x77, 0x10, 0xdd, 0x40, 0xad, 0x21, 0x1b,
+	0xc6, 0xe9, 0xd8, 0x0c, 0x83, 0x09, 0x44, 0xeb, 0x87, 0x28, 0x43, 0xa6, 0x81, 0xff, 0xc0, 0xef,
+	0xa0, 0x05, 0x2f, 0x19, 0xf5, 0x16, 0xec, 0x97, 0x29, 0x3b, 0xc9, 0x88, 0x4f, 0xa5, 0xb8, 0xeb,
+	0x27, 0x23, 0xe3, 0xae, 0x9f, 0x8c, 0xe0, 0xae, 0x9f, 0x8c, 0xa0, 0x6f, 0x6e, 0xea, 0xed, 0x9b,
+	0xa7, 0x13, 0x94, 0x75, 0xdf, 0xa0, 0x44, 0x28, 0x07, 0xf1, 0xbb, 0xa8, 0x31, 0x48, 0x46, 0x2a,
+	0xd0, 0x5b, 0xb6, 0x73, 0x5b, 0xb6, 0xc3, 0x6b, 0x03, 0x83, 0xae, 0x0d, 0x25, 0x42, 0x39, 0x48,
+	0xfe, 0xb1, 0x86, 0xda, 0x92, 0x55, 0x3f, 0x04, 0x36, 0xa2, 0x83, 0xcf, 0x7c, 0x08, 0xfc, 0x26,
+	0x5a, 0x18, 0xee, 0x29, 0x4b, 0xc7, 0x07, 0x34, 0xdc, 0x4b, 0xf5, 0x80, 0x86, 0x7b, 0x29, 0xa1,
+	0x00, 0x81, 0xe4, 0x61, 0xec, 0x33, 0x15, 0xbd, 0xe1, 0x92, 0x39, 0xa0, 0x25, 0xf3, 0x22, 0xa1,
+	0x02, 0x36, 0x26, 0xbc, 0x71, 0xea, 0x09, 0x27, 0x07, 0xa8, 0xbd, 0x63, 0x0c, 0x25, 0x8c, 0xbd,
+	0x03, 0x6b, 0x28, 0x00, 0x18, 0x43, 0x81, 0x22, 0x0c, 0x05, 0xfe, 0xda, 0x8f, 0xa0, 0x4f, 0x31,
+	0x76, 0xf2, 0xe7, 0x2d, 0xb4, 0x02, 0xca, 0x64, 0xb8, 0x93, 0xbb, 0xc8, 0xd0, 0x5b, 0x43, 0xb9,
+	0xbe, 0x90, 0xea, 0xbf, 0x37, 0xfb, 0x6c, 0x7f, 0x9e, 0xb3, 0xf3, 0xeb, 0xa8, 0xed, 0x25, 0xa3,
+	0xfe, 0x30, 0xb0, 0x4c, 0x9b, 0x97, 0x8c, 0x1e, 0x04, 0x86, 0x69, 0x13, 0x65, 0x78, 0x3b, 0xcf,
+	0x7f, 0x94, 0xb5, 0xdc, 0x23, 0x73, 0xfe, 0x81, 0xe8, 0x1e, 0xd9, 0xb5, 0xdc, 0x23, 0x59, 0xcb,
+	0x3d, 0xe2, 0xa9, 0x35, 0xbe, 0x12, 0xbc, 0x39, 0xe3, 0xd3, 0x4e, 0x81, 0x8a, 0x16, 0xd7, 0xcc,
+	0xb5, 0xe3, 0x8d, 0x6a, 0xb2, 0x29, 0xc1, 0x55, 0xe9, 0x4e, 0x53, 0x82, 0x7b, 0x34, 0x23, 0x01,
+	0x3a, 0xa0, 0xc9, 0x22, 0x3f, 0x1d, 0x7b, 0x07, 0xbc, 0x0b, 0x6d, 0x33, 0x3f, 0x1d, 0x7b, 0x07,
+	0xa2, 0x07, 0xab, 0xc6, 0xfa, 0xf3, 0x0e, 0x94, 0x44, 0xa3, 0xb6, 0x7b, 0x64, 0x7d, 0xc2, 0xc9,
+	0x19, 0xdc, 0xa3, 0xe9, 0xda, 0xd0, 0x78, 0x49, 0x2c, 0xb7, 0x6d, 0xe7, 0x34, 0xdb, 0xf6, 0x4d,
+	0xb4, 0x30, 0x48, 0x46, 0x3d, 0xa4, 0xf7, 0xce, 0xc0, 0x34, 0x06, 0x03, 0x6e, 0x0c, 0x06, 0xc2,
+	0x18, 0x70, 0x5d, 0xea, 0x9e, 0xd2, 0x63, 0xce, 0x20, 0xd1, 0xb4, 0xa4, 0x99, 0xa1, 0xac, 0x99,
+	0xa1, 0x04, 0xae, 0x6a, 0x9c, 0x8a, 0xd4, 0x6d, 0x30, 0x0c, 0xd4, 0xe3, 0x31, 0x91, 0xba, 0x05,
+	0xc0, 0x48, 0xdd, 0x42, 0x11, 0x52, 0xb7, 0xf0, 0x17, 0x76, 0xa5, 0xb4, 0xc0, 0x2b, 0x86, 0x56,
+	0x28, 0xeb, 0xab, 0xb4, 0x42, 0x5a, 0x5e, 0x49, 0x00, 0x8f, 0x63, 0x93, 0x7f, 0x65, 0x5d, 0xea,
+	0xf5, 0xd9, 0x0f, 0xa8, 0xdb, 0xf6, 0x01, 0x75, 0xc1, 0xf8, 0x2e, 0xc4, 0x90, 0x7d, 0x0a, 0x8f,
+	0xe3, 0x27, 0x0d, 0xb4, 0x62, 0x57, 0x9a, 0xef, 0x40, 0x90, 0x61, 0xdb, 0xfa, 0x1c, 0x5f, 0x59,
+	0x2d, 0xcc, 0xff, 0x95, 0x55, 0xe3, 0x34, 0x5f, 0x59, 0xdd, 0x44, 0x32, 0xaa, 0x2a, 0x4e, 0xdb,
+	0xa6, 0x3e, 0x1b, 0x05, 0x2c, 0xcd, 0xcd, 0xba, 0xd9, 0x94, 0x30, 0x35, 0x06, 0x03, 0x3f, 0xb7,
+	0xcb, 0x68, 0xb9, 0x90, 0xd4, 0x32, 0x8c, 0x57, 0x49, 0x9a, 0x32, 0x5e, 0x16, 0x0e, 0xc6, 0xcb,
+	0x02, 0xe0, 0xce, 0x90, 0x8d, 0x12, 0x48, 0x50, 0x32, 0xbf, 0xd7, 0xd6, 0x97, 0xa1, 0x12, 0xd4,
+	0xdb, 0xb9, 0x84, 0xe0, 0x85, 0xa7, 0xfa, 0x0d, 0xf1, 0xcb, 0x60, 0xe8, 0x0e, 0xf8, 0x93, 0xac,
+	0x38, 0x3c, 0x74, 0x9f, 0x86, 0xe2, 0x6e, 0xb3, 0x28, 0x3f, 0xc9, 0x19, 0x8a, 0xff, 0x83, 0x20,
+	0x49, 0xc6, 0x27, 0x39, 0x36, 0x01, 0x3e, 0xc9, 0xb1, 0x11, 0xd3, 0x7b, 0xeb, 0xcc, 0xe3, 0xbd,
+	0x5d, 0xff, 0xaf, 0x36, 0x6a, 0x3c, 0xd8, 0xd9, 0xa2, 0xf8, 0x06, 0x6a, 0xdf, 0x61, 0x6e, 0x98,
+	0xef, 0x8f, 0x71, 0x19, 0x11, 0xe6, 0xff, 0xa3, 0x61, 0xf3, 0x62, 0xe9, 0x0d, 0xdb, 0xff, 0xa9,
+	0x81, 0x9c, 0xc3, 0xf7, 0xd1, 0xb2, 0x88, 0x45, 0xc9, 0xe4, 0x1c, 0x7e, 0xa5, 0xf2, 0x6b, 0x5a,
+	0x79, 0xa4, 0x6c, 0x5e, 0xaa, 0xf8, 0x57, 0x02, 0x86, 0xb4, 0xef, 0xa0, 0x2e, 0x84, 0x9c, 0xce,
+	0x26, 0xcb, 0xfc, 0xf2, 0x96, 0x9c, 0xc3, 0x0f, 0x51, 0xd7, 0xf8, 0x67, 0x08, 0x33, 0xb2, 0xac,
+	0x18, 0xd4, 0xa6, 0xa3, 0xa8, 0x27, 0xfc, 0xff, 0x04, 0x72, 0x0e, 0x7f, 0x80, 0xd0, 0x6d, 0x56,
+	0x8a, 0x9b, 0xfe, 0x68, 0xd8, 0x90, 0xf5, 0x9c, 0x31, 0xde, 0x44, 0xcb, 0x37, 0x59, 0xc8, 0x72,
+	0x76, 0x0a, 0x51, 0xa5, 0x41, 0xb0, 0xff, 0xab, 0x05, 0x97, 0xd2, 0xde, 0xf2, 0x7d, 0xfe, 0x01,
+	0xe7, 0xcb, 0xb3, 0x31, 0x45, 0x55, 0xff, 0x15, 0x73, 0x58, 0xd3, 0x29, 0x43, 0x72, 0x0e, 0xdf,
+	0x42, 0x8b, 0x8a, 0x62, 0x8b, 0xb1, 0x67, 0xe7, 0x79, 0x62, 0xde, 0x43, 0xed, 0xdb, 0x4c, 0x48,
+	0xb1, 0x02, 0x9c, 0x86, 0x88, 0xde, 0x74, 0x2a, 0xc3, 0xa8, 0xfe, 0x6b, 0x08, 0x51, 0x36, 0x8c,
+	0x0f, 0xd9, 0x33, 0x25, 0x9c, 0x3c, 0x17, 0xf7, 0xc4, 0x4a, 0xcb, 0xb0, 0x8c, 0x3d, 0x10, 0x2b,
+	0x40, 0x66, 0x2f, 0x73, 0x45, 0x44, 0x89, 0x9c, 0xc3, 0x8f, 0xd0, 0x32, 0xbf, 0x42, 0xab, 0xfb,
+	0x9b, 0x56, 0x9c, 0xaa, 0x28, 0xc2, 0xe6, 0xe5, 0x69, 0xaa, 0x7d, 0x09, 0x25, 0xe7, 0xf0, 0xb6,
+	0x98, 0x63, 0xf0, 0xad, 0xf4, 0xd8, 0x6c, 0x4f, 0xcb, 0x9e, 0xe0, 0xe9, 0x3b, 0x0f, 0x5f, 0xa7,
+	0x15, 0xfb, 0xc8, 0x99, 0xde, 0xa1, 0xc4, 0xd2, 0xdf, 0xca, 0x93, 0x89, 0x9c, 0xdb, 0x5e, 0xfb,
+	0x87, 0xcf, 0x2f, 0xd7, 0xfe, 0xf9, 0xf3, 0xcb, 0xb5, 0x7f, 0xfb, 0xfc, 0x72, 0xed, 0x0f, 0xff,
+	0xfd, 0xf2, 0xb9, 0xa7, 0x2d, 0xfe, 0x64, 0xe3, 0xc6, 0xff, 0x0d, 0x00, 0x7a, 0x3e, 0xa9, 0xc8,
+	0xe2, 0x45, 0x00, 0x00,
 }
 
-func (m *MessageResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MCARClient is the client API for MCAR service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MCARClient interface {
+	Healthy(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MessageResponse, error)
+	CreateCluster(ctx context.Context, in *ClusterCreateRequest, opts ...grpc.CallOption) (*ClusterInfoResponse, error)
+	PlanCluster(ctx context.Context, in *ClusterCreateRequest, opts ...grpc.CallOption) (*ClusterPlanResponse, error)
+	ListCluster(ctx context.Context, in *ClusterAllQryRequest, opts ...grpc.CallOption) (*ListClusterInfoResponse, error)
+	GetCluster(ctx context.Context, in *ClusterQryRequest, opts ...grpc.CallOption) (*ClusterInfoResponse, error)
+	DeleteCluster(ctx context.Context, in *ClusterQryRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	AddNode(ctx context.Context, in *NodeCreateRequest, opts ...grpc.CallOption) (*ListNodeInfoResponse, error)
+	ListNode(ctx context.Context, in *NodeAllQryRequest, opts ...grpc.CallOption) (*ListNodeInfoResponse, error)
+	GetNode(ctx context.Context, in *NodeQryRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error)
+	RemoveNode(ctx context.Context, in *NodeQryRequest, opts ...grpc.CallOption) (*StatusResponse, error)
+	ListNodeLog(ctx context.Context, in *NodeLogQryRequest, opts ...grpc.CallOption) (*ListNodeLogInfoResponse, error)
+	ApplyManifest(ctx context.Context, in *ManifestApplyRequest, opts ...grpc.CallOption) (*ManifestResultResponse, error)
+	ListSpec(ctx context.Context, in *SpecQryRequest, opts ...grpc.CallOption) (*ListSpecInfoResponse, error)
+	ListConnection(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListConnectionInfoResponse, error)
 }
 
-func (m *StatusResponse) Size() (n int) {
-	if m == nil {
-		return 0
+type mCARClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewMCARClient(cc *grpc.ClientConn) MCARClient {
+	return &mCARClient{cc}
+}
+
+func (c *mCARClient) Healthy(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*MessageResponse, error) {
+	out := new(MessageResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/Healthy", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	return out, nil
+}
+
+func (c *mCARClient) CreateCluster(ctx context.Context, in *ClusterCreateRequest, opts ...grpc.CallOption) (*ClusterInfoResponse, error) {
+	out := new(ClusterInfoResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/CreateCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Code != 0 {
-		n += 1 + sovCbmcks(uint64(m.Code))
+	return out, nil
+}
+
+func (c *mCARClient) PlanCluster(ctx context.Context, in *ClusterCreateRequest, opts ...grpc.CallOption) (*ClusterPlanResponse, error) {
+	out := new(ClusterPlanResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/PlanCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	return out, nil
+}
+
+func (c *mCARClient) ListCluster(ctx context.Context, in *ClusterAllQryRequest, opts ...grpc.CallOption) (*ListClusterInfoResponse, error) {
+	out := new(ListClusterInfoResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/ListCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *mCARClient) GetCluster(ctx context.Context, in *ClusterQryRequest, opts ...grpc.CallOption) (*ClusterInfoResponse, error) {
+	out := new(ClusterInfoResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/GetCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ClusterInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *mCARClient) DeleteCluster(ctx context.Context, in *ClusterQryRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/DeleteCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovCbmcks(uint64(l))
+	return out, nil
+}
+
+func (c *mCARClient) AddNode(ctx context.Context, in *NodeCreateRequest, opts ...grpc.CallOption) (*ListNodeInfoResponse, error) {
+	out := new(ListNodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/AddNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *mCARClient) ListNode(ctx context.Context, in *NodeAllQryRequest, opts ...grpc.CallOption) (*ListNodeInfoResponse, error) {
+	out := new(ListNodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/ListNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ListClusterInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *mCARClient) GetNode(ctx context.Context, in *NodeQryRequest, opts ...grpc.CallOption) (*NodeInfoResponse, error) {
+	out := new(NodeInfoResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/GetNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	return out, nil
+}
+
+func (c *mCARClient) RemoveNode(ctx context.Context, in *NodeQryRequest, opts ...grpc.CallOption) (*StatusResponse, error) {
+	out := new(StatusResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/RemoveNode", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovCbmcks(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *mCARClient) ListNodeLog(ctx context.Context, in *NodeLogQryRequest, opts ...grpc.CallOption) (*ListNodeLogInfoResponse, error) {
+	out := new(ListNodeLogInfoResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/ListNodeLog", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *mCARClient) ApplyManifest(ctx context.Context, in *ManifestApplyRequest, opts ...grpc.CallOption) (*ManifestResultResponse, error) {
+	out := new(ManifestResultResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/ApplyManifest", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *ClusterInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *mCARClient) ListSpec(ctx context.Context, in *SpecQryRequest, opts ...grpc.CallOption) (*ListSpecInfoResponse, error) {
+	out := new(ListSpecInfoResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/ListSpec", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	return out, nil
+}
+
+func (c *mCARClient) ListConnection(ctx context.Context, in *Empty, opts ...grpc.CallOption) (*ListConnectionInfoResponse, error) {
+	out := new(ListConnectionInfoResponse)
+	err := c.cc.Invoke(ctx, "/cbmcks.MCAR/ListConnection", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.Mcis)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	return out, nil
+}
+
+// MCARServer is the server API for MCAR service.
+type MCARServer interface {
+	Healthy(context.Context, *Empty) (*MessageResponse, error)
+	CreateCluster(context.Context, *ClusterCreateRequest) (*ClusterInfoResponse, error)
+	PlanCluster(context.Context, *ClusterCreateRequest) (*ClusterPlanResponse, error)
+	ListCluster(context.Context, *ClusterAllQryRequest) (*ListClusterInfoResponse, error)
+	GetCluster(context.Context, *ClusterQryRequest) (*ClusterInfoResponse, error)
+	DeleteCluster(context.Context, *ClusterQryRequest) (*StatusResponse, error)
+	AddNode(context.Context, *NodeCreateRequest) (*ListNodeInfoResponse, error)
+	ListNode(context.Context, *NodeAllQryRequest) (*ListNodeInfoResponse, error)
+	GetNode(context.Context, *NodeQryRequest) (*NodeInfoResponse, error)
+	RemoveNode(context.Context, *NodeQryRequest) (*StatusResponse, error)
+	ListNodeLog(context.Context, *NodeLogQryRequest) (*ListNodeLogInfoResponse, error)
+	ApplyManifest(context.Context, *ManifestApplyRequest) (*ManifestResultResponse, error)
+	ListSpec(context.Context, *SpecQryRequest) (*ListSpecInfoResponse, error)
+	ListConnection(context.Context, *Empty) (*ListConnectionInfoResponse, error)
+}
+
+// UnimplementedMCARServer can be embedded to have forward compatible implementations.
+type UnimplementedMCARServer struct {
+}
+
+func (*UnimplementedMCARServer) Healthy(ctx context.Context, req *Empty) (*MessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Healthy not implemented")
+}
+func (*UnimplementedMCARServer) CreateCluster(ctx context.Context, req *ClusterCreateRequest) (*ClusterInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCluster not implemented")
+}
+func (*UnimplementedMCARServer) PlanCluster(ctx context.Context, req *ClusterCreateRequest) (*ClusterPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PlanCluster not implemented")
+}
+func (*UnimplementedMCARServer) ListCluster(ctx context.Context, req *ClusterAllQryRequest) (*ListClusterInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCluster not implemented")
+}
+func (*UnimplementedMCARServer) GetCluster(ctx context.Context, req *ClusterQryRequest) (*ClusterInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCluster not implemented")
+}
+func (*UnimplementedMCARServer) DeleteCluster(ctx context.Context, req *ClusterQryRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCluster not implemented")
+}
+func (*UnimplementedMCARServer) AddNode(ctx context.Context, req *NodeCreateRequest) (*ListNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddNode not implemented")
+}
+func (*UnimplementedMCARServer) ListNode(ctx context.Context, req *NodeAllQryRequest) (*ListNodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNode not implemented")
+}
+func (*UnimplementedMCARServer) GetNode(ctx context.Context, req *NodeQryRequest) (*NodeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNode not implemented")
+}
+func (*UnimplementedMCARServer) RemoveNode(ctx context.Context, req *NodeQryRequest) (*StatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveNode not implemented")
+}
+func (*UnimplementedMCARServer) ListNodeLog(ctx context.Context, req *NodeLogQryRequest) (*ListNodeLogInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNodeLog not implemented")
+}
+func (*UnimplementedMCARServer) ApplyManifest(ctx context.Context, req *ManifestApplyRequest) (*ManifestResultResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyManifest not implemented")
+}
+func (*UnimplementedMCARServer) ListSpec(ctx context.Context, req *SpecQryRequest) (*ListSpecInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSpec not implemented")
+}
+func (*UnimplementedMCARServer) ListConnection(ctx context.Context, req *Empty) (*ListConnectionInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListConnection not implemented")
+}
+
+func RegisterMCARServer(s *grpc.Server, srv MCARServer) {
+	s.RegisterService(&_MCAR_serviceDesc, srv)
+}
+
+func _MCAR_Healthy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if interceptor == nil {
+		return srv.(MCARServer).Healthy(ctx, in)
 	}
-	l = len(m.K8SVersion)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/Healthy",
 	}
-	l = len(m.ClusterConfig)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).Healthy(ctx, req.(*Empty))
 	}
-	l = len(m.CpLeader)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCAR_CreateCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.NetworkCni)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if interceptor == nil {
+		return srv.(MCARServer).CreateCluster(ctx, in)
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/CreateCluster",
 	}
-	l = len(m.InstallMonAgent)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).CreateCluster(ctx, req.(*ClusterCreateRequest))
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCAR_PlanCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.CreatedTime)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if interceptor == nil {
+		return srv.(MCARServer).PlanCluster(ctx, in)
 	}
-	if len(m.Nodes) > 0 {
-		for _, e := range m.Nodes {
-			l = e.Size()
-			n += 1 + l + sovCbmcks(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/PlanCluster",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).PlanCluster(ctx, req.(*ClusterCreateRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClusterCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.Minorversion)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+func _MCAR_ListCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterAllQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Patchversion)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if interceptor == nil {
+		return srv.(MCARServer).ListCluster(ctx, in)
 	}
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovCbmcks(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/ListCluster",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).ListCluster(ctx, req.(*ClusterAllQryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClusterCreateInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+func _MCAR_GetCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ControlPlane) > 0 {
-		for _, e := range m.ControlPlane {
-			l = e.Size()
-			n += 1 + l + sovCbmcks(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(MCARServer).GetCluster(ctx, in)
 	}
-	if len(m.Worker) > 0 {
-		for _, e := range m.Worker {
-			l = e.Size()
-			n += 1 + l + sovCbmcks(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/GetCluster",
 	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovCbmcks(uint64(l))
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).GetCluster(ctx, req.(*ClusterQryRequest))
 	}
-	l = len(m.Label)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MCAR_DeleteCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClusterQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.InstallMonAgent)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if interceptor == nil {
+		return srv.(MCARServer).DeleteCluster(ctx, in)
 	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/DeleteCluster",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).DeleteCluster(ctx, req.(*ClusterQryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeConfig) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Connection)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+func _MCAR_AddNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeCreateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if m.Count != 0 {
-		n += 1 + sovCbmcks(uint64(m.Count))
+	if interceptor == nil {
+		return srv.(MCARServer).AddNode(ctx, in)
 	}
-	l = len(m.Spec)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/AddNode",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
-
-func (m *Config) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Kubernetes != nil {
-		l = m.Kubernetes.Size()
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).AddNode(ctx, req.(*NodeCreateRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *Kubernetes) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NetworkCni)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.PodCidr)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+func _MCAR_ListNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeAllQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.ServiceCidr)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if interceptor == nil {
+		return srv.(MCARServer).ListNode(ctx, in)
 	}
-	l = len(m.ServicDnsDomain)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/ListNode",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).ListNode(ctx, req.(*NodeAllQryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClusterAllQryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MCAR_GetNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if interceptor == nil {
+		return srv.(MCARServer).GetNode(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/GetNode",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).GetNode(ctx, req.(*NodeQryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClusterQryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _MCAR_RemoveNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if interceptor == nil {
+		return srv.(MCARServer).RemoveNode(ctx, in)
 	}
-	l = len(m.Cluster)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/RemoveNode",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).RemoveNode(ctx, req.(*NodeQryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ClusterStatusInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Phase)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+func _MCAR_ListNodeLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NodeLogQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if interceptor == nil {
+		return srv.(MCARServer).ListNodeLog(ctx, in)
 	}
-	l = len(m.Message)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/ListNodeLog",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).ListNodeLog(ctx, req.(*NodeLogQryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MCAR_ApplyManifest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManifestApplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovCbmcks(uint64(l))
+	if interceptor == nil {
+		return srv.(MCARServer).ApplyManifest(ctx, in)
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/ApplyManifest",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).ApplyManifest(ctx, req.(*ManifestApplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *ListNodeInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _MCAR_ListSpec_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SpecQryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if interceptor == nil {
+		return srv.(MCARServer).ListSpec(ctx, in)
 	}
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovCbmcks(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/ListSpec",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).ListSpec(ctx, req.(*SpecQryRequest))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.Credential)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.PublicIp)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.Spec)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.Csp)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.CreatedTime)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.CspLabel)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+func _MCAR_ListConnection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	l = len(m.RegionLabel)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if interceptor == nil {
+		return srv.(MCARServer).ListConnection(ctx, in)
 	}
-	l = len(m.ZoneLabel)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cbmcks.MCAR/ListConnection",
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MCARServer).ListConnection(ctx, req.(*Empty))
 	}
-	return n
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *NodeCreateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.Cluster)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+var _MCAR_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cbmcks.MCAR",
+	HandlerType: (*MCARServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Healthy",
+			Handler:    _MCAR_Healthy_Handler,
+		},
+		{
+			MethodName: "CreateCluster",
+			Handler:    _MCAR_CreateCluster_Handler,
+		},
+		{
+			MethodName: "PlanCluster",
+			Handler:    _MCAR_PlanCluster_Handler,
+		},
+		{
+			MethodName: "ListCluster",
+			Handler:    _MCAR_ListCluster_Handler,
+		},
+		{
+			MethodName: "GetCluster",
+			Handler:    _MCAR_GetCluster_Handler,
+		},
+		{
+			MethodName: "DeleteCluster",
+			Handler:    _MCAR_DeleteCluster_Handler,
+		},
+		{
+			MethodName: "AddNode",
+			Handler:    _MCAR_AddNode_Handler,
+		},
+		{
+			MethodName: "ListNode",
+			Handler:    _MCAR_ListNode_Handler,
+		},
+		{
+			MethodName: "GetNode",
+			Handler:    _MCAR_GetNode_Handler,
+		},
+		{
+			MethodName: "RemoveNode",
+			Handler:    _MCAR_RemoveNode_Handler,
+		},
+		{
+			MethodName: "ListNodeLog",
+			Handler:    _MCAR_ListNodeLog_Handler,
+		},
+		{
+			MethodName: "ApplyManifest",
+			Handler:    _MCAR_ApplyManifest_Handler,
+		},
+		{
+			MethodName: "ListSpec",
+			Handler:    _MCAR_ListSpec_Handler,
+		},
+		{
+			MethodName: "ListConnection",
+			Handler:    _MCAR_ListConnection_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cbmcks/cbmcks.proto",
 }
 
-func (m *NodeCreateInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Empty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Empty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Empty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ControlPlane) > 0 {
-		for _, e := range m.ControlPlane {
-			l = e.Size()
-			n += 1 + l + sovCbmcks(uint64(l))
-		}
-	}
-	if len(m.Worker) > 0 {
-		for _, e := range m.Worker {
-			l = e.Size()
-			n += 1 + l + sovCbmcks(uint64(l))
-		}
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *NodeAllQryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MessageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MessageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MessageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.Cluster)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *NodeQryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *StatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Namespace)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Cluster)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Node)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if m.Code != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.Code))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SpecInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClusterInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Item != nil {
-		l = m.Item.Size()
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
 	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	return n
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ListSpecInfoResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ListClusterInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ListClusterInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListClusterInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Kind)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
 	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovCbmcks(uint64(l))
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *SpecInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClusterInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.Memory)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
 	}
-	if m.Cpu != nil {
-		l = m.Cpu.Size()
-		n += 1 + l + sovCbmcks(uint64(l))
+	if len(m.CreatedTime) > 0 {
+		i -= len(m.CreatedTime)
+		copy(dAtA[i:], m.CreatedTime)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CreatedTime)))
+		i--
+		dAtA[i] = 0x6a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x62
 	}
-	return n
-}
-
-func (m *CpuInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.InstallMonAgent) > 0 {
+		i -= len(m.InstallMonAgent)
+		copy(dAtA[i:], m.InstallMonAgent)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.InstallMonAgent)))
+		i--
+		dAtA[i] = 0x5a
 	}
-	var l int
-	_ = l
-	l = len(m.Clock)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.Count)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if len(m.NetworkCni) > 0 {
+		i -= len(m.NetworkCni)
+		copy(dAtA[i:], m.NetworkCni)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.NetworkCni)))
+		i--
+		dAtA[i] = 0x4a
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.CpLeader) > 0 {
+		i -= len(m.CpLeader)
+		copy(dAtA[i:], m.CpLeader)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CpLeader)))
+		i--
+		dAtA[i] = 0x42
 	}
-	return n
+	if len(m.ClusterConfig) > 0 {
+		i -= len(m.ClusterConfig)
+		copy(dAtA[i:], m.ClusterConfig)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ClusterConfig)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.K8SVersion) > 0 {
+		i -= len(m.K8SVersion)
+		copy(dAtA[i:], m.K8SVersion)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.K8SVersion)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Mcis) > 0 {
+		i -= len(m.Mcis)
+		copy(dAtA[i:], m.Mcis)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Mcis)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *SpecQryRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ClusterCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Connectionname)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.ControlPlane)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
-	}
-	l = len(m.CpuMin)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.CpuMax)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.MemoryMin)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if len(m.Patchversion) > 0 {
+		i -= len(m.Patchversion)
+		copy(dAtA[i:], m.Patchversion)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Patchversion)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.MemoryMax)
-	if l > 0 {
-		n += 1 + l + sovCbmcks(uint64(l))
+	if len(m.Minorversion) > 0 {
+		i -= len(m.Minorversion)
+		copy(dAtA[i:], m.Minorversion)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Minorversion)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovCbmcks(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozCbmcks(x uint64) (n int) {
+func (m *ClusterPlanResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterPlanResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterPlanResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Addons) > 0 {
+		for iNdEx := len(m.Addons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Addons[iNdEx])
+			copy(dAtA[i:], m.Addons[iNdEx])
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Addons[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Resources[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Errors) > 0 {
+		for iNdEx := len(m.Errors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Errors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Version) > 0 {
+		i -= len(m.Version)
+		copy(dAtA[i:], m.Version)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Version)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanError) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanError) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanError) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Csp) > 0 {
+		i -= len(m.Csp)
+		copy(dAtA[i:], m.Csp)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Csp)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Connection) > 0 {
+		i -= len(m.Connection)
+		copy(dAtA[i:], m.Connection)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Connection)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlanResource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlanResource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlanResource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Connection) > 0 {
+		i -= len(m.Connection)
+		copy(dAtA[i:], m.Connection)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Connection)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterCreateInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterCreateInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterCreateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.InstallMonAgent) > 0 {
+		i -= len(m.InstallMonAgent)
+		copy(dAtA[i:], m.InstallMonAgent)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.InstallMonAgent)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Label) > 0 {
+		i -= len(m.Label)
+		copy(dAtA[i:], m.Label)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Label)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Worker) > 0 {
+		for iNdEx := len(m.Worker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Worker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ControlPlane) > 0 {
+		for iNdEx := len(m.ControlPlane) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ControlPlane[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ssh != nil {
+		{
+			size, err := m.Ssh.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Taints) > 0 {
+		for iNdEx := len(m.Taints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Taints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCbmcks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Csi {
+		i--
+		if m.Csi {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Count != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Connection) > 0 {
+		i -= len(m.Connection)
+		copy(dAtA[i:], m.Connection)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Connection)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SSH) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SSH) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SSH) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Keepalive != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.Keepalive))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxRetryInterval != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.MaxRetryInterval))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.RetryInterval != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.RetryInterval))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Retries != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.DialTimeout != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.DialTimeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Username) > 0 {
+		i -= len(m.Username)
+		copy(dAtA[i:], m.Username)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Username)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Port != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.Port))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Taint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Taint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Taint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Effect) > 0 {
+		i -= len(m.Effect)
+		copy(dAtA[i:], m.Effect)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Effect)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Config) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Config) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Config) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Kubernetes != nil {
+		{
+			size, err := m.Kubernetes.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Kubernetes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Kubernetes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Kubernetes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Encryption != nil {
+		{
+			size, err := m.Encryption.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Audit != nil {
+		{
+			size, err := m.Audit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Oidc != nil {
+		{
+			size, err := m.Oidc.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.Etcd != nil {
+		{
+			size, err := m.Etcd.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.CertSans) > 0 {
+		for iNdEx := len(m.CertSans) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CertSans[iNdEx])
+			copy(dAtA[i:], m.CertSans[iNdEx])
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CertSans[iNdEx])))
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if m.Kubelet != nil {
+		{
+			size, err := m.Kubelet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Scheduler != nil {
+		{
+			size, err := m.Scheduler.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ControllerManager != nil {
+		{
+			size, err := m.ControllerManager.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ApiServer != nil {
+		{
+			size, err := m.ApiServer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FeatureGates) > 0 {
+		for k := range m.FeatureGates {
+			v := m.FeatureGates[k]
+			baseI := i
+			i--
+			if v {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCbmcks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Kilo != nil {
+		{
+			size, err := m.Kilo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ServicDnsDomain) > 0 {
+		i -= len(m.ServicDnsDomain)
+		copy(dAtA[i:], m.ServicDnsDomain)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ServicDnsDomain)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ServiceCidr) > 0 {
+		i -= len(m.ServiceCidr)
+		copy(dAtA[i:], m.ServiceCidr)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ServiceCidr)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PodCidr) > 0 {
+		i -= len(m.PodCidr)
+		copy(dAtA[i:], m.PodCidr)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.PodCidr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NetworkCni) > 0 {
+		i -= len(m.NetworkCni)
+		copy(dAtA[i:], m.NetworkCni)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.NetworkCni)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Component) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Component) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Component) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExtraArgs) > 0 {
+		for k := range m.ExtraArgs {
+			v := m.ExtraArgs[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCbmcks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Kubelet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Kubelet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Kubelet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EvictionHard) > 0 {
+		for k := range m.EvictionHard {
+			v := m.EvictionHard[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCbmcks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.KubeReserved) > 0 {
+		for k := range m.KubeReserved {
+			v := m.KubeReserved[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCbmcks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SystemReserved) > 0 {
+		for k := range m.SystemReserved {
+			v := m.SystemReserved[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCbmcks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MaxPods != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.MaxPods))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Etcd) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Etcd) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Etcd) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExtraArgs) > 0 {
+		for k := range m.ExtraArgs {
+			v := m.ExtraArgs[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCbmcks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.DataDir) > 0 {
+		i -= len(m.DataDir)
+		copy(dAtA[i:], m.DataDir)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.DataDir)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Oidc) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Oidc) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Oidc) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Ca) > 0 {
+		i -= len(m.Ca)
+		copy(dAtA[i:], m.Ca)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Ca)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.GroupsPrefix) > 0 {
+		i -= len(m.GroupsPrefix)
+		copy(dAtA[i:], m.GroupsPrefix)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.GroupsPrefix)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.GroupsClaim) > 0 {
+		i -= len(m.GroupsClaim)
+		copy(dAtA[i:], m.GroupsClaim)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.GroupsClaim)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.UsernamePrefix) > 0 {
+		i -= len(m.UsernamePrefix)
+		copy(dAtA[i:], m.UsernamePrefix)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.UsernamePrefix)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.UsernameClaim) > 0 {
+		i -= len(m.UsernameClaim)
+		copy(dAtA[i:], m.UsernameClaim)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.UsernameClaim)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClientId) > 0 {
+		i -= len(m.ClientId)
+		copy(dAtA[i:], m.ClientId)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ClientId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IssuerUrl) > 0 {
+		i -= len(m.IssuerUrl)
+		copy(dAtA[i:], m.IssuerUrl)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.IssuerUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Audit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Audit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Audit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MaxSize != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.MaxSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxBackup != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.MaxBackup))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxAge != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.MaxAge))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Level) > 0 {
+		i -= len(m.Level)
+		copy(dAtA[i:], m.Level)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Level)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Encryption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Encryption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Encryption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Resources) > 0 {
+		for iNdEx := len(m.Resources) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Resources[iNdEx])
+			copy(dAtA[i:], m.Resources[iNdEx])
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Resources[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Provider)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Kilo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Kilo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Kilo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.AllowedLocationIps) > 0 {
+		for k := range m.AllowedLocationIps {
+			v := m.AllowedLocationIps[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCbmcks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Port != nil {
+		n16, err16 := github_com_gogo_protobuf_types.StdInt32MarshalTo(*m.Port, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdInt32(*m.Port):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintCbmcks(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.PersistentKeepalive != nil {
+		n17, err17 := github_com_gogo_protobuf_types.StdInt32MarshalTo(*m.PersistentKeepalive, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdInt32(*m.PersistentKeepalive):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintCbmcks(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Locations) > 0 {
+		for k := range m.Locations {
+			v := m.Locations[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCbmcks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Topology) > 0 {
+		i -= len(m.Topology)
+		copy(dAtA[i:], m.Topology)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Topology)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterAllQryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterAllQryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterAllQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterQryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterQryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClusterStatusInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClusterStatusInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClusterStatusInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListNodeInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListNodeInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListNodeInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Failed) > 0 {
+		for iNdEx := len(m.Failed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Failed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeFailure) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeFailure) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeFailure) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Ssh != nil {
+		{
+			size, err := m.Ssh.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if len(m.Connection) > 0 {
+		i -= len(m.Connection)
+		copy(dAtA[i:], m.Connection)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Connection)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.PrivateIp) > 0 {
+		i -= len(m.PrivateIp)
+		copy(dAtA[i:], m.PrivateIp)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.PrivateIp)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.HostKey) > 0 {
+		i -= len(m.HostKey)
+		copy(dAtA[i:], m.HostKey)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.HostKey)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if len(m.ProviderId) > 0 {
+		i -= len(m.ProviderId)
+		copy(dAtA[i:], m.ProviderId)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ProviderId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.ConnectionLabel) > 0 {
+		i -= len(m.ConnectionLabel)
+		copy(dAtA[i:], m.ConnectionLabel)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ConnectionLabel)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.InstanceTypeLabel) > 0 {
+		i -= len(m.InstanceTypeLabel)
+		copy(dAtA[i:], m.InstanceTypeLabel)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.InstanceTypeLabel)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Taints) > 0 {
+		for iNdEx := len(m.Taints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Taints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarintCbmcks(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintCbmcks(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ZoneLabel) > 0 {
+		i -= len(m.ZoneLabel)
+		copy(dAtA[i:], m.ZoneLabel)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ZoneLabel)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.RegionLabel) > 0 {
+		i -= len(m.RegionLabel)
+		copy(dAtA[i:], m.RegionLabel)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.RegionLabel)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.CspLabel) > 0 {
+		i -= len(m.CspLabel)
+		copy(dAtA[i:], m.CspLabel)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CspLabel)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.CreatedTime) > 0 {
+		i -= len(m.CreatedTime)
+		copy(dAtA[i:], m.CreatedTime)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CreatedTime)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Csp) > 0 {
+		i -= len(m.Csp)
+		copy(dAtA[i:], m.Csp)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Csp)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Spec) > 0 {
+		i -= len(m.Spec)
+		copy(dAtA[i:], m.Spec)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Spec)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.PublicIp) > 0 {
+		i -= len(m.PublicIp)
+		copy(dAtA[i:], m.PublicIp)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.PublicIp)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Credential) > 0 {
+		i -= len(m.Credential)
+		copy(dAtA[i:], m.Credential)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Credential)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeCreateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeCreateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeCreateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeCreateInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeCreateInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeCreateInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Worker) > 0 {
+		for iNdEx := len(m.Worker) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Worker[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ControlPlane) > 0 {
+		for iNdEx := len(m.ControlPlane) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ControlPlane[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeAllQryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeAllQryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeAllQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeQryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeQryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeLogQryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeLogQryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeLogQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Step) > 0 {
+		i -= len(m.Step)
+		copy(dAtA[i:], m.Step)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Step)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListNodeLogInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListNodeLogInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListNodeLogInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NodeLogInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NodeLogInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NodeLogInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UpdatedTime) > 0 {
+		i -= len(m.UpdatedTime)
+		copy(dAtA[i:], m.UpdatedTime)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.UpdatedTime)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Output) > 0 {
+		i -= len(m.Output)
+		copy(dAtA[i:], m.Output)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Output)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Size_ != 0 {
+		i = encodeVarintCbmcks(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Step) > 0 {
+		i -= len(m.Step)
+		copy(dAtA[i:], m.Step)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Step)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Node) > 0 {
+		i -= len(m.Node)
+		copy(dAtA[i:], m.Node)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Node)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManifestApplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestApplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestApplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Cluster) > 0 {
+		i -= len(m.Cluster)
+		copy(dAtA[i:], m.Cluster)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Cluster)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Namespace) > 0 {
+		i -= len(m.Namespace)
+		copy(dAtA[i:], m.Namespace)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Namespace)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManifestApplyInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestApplyInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestApplyInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ForceConflicts {
+		i--
+		if m.ForceConflicts {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.PruneSelector) > 0 {
+		i -= len(m.PruneSelector)
+		copy(dAtA[i:], m.PruneSelector)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.PruneSelector)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Manifest) > 0 {
+		i -= len(m.Manifest)
+		copy(dAtA[i:], m.Manifest)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Manifest)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManifestResultResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestResultResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestResultResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ManifestObjectInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ManifestObjectInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ManifestObjectInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Action) > 0 {
+		i -= len(m.Action)
+		copy(dAtA[i:], m.Action)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Action)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Resource) > 0 {
+		i -= len(m.Resource)
+		copy(dAtA[i:], m.Resource)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Resource)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Item != nil {
+		{
+			size, err := m.Item.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListSpecInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListSpecInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListSpecInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NextCursor) > 0 {
+		i -= len(m.NextCursor)
+		copy(dAtA[i:], m.NextCursor)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.NextCursor)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ConnectionName) > 0 {
+		i -= len(m.ConnectionName)
+		copy(dAtA[i:], m.ConnectionName)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ConnectionName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Gpus) > 0 {
+		for iNdEx := len(m.Gpus) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Gpus[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Arch) > 0 {
+		i -= len(m.Arch)
+		copy(dAtA[i:], m.Arch)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Arch)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Cpu != nil {
+		{
+			size, err := m.Cpu.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCbmcks(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Memory) > 0 {
+		i -= len(m.Memory)
+		copy(dAtA[i:], m.Memory)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Memory)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GpuInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GpuInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GpuInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Memory) > 0 {
+		i -= len(m.Memory)
+		copy(dAtA[i:], m.Memory)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Memory)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Model) > 0 {
+		i -= len(m.Model)
+		copy(dAtA[i:], m.Model)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Model)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Mfr) > 0 {
+		i -= len(m.Mfr)
+		copy(dAtA[i:], m.Mfr)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Mfr)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Count) > 0 {
+		i -= len(m.Count)
+		copy(dAtA[i:], m.Count)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Count)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CpuInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CpuInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CpuInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Count) > 0 {
+		i -= len(m.Count)
+		copy(dAtA[i:], m.Count)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Count)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Clock) > 0 {
+		i -= len(m.Clock)
+		copy(dAtA[i:], m.Clock)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Clock)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SpecQryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SpecQryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SpecQryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Limit) > 0 {
+		i -= len(m.Limit)
+		copy(dAtA[i:], m.Limit)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Limit)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if len(m.Sort) > 0 {
+		i -= len(m.Sort)
+		copy(dAtA[i:], m.Sort)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Sort)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Gpu) > 0 {
+		i -= len(m.Gpu)
+		copy(dAtA[i:], m.Gpu)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Gpu)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Arch) > 0 {
+		i -= len(m.Arch)
+		copy(dAtA[i:], m.Arch)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Arch)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ClockMax) > 0 {
+		i -= len(m.ClockMax)
+		copy(dAtA[i:], m.ClockMax)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ClockMax)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.ClockMin) > 0 {
+		i -= len(m.ClockMin)
+		copy(dAtA[i:], m.ClockMin)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ClockMin)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.MemoryMax) > 0 {
+		i -= len(m.MemoryMax)
+		copy(dAtA[i:], m.MemoryMax)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.MemoryMax)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.MemoryMin) > 0 {
+		i -= len(m.MemoryMin)
+		copy(dAtA[i:], m.MemoryMin)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.MemoryMin)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.CpuMax) > 0 {
+		i -= len(m.CpuMax)
+		copy(dAtA[i:], m.CpuMax)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CpuMax)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CpuMin) > 0 {
+		i -= len(m.CpuMin)
+		copy(dAtA[i:], m.CpuMin)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CpuMin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ControlPlane) > 0 {
+		i -= len(m.ControlPlane)
+		copy(dAtA[i:], m.ControlPlane)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.ControlPlane)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Connectionname) > 0 {
+		i -= len(m.Connectionname)
+		copy(dAtA[i:], m.Connectionname)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Connectionname)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ListConnectionInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ListConnectionInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ListConnectionInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintCbmcks(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Kind) > 0 {
+		i -= len(m.Kind)
+		copy(dAtA[i:], m.Kind)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Kind)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConnectionInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConnectionInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConnectionInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Message) > 0 {
+		i -= len(m.Message)
+		copy(dAtA[i:], m.Message)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Message)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ImageResolvable {
+		i--
+		if m.ImageResolvable {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Supported {
+		i--
+		if m.Supported {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CredentialName) > 0 {
+		i -= len(m.CredentialName)
+		copy(dAtA[i:], m.CredentialName)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.CredentialName)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RegionName) > 0 {
+		i -= len(m.RegionName)
+		copy(dAtA[i:], m.RegionName)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.RegionName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Zone) > 0 {
+		i -= len(m.Zone)
+		copy(dAtA[i:], m.Zone)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Zone)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Region) > 0 {
+		i -= len(m.Region)
+		copy(dAtA[i:], m.Region)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Region)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Csp) > 0 {
+		i -= len(m.Csp)
+		copy(dAtA[i:], m.Csp)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Csp)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintCbmcks(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintCbmcks(dAtA []byte, offset int, v uint64) int {
+	offset -= sovCbmcks(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Empty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *MessageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *StatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Code != 0 {
+		n += 1 + sovCbmcks(uint64(m.Code))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListClusterInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Mcis)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.K8SVersion)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.ClusterConfig)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.CpLeader)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.NetworkCni)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.InstallMonAgent)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.CreatedTime)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Minorversion)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Patchversion)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterPlanResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Version)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Valid {
+		n += 2
+	}
+	if len(m.Errors) > 0 {
+		for _, e := range m.Errors {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if len(m.Resources) > 0 {
+		for _, e := range m.Resources {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if len(m.Addons) > 0 {
+		for _, s := range m.Addons {
+			l = len(s)
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PlanError) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PlanNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Connection)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Csp)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PlanResource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Connection)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterCreateInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.ControlPlane) > 0 {
+		for _, e := range m.ControlPlane {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if len(m.Worker) > 0 {
+		for _, e := range m.Worker {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Label)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.InstallMonAgent)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Connection)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Count != 0 {
+		n += 1 + sovCbmcks(uint64(m.Count))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Csi {
+		n += 2
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCbmcks(uint64(len(k))) + 1 + len(v) + sovCbmcks(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCbmcks(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Taints) > 0 {
+		for _, e := range m.Taints {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.Ssh != nil {
+		l = m.Ssh.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SSH) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Port != 0 {
+		n += 1 + sovCbmcks(uint64(m.Port))
+	}
+	l = len(m.Username)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.DialTimeout != 0 {
+		n += 1 + sovCbmcks(uint64(m.DialTimeout))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovCbmcks(uint64(m.Retries))
+	}
+	if m.RetryInterval != 0 {
+		n += 1 + sovCbmcks(uint64(m.RetryInterval))
+	}
+	if m.MaxRetryInterval != 0 {
+		n += 1 + sovCbmcks(uint64(m.MaxRetryInterval))
+	}
+	if m.Keepalive != 0 {
+		n += 1 + sovCbmcks(uint64(m.Keepalive))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Taint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Effect)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Config) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Kubernetes != nil {
+		l = m.Kubernetes.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Kubernetes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NetworkCni)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.PodCidr)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.ServiceCidr)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.ServicDnsDomain)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Kilo != nil {
+		l = m.Kilo.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.FeatureGates) > 0 {
+		for k, v := range m.FeatureGates {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCbmcks(uint64(len(k))) + 1 + 1
+			n += mapEntrySize + 1 + sovCbmcks(uint64(mapEntrySize))
+		}
+	}
+	if m.ApiServer != nil {
+		l = m.ApiServer.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.ControllerManager != nil {
+		l = m.ControllerManager.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Scheduler != nil {
+		l = m.Scheduler.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Kubelet != nil {
+		l = m.Kubelet.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.CertSans) > 0 {
+		for _, s := range m.CertSans {
+			l = len(s)
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.Etcd != nil {
+		l = m.Etcd.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Oidc != nil {
+		l = m.Oidc.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Audit != nil {
+		l = m.Audit.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Encryption != nil {
+		l = m.Encryption.Size()
+		n += 2 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Component) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ExtraArgs) > 0 {
+		for k, v := range m.ExtraArgs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCbmcks(uint64(len(k))) + 1 + len(v) + sovCbmcks(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCbmcks(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Kubelet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxPods != 0 {
+		n += 1 + sovCbmcks(uint64(m.MaxPods))
+	}
+	if len(m.SystemReserved) > 0 {
+		for k, v := range m.SystemReserved {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCbmcks(uint64(len(k))) + 1 + len(v) + sovCbmcks(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCbmcks(uint64(mapEntrySize))
+		}
+	}
+	if len(m.KubeReserved) > 0 {
+		for k, v := range m.KubeReserved {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCbmcks(uint64(len(k))) + 1 + len(v) + sovCbmcks(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCbmcks(uint64(mapEntrySize))
+		}
+	}
+	if len(m.EvictionHard) > 0 {
+		for k, v := range m.EvictionHard {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCbmcks(uint64(len(k))) + 1 + len(v) + sovCbmcks(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCbmcks(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Etcd) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DataDir)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.ExtraArgs) > 0 {
+		for k, v := range m.ExtraArgs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCbmcks(uint64(len(k))) + 1 + len(v) + sovCbmcks(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCbmcks(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Oidc) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IssuerUrl)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.ClientId)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.UsernameClaim)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.UsernamePrefix)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.GroupsClaim)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.GroupsPrefix)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Ca)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Audit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Level)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.MaxAge != 0 {
+		n += 1 + sovCbmcks(uint64(m.MaxAge))
+	}
+	if m.MaxBackup != 0 {
+		n += 1 + sovCbmcks(uint64(m.MaxBackup))
+	}
+	if m.MaxSize != 0 {
+		n += 1 + sovCbmcks(uint64(m.MaxSize))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Encryption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.Resources) > 0 {
+		for _, s := range m.Resources {
+			l = len(s)
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *Kilo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Topology)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.Locations) > 0 {
+		for k, v := range m.Locations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCbmcks(uint64(len(k))) + 1 + len(v) + sovCbmcks(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCbmcks(uint64(mapEntrySize))
+		}
+	}
+	if m.PersistentKeepalive != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdInt32(*m.PersistentKeepalive)
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Port != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdInt32(*m.Port)
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.AllowedLocationIps) > 0 {
+		for k, v := range m.AllowedLocationIps {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCbmcks(uint64(len(k))) + 1 + len(v) + sovCbmcks(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCbmcks(uint64(mapEntrySize))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterAllQryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterQryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ClusterStatusInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListNodeInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if len(m.Failed) > 0 {
+		for _, e := range m.Failed {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeFailure) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Credential)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.PublicIp)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Spec)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Csp)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.CreatedTime)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.CspLabel)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.RegionLabel)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.ZoneLabel)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovCbmcks(uint64(len(k))) + 1 + len(v) + sovCbmcks(uint64(len(v)))
+			n += mapEntrySize + 1 + sovCbmcks(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Taints) > 0 {
+		for _, e := range m.Taints {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	l = len(m.InstanceTypeLabel)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.ConnectionLabel)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.ProviderId)
+	if l > 0 {
+		n += 2 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.HostKey)
+	if l > 0 {
+		n += 2 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.PrivateIp)
+	if l > 0 {
+		n += 2 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Connection)
+	if l > 0 {
+		n += 2 + l + sovCbmcks(uint64(l))
+	}
+	if m.Ssh != nil {
+		l = m.Ssh.Size()
+		n += 2 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeCreateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeCreateInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ControlPlane) > 0 {
+		for _, e := range m.ControlPlane {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if len(m.Worker) > 0 {
+		for _, e := range m.Worker {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeAllQryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeQryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeLogQryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Step)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListNodeLogInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *NodeLogInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Node)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Step)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 1 + sovCbmcks(uint64(m.Size_))
+	}
+	if m.Truncated {
+		n += 2
+	}
+	l = len(m.Output)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.UpdatedTime)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestApplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Cluster)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestApplyInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Manifest)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.PruneSelector)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.ForceConflicts {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestResultResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ManifestObjectInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resource)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Action)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Item != nil {
+		l = m.Item.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListSpecInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	l = len(m.ConnectionName)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.NextCursor)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Memory)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Cpu != nil {
+		l = m.Cpu.Size()
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Arch)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.Gpus) > 0 {
+		for _, e := range m.Gpus {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GpuInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Count)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Mfr)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Model)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Memory)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CpuInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Clock)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Count)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SpecQryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Connectionname)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.ControlPlane)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.CpuMin)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.CpuMax)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.MemoryMin)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.MemoryMax)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.ClockMin)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.ClockMax)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Arch)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Gpu)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Sort)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Limit)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ListConnectionInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Kind)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovCbmcks(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ConnectionInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Csp)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Region)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.Zone)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.RegionName)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	l = len(m.CredentialName)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.Supported {
+		n += 2
+	}
+	if m.ImageResolvable {
+		n += 2
+	}
+	l = len(m.Message)
+	if l > 0 {
+		n += 1 + l + sovCbmcks(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovCbmcks(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozCbmcks(x uint64) (n int) {
 	return sovCbmcks(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Empty) Unmarshal(dAtA []byte) error {
+func (m *Empty) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MessageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MessageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			m.Code = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Code |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &ClusterInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListClusterInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListClusterInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListClusterInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &ClusterInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Status == nil {
+				m.Status = &ClusterStatusInfo{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mcis", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mcis = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field K8SVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.K8SVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClusterConfig", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClusterConfig = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpLeader", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CpLeader = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkCni", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkCni = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallMonAgent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstallMonAgent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &NodeInfo{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCreateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minorversion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minorversion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patchversion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patchversion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &ClusterCreateInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterPlanResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterPlanResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterPlanResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Errors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Errors = append(m.Errors, &PlanError{})
+			if err := m.Errors[len(m.Errors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, &PlanNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, &PlanResource{})
+			if err := m.Resources[len(m.Resources)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Addons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Addons = append(m.Addons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanError) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanError: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanError: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Csp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Csp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Region = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Zone = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PlanResource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PlanResource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PlanResource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterCreateInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterCreateInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterCreateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlane", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ControlPlane = append(m.ControlPlane, &NodeConfig{})
+			if err := m.ControlPlane[len(m.ControlPlane)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Worker = append(m.Worker, &NodeConfig{})
+			if err := m.Worker[len(m.Worker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &Config{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Label = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstallMonAgent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstallMonAgent = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Connection = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Csi", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Csi = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCbmcks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCbmcks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Taints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Taints = append(m.Taints, &Taint{})
+			if err := m.Taints[len(m.Taints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ssh", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ssh == nil {
+				m.Ssh = &SSH{}
+			}
+			if err := m.Ssh.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SSH) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SSH: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SSH: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			m.Port = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Port |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Username", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Username = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DialTimeout", wireType)
+			}
+			m.DialTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DialTimeout |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryInterval", wireType)
+			}
+			m.RetryInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryInterval |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetryInterval", wireType)
+			}
+			m.MaxRetryInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRetryInterval |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keepalive", wireType)
+			}
+			m.Keepalive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Keepalive |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Taint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Taint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Taint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effect", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Effect = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Config) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kubernetes == nil {
+				m.Kubernetes = &Kubernetes{}
+			}
+			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Kubernetes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Kubernetes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Kubernetes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NetworkCni", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NetworkCni = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PodCidr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PodCidr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServiceCidr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServiceCidr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ServicDnsDomain", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ServicDnsDomain = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kilo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kilo == nil {
+				m.Kilo = &Kilo{}
+			}
+			if err := m.Kilo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeatureGates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FeatureGates == nil {
+				m.FeatureGates = make(map[string]bool)
+			}
+			var mapkey string
+			var mapvalue bool
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCbmcks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapvaluetemp int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvaluetemp |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					mapvalue = bool(mapvaluetemp != 0)
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCbmcks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.FeatureGates[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApiServer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApiServer == nil {
+				m.ApiServer = &Component{}
+			}
+			if err := m.ApiServer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerManager", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ControllerManager == nil {
+				m.ControllerManager = &Component{}
+			}
+			if err := m.ControllerManager.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Scheduler == nil {
+				m.Scheduler = &Component{}
+			}
+			if err := m.Scheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kubelet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Kubelet == nil {
+				m.Kubelet = &Kubelet{}
+			}
+			if err := m.Kubelet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CertSans", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CertSans = append(m.CertSans, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Etcd", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Etcd == nil {
+				m.Etcd = &Etcd{}
+			}
+			if err := m.Etcd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Oidc", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Oidc == nil {
+				m.Oidc = &Oidc{}
+			}
+			if err := m.Oidc.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Audit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Audit == nil {
+				m.Audit = &Audit{}
+			}
+			if err := m.Audit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Encryption", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Encryption == nil {
+				m.Encryption = &Encryption{}
+			}
+			if err := m.Encryption.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Component) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Component: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Component: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraArgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtraArgs == nil {
+				m.ExtraArgs = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCbmcks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCbmcks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExtraArgs[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Kubelet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Kubelet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Kubelet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPods", wireType)
+			}
+			m.MaxPods = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPods |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SystemReserved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SystemReserved == nil {
+				m.SystemReserved = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCbmcks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCbmcks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.SystemReserved[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KubeReserved", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KubeReserved == nil {
+				m.KubeReserved = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCbmcks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCbmcks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.KubeReserved[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvictionHard", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvictionHard == nil {
+				m.EvictionHard = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCbmcks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCbmcks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EvictionHard[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Etcd) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Etcd: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Etcd: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataDir", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataDir = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraArgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtraArgs == nil {
+				m.ExtraArgs = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCbmcks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCbmcks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ExtraArgs[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Oidc) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Oidc: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Oidc: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernameClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsernameClaim = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsernamePrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsernamePrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsClaim", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupsClaim = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GroupsPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GroupsPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ca", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ca = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Audit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Audit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Audit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Level", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Level = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAge", wireType)
+			}
+			m.MaxAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAge |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBackup", wireType)
+			}
+			m.MaxBackup = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBackup |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSize", wireType)
+			}
+			m.MaxSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSize |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Encryption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Encryption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Encryption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resources = append(m.Resources, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Kilo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Kilo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Kilo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Topology", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Topology = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Locations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Locations == nil {
+				m.Locations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCbmcks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCbmcks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Locations[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersistentKeepalive", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PersistentKeepalive == nil {
+				m.PersistentKeepalive = new(int32)
+			}
+			if err := github_com_gogo_protobuf_types.StdInt32Unmarshal(m.PersistentKeepalive, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Port == nil {
+				m.Port = new(int32)
+			}
+			if err := github_com_gogo_protobuf_types.StdInt32Unmarshal(m.Port, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedLocationIps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AllowedLocationIps == nil {
+				m.AllowedLocationIps = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCbmcks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCbmcks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.AllowedLocationIps[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterAllQryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4348,25 +15217,57 @@ func (m *Empty) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowCbmcks
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterAllQryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterAllQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Empty: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Empty: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbmcks(dAtA[iNdEx:])
@@ -4389,7 +15290,7 @@ func (m *Empty) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MessageResponse) Unmarshal(dAtA []byte) error {
+func (m *ClusterQryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4412,15 +15313,15 @@ func (m *MessageResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MessageResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterQryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MessageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4448,7 +15349,39 @@ func (m *MessageResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4472,7 +15405,7 @@ func (m *MessageResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StatusResponse) Unmarshal(dAtA []byte) error {
+func (m *ClusterStatusInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4495,15 +15428,15 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StatusResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ClusterStatusInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ClusterStatusInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4531,13 +15464,13 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Phase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.Code = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -4547,11 +15480,24 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Code |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
@@ -4606,7 +15552,94 @@ func (m *StatusResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *NodeInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &NodeInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListNodeInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4625,19 +15658,85 @@ func (m *ClusterInfoResponse) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListNodeInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, &NodeInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Failed", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4664,10 +15763,8 @@ func (m *ClusterInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &ClusterInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Failed = append(m.Failed, &NodeFailure{})
+			if err := m.Failed[len(m.Failed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4693,7 +15790,7 @@ func (m *ClusterInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListClusterInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *NodeFailure) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4716,15 +15813,15 @@ func (m *ListClusterInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListClusterInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeFailure: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListClusterInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeFailure: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4752,13 +15849,13 @@ func (m *ListClusterInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -4768,25 +15865,23 @@ func (m *ListClusterInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &ClusterInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4810,7 +15905,7 @@ func (m *ListClusterInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
+func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4833,10 +15928,10 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4905,9 +16000,9 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -4917,31 +16012,219 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &ClusterStatusInfo{}
+			m.Credential = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.PublicIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Spec = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Csp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Csp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CspLabel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CspLabel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Mcis", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionLabel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4969,11 +16252,11 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Mcis = string(dAtA[iNdEx:postIndex])
+			m.RegionLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ZoneLabel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5001,13 +16284,13 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.ZoneLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field K8SVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -5017,29 +16300,124 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.K8SVersion = string(dAtA[iNdEx:postIndex])
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowCbmcks
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowCbmcks
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipCbmcks(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthCbmcks
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 7:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClusterConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Taints", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -5049,27 +16427,29 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClusterConfig = string(dAtA[iNdEx:postIndex])
+			m.Taints = append(m.Taints, &Taint{})
+			if err := m.Taints[len(m.Taints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpLeader", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InstanceTypeLabel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5097,11 +16477,11 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CpLeader = string(dAtA[iNdEx:postIndex])
+			m.InstanceTypeLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkCni", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionLabel", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5129,11 +16509,11 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkCni = string(dAtA[iNdEx:postIndex])
+			m.ConnectionLabel = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProviderId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5161,11 +16541,11 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.ProviderId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallMonAgent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HostKey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5193,11 +16573,11 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstallMonAgent = string(dAtA[iNdEx:postIndex])
+			m.HostKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 18:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PrivateIp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5225,11 +16605,11 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.PrivateIp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5257,11 +16637,11 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedTime = string(dAtA[iNdEx:postIndex])
+			m.Connection = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 14:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ssh", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5288,8 +16668,10 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Nodes = append(m.Nodes, &NodeInfo{})
-			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Ssh == nil {
+				m.Ssh = &SSH{}
+			}
+			if err := m.Ssh.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5315,7 +16697,7 @@ func (m *ClusterInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *NodeCreateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5338,10 +16720,10 @@ func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeCreateRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5378,7 +16760,7 @@ func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Minorversion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5406,41 +16788,9 @@ func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Minorversion = string(dAtA[iNdEx:postIndex])
+			m.Cluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patchversion", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbmcks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbmcks
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbmcks
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Patchversion = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
@@ -5470,7 +16820,7 @@ func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Item == nil {
-				m.Item = &ClusterCreateInfo{}
+				m.Item = &NodeCreateInfo{}
 			}
 			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5498,7 +16848,7 @@ func (m *ClusterCreateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterCreateInfo) Unmarshal(dAtA []byte) error {
+func (m *NodeCreateInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5521,17 +16871,17 @@ func (m *ClusterCreateInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterCreateInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeCreateInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterCreateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeCreateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlane", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -5541,27 +16891,29 @@ func (m *ClusterCreateInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ControlPlane = append(m.ControlPlane, &NodeConfig{})
+			if err := m.ControlPlane[len(m.ControlPlane)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlane", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5588,16 +16940,67 @@ func (m *ClusterCreateInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ControlPlane = append(m.ControlPlane, &NodeConfig{})
-			if err := m.ControlPlane[len(m.ControlPlane)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Worker = append(m.Worker, &NodeConfig{})
+			if err := m.Worker[len(m.Worker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeAllQryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeAllQryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeAllQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -5607,31 +17010,29 @@ func (m *ClusterCreateInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Worker = append(m.Worker, &NodeConfig{})
-			if err := m.Worker[len(m.Worker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -5641,31 +17042,78 @@ func (m *ClusterCreateInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &Config{}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NodeQryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NodeQryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NodeQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Label", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5693,11 +17141,11 @@ func (m *ClusterCreateInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Label = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InstallMonAgent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5725,11 +17173,11 @@ func (m *ClusterCreateInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InstallMonAgent = string(dAtA[iNdEx:postIndex])
+			m.Cluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5757,7 +17205,7 @@ func (m *ClusterCreateInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Node = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5781,7 +17229,7 @@ func (m *ClusterCreateInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeConfig) Unmarshal(dAtA []byte) error {
+func (m *NodeLogQryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5804,15 +17252,15 @@ func (m *NodeConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeLogQryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeLogQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5840,13 +17288,13 @@ func (m *NodeConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Connection = string(dAtA[iNdEx:postIndex])
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
 			}
-			m.Count = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -5856,14 +17304,27 @@ func (m *NodeConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Count |= int32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cluster = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5891,7 +17352,39 @@ func (m *NodeConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Spec = string(dAtA[iNdEx:postIndex])
+			m.Node = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Step = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5915,7 +17408,7 @@ func (m *NodeConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Config) Unmarshal(dAtA []byte) error {
+func (m *ListNodeLogInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5938,15 +17431,47 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Config: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListNodeLogInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Config: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListNodeLogInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kubernetes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5973,10 +17498,8 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Kubernetes == nil {
-				m.Kubernetes = &Kubernetes{}
-			}
-			if err := m.Kubernetes.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &NodeLogInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6002,7 +17525,7 @@ func (m *Config) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Kubernetes) Unmarshal(dAtA []byte) error {
+func (m *NodeLogInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6025,15 +17548,15 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Kubernetes: wiretype end group for non-group")
+			return fmt.Errorf("proto: NodeLogInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Kubernetes: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: NodeLogInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NetworkCni", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6061,11 +17584,11 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NetworkCni = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PodCidr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6093,11 +17616,11 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PodCidr = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServiceCidr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6125,11 +17648,11 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServiceCidr = string(dAtA[iNdEx:postIndex])
+			m.Node = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ServicDnsDomain", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Step", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6157,62 +17680,82 @@ func (m *Kubernetes) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ServicDnsDomain = string(dAtA[iNdEx:postIndex])
+			m.Step = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbmcks(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbmcks
+			m.Size_ = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Size_ |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterAllQryRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbmcks
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.Truncated = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterAllQryRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterAllQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Output = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedTime", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6240,7 +17783,7 @@ func (m *ClusterAllQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.UpdatedTime = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6264,7 +17807,7 @@ func (m *ClusterAllQryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterQryRequest) Unmarshal(dAtA []byte) error {
+func (m *ManifestApplyRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6287,10 +17830,10 @@ func (m *ClusterQryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterQryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestApplyRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManifestApplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6357,6 +17900,42 @@ func (m *ClusterQryRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Cluster = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Item == nil {
+				m.Item = &ManifestApplyInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbmcks(dAtA[iNdEx:])
@@ -6379,7 +17958,7 @@ func (m *ClusterQryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ClusterStatusInfo) Unmarshal(dAtA []byte) error {
+func (m *ManifestApplyInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6402,15 +17981,15 @@ func (m *ClusterStatusInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterStatusInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestApplyInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterStatusInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManifestApplyInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Manifest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6438,11 +18017,11 @@ func (m *ClusterStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
+			m.Manifest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PruneSelector", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6470,13 +18049,13 @@ func (m *ClusterStatusInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.PruneSelector = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceConflicts", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -6486,24 +18065,12 @@ func (m *ClusterStatusInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthCbmcks
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbmcks
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ForceConflicts = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCbmcks(dAtA[iNdEx:])
@@ -6526,7 +18093,7 @@ func (m *ClusterStatusInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *ManifestResultResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6549,15 +18116,47 @@ func (m *NodeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestResultResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManifestResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6584,10 +18183,8 @@ func (m *NodeInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &NodeInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, &ManifestObjectInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6613,7 +18210,7 @@ func (m *NodeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ListNodeInfoResponse) Unmarshal(dAtA []byte) error {
+func (m *ManifestObjectInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6636,15 +18233,15 @@ func (m *ListNodeInfoResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ListNodeInfoResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ManifestObjectInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListNodeInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ManifestObjectInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resource", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6672,13 +18269,13 @@ func (m *ListNodeInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.Resource = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -6688,25 +18285,87 @@ func (m *ListNodeInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &NodeInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Action = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6730,7 +18389,7 @@ func (m *ListNodeInfoResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeInfo) Unmarshal(dAtA []byte) error {
+func (m *SpecInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6753,17 +18412,17 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpecInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpecInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -6773,25 +18432,80 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+			if m.Item == nil {
+				m.Item = &SpecInfo{}
+			}
+			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ListSpecInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ListSpecInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ListSpecInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
@@ -6823,11 +18537,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -6837,27 +18551,29 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Credential = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, &SpecInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PublicIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6885,11 +18601,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PublicIp = string(dAtA[iNdEx:postIndex])
+			m.ConnectionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextCursor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6917,43 +18633,62 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.NextCursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbmcks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCbmcks(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbmcks
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SpecInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCbmcks
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Spec = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SpecInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SpecInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Csp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6981,11 +18716,11 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Csp = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7013,13 +18748,13 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CreatedTime = string(dAtA[iNdEx:postIndex])
+			m.Memory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CspLabel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -7029,27 +18764,31 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CspLabel = string(dAtA[iNdEx:postIndex])
+			if m.Cpu == nil {
+				m.Cpu = &CpuInfo{}
+			}
+			if err := m.Cpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegionLabel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7077,13 +18816,13 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RegionLabel = string(dAtA[iNdEx:postIndex])
+			m.Arch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ZoneLabel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpus", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -7093,23 +18832,25 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ZoneLabel = string(dAtA[iNdEx:postIndex])
+			m.Gpus = append(m.Gpus, &GpuInfo{})
+			if err := m.Gpus[len(m.Gpus)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7133,7 +18874,7 @@ func (m *NodeInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeCreateRequest) Unmarshal(dAtA []byte) error {
+func (m *GpuInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7156,15 +18897,15 @@ func (m *NodeCreateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeCreateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GpuInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeCreateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GpuInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7192,11 +18933,11 @@ func (m *NodeCreateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Count = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Mfr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7218,106 +18959,19 @@ func (m *NodeCreateRequest) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthCbmcks
 			}
 			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbmcks
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Cluster = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowCbmcks
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthCbmcks
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthCbmcks
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Item == nil {
-				m.Item = &NodeCreateInfo{}
-			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbmcks(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbmcks
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *NodeCreateInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbmcks
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: NodeCreateInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeCreateInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mfr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlane", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Model", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -7327,31 +18981,29 @@ func (m *NodeCreateInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ControlPlane = append(m.ControlPlane, &NodeConfig{})
-			if err := m.ControlPlane[len(m.ControlPlane)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Model = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Worker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -7361,25 +19013,23 @@ func (m *NodeCreateInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Worker = append(m.Worker, &NodeConfig{})
-			if err := m.Worker[len(m.Worker)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Memory = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7403,7 +19053,7 @@ func (m *NodeCreateInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeAllQryRequest) Unmarshal(dAtA []byte) error {
+func (m *CpuInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7426,15 +19076,15 @@ func (m *NodeAllQryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeAllQryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CpuInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeAllQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CpuInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7462,11 +19112,11 @@ func (m *NodeAllQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Clock = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7494,7 +19144,7 @@ func (m *NodeAllQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cluster = string(dAtA[iNdEx:postIndex])
+			m.Count = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7518,7 +19168,7 @@ func (m *NodeAllQryRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *NodeQryRequest) Unmarshal(dAtA []byte) error {
+func (m *SpecQryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7541,15 +19191,15 @@ func (m *NodeQryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: NodeQryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: SpecQryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: NodeQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SpecQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Connectionname", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7577,11 +19227,11 @@ func (m *NodeQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.Connectionname = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cluster", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlane", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7609,11 +19259,11 @@ func (m *NodeQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Cluster = string(dAtA[iNdEx:postIndex])
+			m.ControlPlane = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Node", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuMin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7641,64 +19291,45 @@ func (m *NodeQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Node = string(dAtA[iNdEx:postIndex])
+			m.CpuMin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbmcks(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CpuMax", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbmcks
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpecInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbmcks
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpecInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpecInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.CpuMax = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Item", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryMin", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -7708,82 +19339,91 @@ func (m *SpecInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Item == nil {
-				m.Item = &SpecInfo{}
+			m.MemoryMin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemoryMax", wireType)
 			}
-			if err := m.Item.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbmcks(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ListSpecInfoResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbmcks
+			m.MemoryMax = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockMin", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ListSpecInfoResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ListSpecInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.ClockMin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClockMax", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7811,13 +19451,13 @@ func (m *ListSpecInfoResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			m.ClockMax = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Arch", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -7827,78 +19467,57 @@ func (m *ListSpecInfoResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, &SpecInfo{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Arch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipCbmcks(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gpu", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthCbmcks
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *SpecInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowCbmcks
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SpecInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpecInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Gpu = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
@@ -7930,9 +19549,9 @@ func (m *SpecInfo) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Memory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sort", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7960,13 +19579,13 @@ func (m *SpecInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Memory = string(dAtA[iNdEx:postIndex])
+			m.Sort = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Cpu", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -7976,27 +19595,55 @@ func (m *SpecInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Cpu == nil {
-				m.Cpu = &CpuInfo{}
+			m.Limit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			if err := m.Cpu.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
 			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8020,7 +19667,7 @@ func (m *SpecInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CpuInfo) Unmarshal(dAtA []byte) error {
+func (m *ListConnectionInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8043,15 +19690,15 @@ func (m *CpuInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CpuInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: ListConnectionInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CpuInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ListConnectionInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Clock", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8079,13 +19726,13 @@ func (m *CpuInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Clock = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowCbmcks
@@ -8095,23 +19742,25 @@ func (m *CpuInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthCbmcks
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthCbmcks
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Count = string(dAtA[iNdEx:postIndex])
+			m.Items = append(m.Items, &ConnectionInfo{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8135,7 +19784,7 @@ func (m *CpuInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SpecQryRequest) Unmarshal(dAtA []byte) error {
+func (m *ConnectionInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8158,15 +19807,15 @@ func (m *SpecQryRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SpecQryRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ConnectionInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SpecQryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ConnectionInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Connectionname", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8194,11 +19843,11 @@ func (m *SpecQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Connectionname = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ControlPlane", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Csp", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8226,11 +19875,11 @@ func (m *SpecQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ControlPlane = string(dAtA[iNdEx:postIndex])
+			m.Csp = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8258,11 +19907,11 @@ func (m *SpecQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CpuMin = string(dAtA[iNdEx:postIndex])
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CpuMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Zone", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8290,11 +19939,11 @@ func (m *SpecQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CpuMax = string(dAtA[iNdEx:postIndex])
+			m.Zone = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryMin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8322,11 +19971,11 @@ func (m *SpecQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemoryMin = string(dAtA[iNdEx:postIndex])
+			m.RegionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemoryMax", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8354,7 +20003,79 @@ func (m *SpecQryRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MemoryMax = string(dAtA[iNdEx:postIndex])
+			m.CredentialName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supported", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Supported = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageResolvable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ImageResolvable = bool(v != 0)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCbmcks
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCbmcks
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
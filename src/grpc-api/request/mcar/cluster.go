@@ -41,6 +41,33 @@ func (r *MCARRequest) CreateCluster() (string, error) {
 	return gc.ConvertToOutput(r.OutType, &resp.Item)
 }
 
+// PlanCluster - Cluster 생성 계획 (dry-run)
+func (r *MCARRequest) PlanCluster() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.ClusterCreateRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err := r.Client.PlanCluster(ctx, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
+
 // ListCluster - Cluster 목록
 func (r *MCARRequest) ListCluster() (string, error) {
 	// 입력데이터 검사
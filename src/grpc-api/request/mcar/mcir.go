@@ -34,3 +34,18 @@ func (r *MCARRequest) ListSpec() (string, error) {
 	// 결과값 마샬링
 	return gc.ConvertToOutput(r.OutType, &resp)
 }
+
+// ListConnection - Connection 목록
+func (r *MCARRequest) ListConnection() (string, error) {
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err := r.Client.ListConnection(ctx, &pb.Empty{})
+	if err != nil {
+		return "", err
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
@@ -125,3 +125,30 @@ func (r *MCARRequest) RemoveNode() (string, error) {
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
+
+// ListNodeLog - Node 로그 목록
+func (r *MCARRequest) ListNodeLog() (string, error) {
+	// 입력데이터 검사
+	if r.InData == "" {
+		return "", errors.New("input data required")
+	}
+
+	// 입력데이터 언마샬링
+	var item pb.NodeLogQryRequest
+	err := gc.ConvertToMessage(r.InType, r.InData, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 서버에 요청
+	ctx, cancel := context.WithTimeout(context.Background(), r.Timeout)
+	defer cancel()
+
+	resp, err := r.Client.ListNodeLog(ctx, &item)
+	if err != nil {
+		return "", err
+	}
+
+	// 결과값 마샬링
+	return gc.ConvertToOutput(r.OutType, &resp)
+}
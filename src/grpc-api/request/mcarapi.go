@@ -46,9 +46,19 @@ type ClusterReq struct {
 
 // NodeConfig - Node 환경설정 구조 정의
 type NodeConfig struct {
-	Connection string `yaml:"connection" json:"connection"`
-	Count      int    `yaml:"count" json:"count"`
-	Spec       string `yaml:"spec" json:"spec"`
+	Connection string            `yaml:"connection" json:"connection"`
+	Count      int               `yaml:"count" json:"count"`
+	Spec       string            `yaml:"spec" json:"spec"`
+	Csi        bool              `yaml:"csi" json:"csi"`
+	Labels     map[string]string `yaml:"labels,omitempty" json:"labels,omitempty"`
+	Taints     []Taint           `yaml:"taints,omitempty" json:"taints,omitempty"`
+}
+
+// Taint - Node taint 구조 정의
+type Taint struct {
+	Key    string `yaml:"key" json:"key"`
+	Value  string `yaml:"value,omitempty" json:"value,omitempty"`
+	Effect string `yaml:"effect" json:"effect"`
 }
 
 // Config - 클러스터 환경설정 구조 정의
@@ -58,10 +68,76 @@ type Config struct {
 
 // Kubernetes - 쿠버네티스 환경설정 구조 정의
 type Kubernetes struct {
-	NetworkCni       string `yaml:"networkCni" json:"networkCni"`
-	PodCidr          string `yaml:"podCidr" json:"podCidr"`
-	ServiceCidr      string `yaml:"serviceCidr" json:"serviceCidr"`
-	ServiceDnsDomain string `yaml:"serviceDnsDomain" json:"serviceDnsDomain"`
+	NetworkCni        string          `yaml:"networkCni" json:"networkCni"`
+	PodCidr           string          `yaml:"podCidr" json:"podCidr"`
+	ServiceCidr       string          `yaml:"serviceCidr" json:"serviceCidr"`
+	ServiceDnsDomain  string          `yaml:"serviceDnsDomain" json:"serviceDnsDomain"`
+	Kilo              *Kilo           `yaml:"kilo,omitempty" json:"kilo,omitempty"`
+	FeatureGates      map[string]bool `yaml:"featureGates,omitempty" json:"featureGates,omitempty"`
+	ApiServer         *Component      `yaml:"apiServer,omitempty" json:"apiServer,omitempty"`
+	ControllerManager *Component      `yaml:"controllerManager,omitempty" json:"controllerManager,omitempty"`
+	Scheduler         *Component      `yaml:"scheduler,omitempty" json:"scheduler,omitempty"`
+	Kubelet           *Kubelet        `yaml:"kubelet,omitempty" json:"kubelet,omitempty"`
+	CertSANs          []string        `yaml:"certSANs,omitempty" json:"certSANs,omitempty"`
+	Etcd              *Etcd           `yaml:"etcd,omitempty" json:"etcd,omitempty"`
+	Endpoint          string          `yaml:"endpoint,omitempty" json:"endpoint,omitempty"`
+	Oidc              *Oidc           `yaml:"oidc,omitempty" json:"oidc,omitempty"`
+	Audit             *Audit          `yaml:"audit,omitempty" json:"audit,omitempty"`
+	Encryption        *Encryption     `yaml:"encryption,omitempty" json:"encryption,omitempty"`
+}
+
+// Component - 컨트롤 플레인 컴포넌트 환경설정 구조 정의
+type Component struct {
+	ExtraArgs map[string]string `yaml:"extraArgs,omitempty" json:"extraArgs,omitempty"`
+}
+
+// Kubelet - Kubelet 환경설정 구조 정의
+type Kubelet struct {
+	MaxPods        int               `yaml:"maxPods,omitempty" json:"maxPods,omitempty"`
+	SystemReserved map[string]string `yaml:"systemReserved,omitempty" json:"systemReserved,omitempty"`
+	KubeReserved   map[string]string `yaml:"kubeReserved,omitempty" json:"kubeReserved,omitempty"`
+	EvictionHard   map[string]string `yaml:"evictionHard,omitempty" json:"evictionHard,omitempty"`
+}
+
+// Oidc - API 서버 OIDC 인증 환경설정 구조 정의
+type Oidc struct {
+	IssuerUrl      string `yaml:"issuerUrl" json:"issuerUrl"`
+	ClientId       string `yaml:"clientId" json:"clientId"`
+	UsernameClaim  string `yaml:"usernameClaim,omitempty" json:"usernameClaim,omitempty"`
+	UsernamePrefix string `yaml:"usernamePrefix,omitempty" json:"usernamePrefix,omitempty"`
+	GroupsClaim    string `yaml:"groupsClaim,omitempty" json:"groupsClaim,omitempty"`
+	GroupsPrefix   string `yaml:"groupsPrefix,omitempty" json:"groupsPrefix,omitempty"`
+	CA             string `yaml:"ca,omitempty" json:"ca,omitempty"`
+}
+
+// Audit - API 서버 감사 로그 환경설정 구조 정의
+type Audit struct {
+	Level     string `yaml:"level" json:"level"`
+	Policy    string `yaml:"policy,omitempty" json:"policy,omitempty"`
+	MaxAge    int    `yaml:"maxAge" json:"maxAge"`
+	MaxBackup int    `yaml:"maxBackup" json:"maxBackup"`
+	MaxSize   int    `yaml:"maxSize" json:"maxSize"`
+}
+
+// Encryption - Secret 저장 암호화 환경설정 구조 정의
+type Encryption struct {
+	Provider  string   `yaml:"provider" json:"provider"`
+	Resources []string `yaml:"resources,omitempty" json:"resources,omitempty"`
+}
+
+// Etcd - Etcd 환경설정 구조 정의
+type Etcd struct {
+	DataDir   string            `yaml:"dataDir,omitempty" json:"dataDir,omitempty"`
+	ExtraArgs map[string]string `yaml:"extraArgs,omitempty" json:"extraArgs,omitempty"`
+}
+
+// Kilo - Kilo 토폴로지 환경설정 구조 정의
+type Kilo struct {
+	Topology            string            `yaml:"topology" json:"topology"`
+	Locations           map[string]string `yaml:"locations,omitempty" json:"locations,omitempty"`
+	PersistentKeepalive *int              `yaml:"persistentKeepalive,omitempty" json:"persistentKeepalive,omitempty"`
+	Port                *int              `yaml:"port,omitempty" json:"port,omitempty"`
+	AllowedLocationIPs  map[string]string `yaml:"allowedLocationIPs,omitempty" json:"allowedLocationIPs,omitempty"`
 }
 
 // NodeCreateRequest - NODE 생성 요청 구조 Wrapper 정의
@@ -77,6 +153,38 @@ type NodeReq struct {
 	Worker       []NodeConfig `yaml:"worker" json:"worker"`
 }
 
+// ManifestApplyRequest - Manifest 적용 요청 구조 Wrapper 정의
+type ManifestApplyRequest struct {
+	Namespace string      `yaml:"namespace" json:"namespace"`
+	Cluster   string      `yaml:"cluster" json:"cluster"`
+	Item      ManifestReq `yaml:"ReqInfo" json:"ReqInfo"`
+}
+
+// ManifestReq - Manifest 적용 요청 구조 정의
+type ManifestReq struct {
+	Manifest       string `yaml:"manifest" json:"manifest"`
+	PruneSelector  string `yaml:"pruneSelector" json:"pruneSelector"`
+	ForceConflicts bool   `yaml:"forceConflicts" json:"forceConflicts"`
+}
+
+// SpecQryRequest - Spec 목록 조회 조건 구조 정의
+type SpecQryRequest struct {
+	ConnectionName string `yaml:"connectionName" json:"connectionName"`
+	ControlPlane   string `yaml:"cluster,omitempty" json:"cluster,omitempty"`
+	CpuMin         string `yaml:"cpuMin,omitempty" json:"cpuMin,omitempty"`
+	CpuMax         string `yaml:"cpuMax,omitempty" json:"cpuMax,omitempty"`
+	MemoryMin      string `yaml:"memoryMin,omitempty" json:"memoryMin,omitempty"`
+	MemoryMax      string `yaml:"memoryMax,omitempty" json:"memoryMax,omitempty"`
+	ClockMin       string `yaml:"clockMin,omitempty" json:"clockMin,omitempty"`
+	ClockMax       string `yaml:"clockMax,omitempty" json:"clockMax,omitempty"`
+	Arch           string `yaml:"arch,omitempty" json:"arch,omitempty"`
+	Gpu            string `yaml:"gpu,omitempty" json:"gpu,omitempty"`
+	Name           string `yaml:"name,omitempty" json:"name,omitempty"`
+	Sort           string `yaml:"sort,omitempty" json:"sort,omitempty"`
+	Limit          string `yaml:"limit,omitempty" json:"limit,omitempty"`
+	Cursor         string `yaml:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
 // ===== [ Implementations ] =====
 
 // SetServerAddr - MCKS 서버 주소 설정
@@ -344,6 +452,16 @@ func (m *MCARApi) CreateClusterByParam(req *ClusterCreateRequest) (string, error
 	return result, err
 }
 
+// PlanCluster - Cluster 생성 계획 (dry-run)
+func (m *MCARApi) PlanCluster(doc string) (string, error) {
+	if m.requestMCAR == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	m.requestMCAR.InData = doc
+	return m.requestMCAR.PlanCluster()
+}
+
 // ListCluster - Cluster 목록
 func (m *MCARApi) ListCluster(doc string) (string, error) {
 	if m.requestMCAR == nil {
@@ -498,6 +616,31 @@ func (m *MCARApi) GetNodeByParam(namespace string, cluster string, node string)
 	return result, err
 }
 
+// ListNodeLog - Node 로그 목록
+func (m *MCARApi) ListNodeLog(doc string) (string, error) {
+	if m.requestMCAR == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	m.requestMCAR.InData = doc
+	return m.requestMCAR.ListNodeLog()
+}
+
+// ListNodeLogByParam - Node 로그 목록
+func (m *MCARApi) ListNodeLogByParam(namespace string, cluster string, node string, step string) (string, error) {
+	if m.requestMCAR == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := m.GetInType()
+	m.SetInType("json")
+	m.requestMCAR.InData = `{"namespace":"` + namespace + `", "cluster":"` + cluster + `", "node":"` + node + `", "step":"` + step + `"}`
+	result, err := m.requestMCAR.ListNodeLog()
+	m.SetInType(holdType)
+
+	return result, err
+}
+
 // RemoveNode - Node 삭제
 func (m *MCARApi) RemoveNode(doc string) (string, error) {
 	if m.requestMCAR == nil {
@@ -523,6 +666,73 @@ func (m *MCARApi) RemoveNodeByParam(namespace string, cluster string, node strin
 	return result, err
 }
 
+// ApplyManifest - Manifest 적용
+func (m *MCARApi) ApplyManifest(doc string) (string, error) {
+	if m.requestMCAR == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	m.requestMCAR.InData = doc
+	return m.requestMCAR.ApplyManifest()
+}
+
+// ApplyManifestByParam - Manifest 적용
+func (m *MCARApi) ApplyManifestByParam(req *ManifestApplyRequest) (string, error) {
+	if m.requestMCAR == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := m.GetInType()
+	m.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	m.requestMCAR.InData = string(j)
+	result, err := m.requestMCAR.ApplyManifest()
+	m.SetInType(holdType)
+
+	return result, err
+}
+
+// ListConnection - Connection 목록
+func (m *MCARApi) ListConnection() (string, error) {
+	if m.requestMCAR == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	return m.requestMCAR.ListConnection()
+}
+
+// ListSpec - Spec 목록
+func (m *MCARApi) ListSpec(doc string) (string, error) {
+	if m.requestMCAR == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	m.requestMCAR.InData = doc
+	return m.requestMCAR.ListSpec()
+}
+
+// ListSpecByParam - Spec 목록
+func (m *MCARApi) ListSpecByParam(req *SpecQryRequest) (string, error) {
+	if m.requestMCAR == nil {
+		return "", errors.New("The Open() function must be called")
+	}
+
+	holdType, _ := m.GetInType()
+	m.SetInType("json")
+	j, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	m.requestMCAR.InData = string(j)
+	result, err := m.requestMCAR.ListSpec()
+	m.SetInType(holdType)
+
+	return result, err
+}
+
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
@@ -36,7 +36,7 @@ func (s *MCARService) CreateCluster(ctx context.Context, req *pb.ClusterCreateRe
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.CreateCluster()")
 	}
 
-	cluster, err := service.CreateCluster(req.Namespace, req.Minorversion, req.Patchversion, &mcarObj)
+	cluster, err := service.CreateCluster(ctx, req.Namespace, req.Minorversion, req.Patchversion, &mcarObj)
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.CreateCluster()")
 	}
@@ -52,6 +52,40 @@ func (s *MCARService) CreateCluster(ctx context.Context, req *pb.ClusterCreateRe
 	return resp, nil
 }
 
+// PlanCluster - Cluster 생성 계획 (dry-run)
+func (s *MCARService) PlanCluster(ctx context.Context, req *pb.ClusterCreateRequest) (*pb.ClusterPlanResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling MCARService.PlanCluster()")
+
+	// GRPC 메시지에서 MCKS 객체로 복사
+	var mcarObj app.ClusterReq
+	err := gc.CopySrcToDest(&req.Item, &mcarObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.PlanCluster()")
+	}
+
+	s.ClusterReqDef(&mcarObj)
+	err = s.ClusterReqValidate(mcarObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.PlanCluster()")
+	}
+
+	plan, err := service.PlanCluster(req.Namespace, req.Minorversion, req.Patchversion, &mcarObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.PlanCluster()")
+	}
+
+	// MCKS 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.ClusterPlanResponse
+	err = gc.CopySrcToDest(plan, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.PlanCluster()")
+	}
+
+	return &grpcObj, nil
+}
+
 // ListCluster - Cluster 목록
 func (s *MCARService) ListCluster(ctx context.Context, req *pb.ClusterAllQryRequest) (*pb.ListClusterInfoResponse, error) {
 	logger := logger.NewLogger()
@@ -0,0 +1,59 @@
+package mcar
+
+import (
+	"context"
+
+	gc "github.com/cloud-barista/cb-mcks/src/grpc-api/common"
+	"github.com/cloud-barista/cb-mcks/src/grpc-api/logger"
+	pb "github.com/cloud-barista/cb-mcks/src/grpc-api/protobuf/cbmcks"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/service"
+)
+
+// ===== [ Constants and Variables ] =====
+
+// ===== [ Types ] =====
+
+// ===== [ Implementations ] =====
+
+// ApplyManifest - Manifest 적용
+func (s *MCARService) ApplyManifest(ctx context.Context, req *pb.ManifestApplyRequest) (*pb.ManifestResultResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling MCARService.ApplyManifest()")
+
+	if err := s.Validate(map[string]string{"cluster": req.Cluster}); err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ApplyManifest()")
+	}
+
+	// GRPC 메시지에서 MCKS 객체로 복사
+	var mcarObj app.ManifestReq
+	err := gc.CopySrcToDest(&req.Item, &mcarObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ApplyManifest()")
+	}
+
+	err = app.ManifestReqValidate(mcarObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ApplyManifest()")
+	}
+
+	result, err := service.ApplyManifest(ctx, req.Namespace, req.Cluster, &mcarObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ApplyManifest()")
+	}
+
+	// MCKS 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.ManifestResultResponse
+	err = gc.CopySrcToDest(&result, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ApplyManifest()")
+	}
+
+	return &grpcObj, nil
+}
+
+// ===== [ Private Functions ] =====
+
+// ===== [ Public Functions ] =====
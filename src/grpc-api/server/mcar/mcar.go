@@ -45,54 +45,14 @@ func (s *MCARService) ClusterReqDef(clusterReq *app.ClusterReq) error {
 	return nil
 }
 
+// ClusterReqValidate - REST API 와 동일한 검증 (app.ClusterReqValidate)
 func (s *MCARService) ClusterReqValidate(req app.ClusterReq) error {
-	if len(req.ControlPlane) == 0 {
-		return errors.New("control plane node must be at least one")
-	}
-	if len(req.ControlPlane) > 1 {
-		return errors.New("only one control plane node is supported")
-	}
-	if len(req.Worker) == 0 {
-		return errors.New("worker node must be at least one")
-	}
-	if !(req.Config.Kubernetes.NetworkCni == app.NETWORKCNI_CANAL || req.Config.Kubernetes.NetworkCni == app.NETWORKCNI_KILO) {
-		return errors.New("network cni allows only canal or kilo")
-	}
-
-	if len(req.Name) == 0 {
-		return errors.New("cluster name is empty")
-	} else {
-		err := lang.VerifyClusterName(req.Name)
-		if err != nil {
-			return err
-		}
-	}
-
-	if len(req.Config.Kubernetes.PodCidr) > 0 {
-		err := lang.VerifyCIDR("podCidr", req.Config.Kubernetes.PodCidr)
-		if err != nil {
-			return err
-		}
-	}
-	if len(req.Config.Kubernetes.ServiceCidr) > 0 {
-		err := lang.VerifyCIDR("serviceCidr", req.Config.Kubernetes.ServiceCidr)
-		if err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return app.ClusterReqValidate(req)
 }
 
+// NodeReqValidate - REST API 와 동일한 검증 (app.NodeReqValidate)
 func (s *MCARService) NodeReqValidate(req app.NodeReq) error {
-	if len(req.ControlPlane) > 0 {
-		return errors.New("control plane node is not supported")
-	}
-	if len(req.Worker) == 0 {
-		return errors.New("worker node must be at least one")
-	}
-
-	return nil
+	return app.NodeReqValidate(req)
 }
 
 // ===== [ Private Functions ] =====
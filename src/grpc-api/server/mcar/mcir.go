@@ -2,12 +2,15 @@ package mcar
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 
 	gc "github.com/cloud-barista/cb-mcks/src/grpc-api/common"
 	"github.com/cloud-barista/cb-mcks/src/grpc-api/logger"
 	pb "github.com/cloud-barista/cb-mcks/src/grpc-api/protobuf/cbmcks"
 
+	"github.com/cloud-barista/cb-mcks/src/core/app"
 	"github.com/cloud-barista/cb-mcks/src/core/service"
 )
 
@@ -16,50 +19,74 @@ func (s *MCARService) ListSpec(ctx context.Context, req *pb.SpecQryRequest) (*pb
 
 	logger.Debug("calling MCARService.ListSpec()")
 
-	control_plane := req.ControlPlane
-	if len(req.ControlPlane) == 0 {
-		control_plane = "N"
+	// GRPC 메시지에서 MCKS 객체로 변환 (숫자 파라미터 검사)
+	specReq := app.SpecListReq{
+		ControlPlane: req.ControlPlane,
+		Arch:         req.Arch,
+		Gpu:          req.Gpu,
+		Name:         req.Name,
+		Sort:         req.Sort,
+		Cursor:       req.Cursor,
 	}
-
-	cpumin, _ := strconv.Atoi(req.CpuMin)
-	cpumax, _ := strconv.Atoi(req.CpuMax)
-	memorymin, _ := strconv.Atoi(req.MemoryMin)
-	memorymax, _ := strconv.Atoi(req.MemoryMax)
-
-	if req.ControlPlane == "Y" {
-		if len(req.CpuMin) == 0 {
-			cpumin = 2
-		}
-		if len(req.MemoryMin) == 0 {
-			memorymin = 2
-		}
-	} else {
-		if len(req.CpuMin) == 0 {
-			cpumin = 1
-		}
-		if len(req.MemoryMin) == 0 {
-			memorymin = 1
+	var err error
+	for _, p := range []struct {
+		name  string
+		value string
+		dest  *int
+	}{{"cpuMin", req.CpuMin, &specReq.CpuMin}, {"cpuMax", req.CpuMax, &specReq.CpuMax}, {"limit", req.Limit, &specReq.Limit}} {
+		if len(p.value) > 0 {
+			if *p.dest, err = strconv.Atoi(p.value); err != nil {
+				return nil, gc.ConvGrpcStatusErr(errors.New(fmt.Sprintf("%s must be an integer. (%s=%s)", p.name, p.name, p.value)), "", "MCARService.ListSpec()")
+			}
 		}
 	}
-
-	if len(req.CpuMax) == 0 {
-		cpumax = 99999
+	for _, p := range []struct {
+		name  string
+		value string
+		dest  *float64
+	}{{"memoryMin", req.MemoryMin, &specReq.MemoryMin}, {"memoryMax", req.MemoryMax, &specReq.MemoryMax}, {"clockMin", req.ClockMin, &specReq.ClockMin}, {"clockMax", req.ClockMax, &specReq.ClockMax}} {
+		if len(p.value) > 0 {
+			if *p.dest, err = strconv.ParseFloat(p.value, 64); err != nil {
+				return nil, gc.ConvGrpcStatusErr(errors.New(fmt.Sprintf("%s must be a number. (%s=%s)", p.name, p.name, p.value)), "", "MCARService.ListSpec()")
+			}
+		}
 	}
-	if len(req.MemoryMax) == 0 {
-		memorymax = 99999
+	app.SpecListReqDef(&specReq)
+	if err := app.SpecListReqValidate(specReq); err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ListSpec()")
 	}
 
-	specList, err := service.VerifySpecList(req.Connectionname, control_plane, cpumin, cpumax, memorymin, memorymax)
+	specList, err := service.ListSpecs(req.Connectionname, specReq)
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ListSpec()")
 	}
 
 	// MCKS 객체에서 GRPC 메시지로 복사
 	var grpcObj pb.ListSpecInfoResponse
-	err = gc.CopySrcToDest(&specList, &grpcObj)
+	err = gc.CopySrcToDest(specList, &grpcObj)
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ListSpec()")
 	}
 
 	return &grpcObj, nil
 }
+
+func (s *MCARService) ListConnection(ctx context.Context, req *pb.Empty) (*pb.ListConnectionInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling MCARService.ListConnection()")
+
+	connectionList, err := service.ListConnections()
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ListConnection()")
+	}
+
+	// MCKS 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.ListConnectionInfoResponse
+	err = gc.CopySrcToDest(connectionList, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ListConnection()")
+	}
+
+	return &grpcObj, nil
+}
@@ -39,7 +39,7 @@ func (s *MCARService) AddNode(ctx context.Context, req *pb.NodeCreateRequest) (*
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.AddNode()")
 	}
 
-	node, err := service.AddNode(req.Namespace, req.Cluster, &mcarObj)
+	node, err := service.AddNode(ctx, req.Namespace, req.Cluster, &mcarObj)
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.AddNode()")
 	}
@@ -115,7 +115,7 @@ func (s *MCARService) RemoveNode(ctx context.Context, req *pb.NodeQryRequest) (*
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.GetNode()")
 	}
 
-	status, err := service.RemoveNode(req.Namespace, req.Cluster, req.Node)
+	status, err := service.RemoveNode(ctx, req.Namespace, req.Cluster, req.Node)
 	if err != nil {
 		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.RemoveNode()")
 	}
@@ -133,3 +133,28 @@ func (s *MCARService) RemoveNode(ctx context.Context, req *pb.NodeQryRequest) (*
 // ===== [ Private Functions ] =====
 
 // ===== [ Public Functions ] =====
+
+// ListNodeLog - Node 로그 목록 (프로비저닝 단계별 원격 명령 출력)
+func (s *MCARService) ListNodeLog(ctx context.Context, req *pb.NodeLogQryRequest) (*pb.ListNodeLogInfoResponse, error) {
+	logger := logger.NewLogger()
+
+	logger.Debug("calling MCARService.ListNodeLog()")
+
+	if err := s.Validate(map[string]string{"cluster": req.Cluster, "node": req.Node}); err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ListNodeLog()")
+	}
+
+	logs, err := service.ListNodeLog(req.Namespace, req.Cluster, req.Node, req.Step)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ListNodeLog()")
+	}
+
+	// MCKS 객체에서 GRPC 메시지로 복사
+	var grpcObj pb.ListNodeLogInfoResponse
+	err = gc.CopySrcToDest(&logs, &grpcObj)
+	if err != nil {
+		return nil, gc.ConvGrpcStatusErr(err, "", "MCARService.ListNodeLog()")
+	}
+
+	return &grpcObj, nil
+}
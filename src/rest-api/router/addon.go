@@ -0,0 +1,117 @@
+package router
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/service"
+
+	"github.com/labstack/echo/v4"
+	logger "github.com/sirupsen/logrus"
+)
+
+// ListAddon godoc
+// @Tags Addon
+// @Summary List all Add-ons in specified Cluster
+// @Description List all Add-ons in specified Cluster
+// @ID ListAddon
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Success 200 {object} model.AddonList
+// @Failure 400 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/addons [get]
+func ListAddon(c echo.Context) error {
+	if err := app.Validate(c, []string{"cluster"}); err != nil {
+		logger.Warnf("(ListAddon) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	addonList, err := service.ListAddon(c.Param("namespace"), c.Param("cluster"))
+	if err != nil {
+		logger.Warnf("(ListAddon) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	return app.Send(c, http.StatusOK, addonList)
+}
+
+// InstallAddon godoc
+// @Tags Addon
+// @Summary Install Add-on in specified Cluster
+// @Description Install Add-on in specified Cluster
+// @ID InstallAddon
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param addonReq body app.AddonReq true "Request Body to install add-on"
+// @Success 200 {object} model.Addon
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/addons [post]
+func InstallAddon(c echo.Context) error {
+	start := time.Now()
+	if err := app.Validate(c, []string{"cluster"}); err != nil {
+		logger.Warnf("(InstallAddon) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	addonReq := &app.AddonReq{}
+	if err := c.Bind(addonReq); err != nil {
+		logger.Warnf("(InstallAddon) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := app.AddonReqValidate(*addonReq); err != nil {
+		logger.Warnf("(InstallAddon) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	addon, err := service.InstallAddon(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), addonReq)
+	if err != nil {
+		logger.Warnf("(InstallAddon) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	logger.Info("(InstallAddon) Duration = ", time.Since(start))
+	return app.Send(c, http.StatusOK, addon)
+}
+
+// UninstallAddon godoc
+// @Tags Addon
+// @Summary Uninstall Add-on in specified Cluster
+// @Description Uninstall Add-on in specified Cluster
+// @ID UninstallAddon
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param	addon	path	string	true  "Add-on Name"
+// @Success 200 {object} app.Status
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/addons/{addon} [delete]
+func UninstallAddon(c echo.Context) error {
+	start := time.Now()
+	if err := app.Validate(c, []string{"cluster", "addon"}); err != nil {
+		logger.Warnf("(UninstallAddon) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	status, err := service.UninstallAddon(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), c.Param("addon"))
+	if err != nil {
+		logger.Warnf("(UninstallAddon) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	} else {
+		if status.Code == app.STATUS_NOTFOUND {
+			return app.Send(c, http.StatusNotFound, status)
+		} else {
+			logger.Info("(UninstallAddon) Duration = ", time.Since(start))
+			return app.Send(c, http.StatusOK, status)
+		}
+	}
+
+}
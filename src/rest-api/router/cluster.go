@@ -2,6 +2,7 @@ package router
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/cloud-barista/cb-mcks/src/core/app"
@@ -63,16 +64,18 @@ func GetCluster(c echo.Context) error {
 // CreateCluster godoc
 // @Tags Cluster
 // @Summary Create Cluster
-// @Description Create Cluster
+// @Description Create Cluster (dryRun=true : validate a request and returns a plan (model.ClusterPlan, 422 if invalid) without changes)
 // @ID CreateCluster
 // @Accept json
 // @Produce json
 // @Param	namespace	path	string	true  "Namespace ID"
 // @Param   minorversion  query    string   true  "string enums"    Enums(1.18, 1.23)
 // @Param   patchversion  path	int	true  "Patch version"
+// @Param   dryRun  query    bool   false  "Plan only (nothing is created)"    default(false)
 // @Param ClusterReq body app.ClusterReq true "Request Body to create cluster"
 // @Success 200 {object} model.Cluster
 // @Failure 400 {object} app.Status
+// @Failure 422 {object} model.ClusterPlan
 // @Failure 500 {object} app.Status
 // @Router /ns/{namespace}/clusters [post]
 func CreateCluster(c echo.Context) error {
@@ -90,7 +93,19 @@ func CreateCluster(c echo.Context) error {
 		logger.Warnf("(CreateCluster) %s", err.Error())
 		return app.SendMessage(c, http.StatusBadRequest, err.Error())
 	}
-	cluster, err := service.CreateCluster(c.Param("namespace"), c.QueryParam("minorversion"), c.QueryParam("patchversion"), clusterReq)
+
+	if dryRun, _ := strconv.ParseBool(c.QueryParam("dryRun")); dryRun {
+		plan, err := service.PlanCluster(c.Param("namespace"), c.QueryParam("minorversion"), c.QueryParam("patchversion"), clusterReq)
+		if err != nil {
+			logger.Warnf("(CreateCluster) %s", err.Error())
+			return app.SendMessage(c, http.StatusBadRequest, err.Error())
+		} else if !plan.Valid {
+			return app.Send(c, http.StatusUnprocessableEntity, plan)
+		}
+		return app.Send(c, http.StatusOK, plan)
+	}
+
+	cluster, err := service.CreateCluster(c.Request().Context(), c.Param("namespace"), c.QueryParam("minorversion"), c.QueryParam("patchversion"), clusterReq)
 	if err != nil {
 		logger.Warnf("(CreateCluster) %s", err.Error())
 		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
@@ -130,3 +145,153 @@ func DeleteCluster(c echo.Context) error {
 	logger.Info("(DeleteCluster) Duration = ", time.Since(start))
 	return app.Send(c, http.StatusOK, status)
 }
+
+// UpdateEndpoint godoc
+// @Tags Cluster
+// @Summary Update API server endpoint of Cluster
+// @Description Update API server endpoint of Cluster (refresh public IPs of nodes, regenerate API server certificates, manifests and kubeconfigs, update node IPs of kubelets). If endpoint is empty, a public IP of the control-plane leader is used.
+// @ID UpdateEndpoint
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param endpointReq body app.EndpointReq true "Request Body to update an endpoint"
+// @Success 200 {object} model.Cluster
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/endpoint [put]
+func UpdateEndpoint(c echo.Context) error {
+	start := time.Now()
+	if err := app.Validate(c, []string{"cluster"}); err != nil {
+		logger.Warnf("(UpdateEndpoint) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	endpointReq := &app.EndpointReq{}
+	if err := c.Bind(endpointReq); err != nil {
+		logger.Warnf("(UpdateEndpoint) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := app.EndpointReqValidate(*endpointReq); err != nil {
+		logger.Warnf("(UpdateEndpoint) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	cluster, err := service.UpdateEndpoint(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), endpointReq)
+	if err != nil {
+		logger.Warnf("(UpdateEndpoint) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	logger.Info("(UpdateEndpoint) Duration = ", time.Since(start))
+	return app.Send(c, http.StatusOK, cluster)
+}
+
+// UpdateOidc godoc
+// @Tags Cluster
+// @Summary Update OIDC authentication of Cluster
+// @Description Update OIDC authentication of Cluster (rewrite API server static pod manifests of all control-plane nodes). If issuerUrl and clientId are empty, OIDC authentication is disabled.
+// @ID UpdateOidc
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param oidcReq body app.ClusterConfigOidcReq true "Request Body to update OIDC settings"
+// @Success 200 {object} model.Cluster
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/oidc [put]
+func UpdateOidc(c echo.Context) error {
+	start := time.Now()
+	if err := app.Validate(c, []string{"cluster"}); err != nil {
+		logger.Warnf("(UpdateOidc) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	oidcReq := &app.ClusterConfigOidcReq{}
+	if err := c.Bind(oidcReq); err != nil {
+		logger.Warnf("(UpdateOidc) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	if oidcReq.IssuerUrl != "" || oidcReq.ClientId != "" {
+		if err := app.OidcReqValidate(*oidcReq); err != nil {
+			logger.Warnf("(UpdateOidc) %s", err.Error())
+			return app.SendMessage(c, http.StatusBadRequest, err.Error())
+		}
+	}
+
+	cluster, err := service.UpdateOidc(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), oidcReq)
+	if err != nil {
+		logger.Warnf("(UpdateOidc) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	logger.Info("(UpdateOidc) Duration = ", time.Since(start))
+	return app.Send(c, http.StatusOK, cluster)
+}
+
+// RotateEncryptionKey godoc
+// @Tags Cluster
+// @Summary Rotate encryption key of Cluster
+// @Description Rotate an AES key of encryption at rest (add a new key, re-encrypt resources and remove old keys on all control-plane nodes)
+// @ID RotateEncryptionKey
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Success 200 {object} app.Status
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/encryption/rotate [post]
+func RotateEncryptionKey(c echo.Context) error {
+	start := time.Now()
+	if err := app.Validate(c, []string{"cluster"}); err != nil {
+		logger.Warnf("(RotateEncryptionKey) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	status, err := service.RotateEncryptionKey(c.Request().Context(), c.Param("namespace"), c.Param("cluster"))
+	if err != nil {
+		logger.Warnf("(RotateEncryptionKey) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	logger.Info("(RotateEncryptionKey) Duration = ", time.Since(start))
+	return app.Send(c, http.StatusOK, status)
+}
+
+// EstimateCluster godoc
+// @Tags Cluster
+// @Summary Estimate Cluster cost
+// @Description Estimate hourly and monthly costs of a cluster request (a pricing table or CB-Spider price information)
+// @ID EstimateCluster
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param ClusterReq body app.ClusterReq true "Request Body to estimate cluster cost"
+// @Success 200 {object} model.CostEstimate
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/estimate [post]
+func EstimateCluster(c echo.Context) error {
+	clusterReq := &app.ClusterReq{}
+	if err := c.Bind(clusterReq); err != nil {
+		logger.Warnf("(EstimateCluster) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := app.ClusterReqValidate(*clusterReq); err != nil {
+		logger.Warnf("(EstimateCluster) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	estimate, err := service.EstimateCluster(c.Param("namespace"), clusterReq)
+	if err != nil {
+		logger.Warnf("(EstimateCluster) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return app.Send(c, http.StatusOK, estimate)
+}
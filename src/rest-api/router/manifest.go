@@ -0,0 +1,54 @@
+package router
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/service"
+
+	"github.com/labstack/echo/v4"
+	logger "github.com/sirupsen/logrus"
+)
+
+// ApplyManifest godoc
+// @Tags Manifest
+// @Summary Apply Manifests to specified Cluster
+// @Description Apply Manifests (one or more YAML documents) to specified Cluster with server-side apply. If pruneSelector is specified, only objects matching a label selector are applied and objects not in manifests are pruned.
+// @ID ApplyManifest
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param manifestReq body app.ManifestReq true "Request Body to apply manifests"
+// @Success 200 {object} model.ManifestResult
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/manifests [post]
+func ApplyManifest(c echo.Context) error {
+	start := time.Now()
+	if err := app.Validate(c, []string{"cluster"}); err != nil {
+		logger.Warnf("(ApplyManifest) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	manifestReq := &app.ManifestReq{}
+	if err := c.Bind(manifestReq); err != nil {
+		logger.Warnf("(ApplyManifest) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := app.ManifestReqValidate(*manifestReq); err != nil {
+		logger.Warnf("(ApplyManifest) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	result, err := service.ApplyManifest(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), manifestReq)
+	if err != nil {
+		logger.Warnf("(ApplyManifest) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	logger.Info("(ApplyManifest) Duration = ", time.Since(start))
+	return app.Send(c, http.StatusOK, result)
+}
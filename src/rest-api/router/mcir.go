@@ -2,8 +2,6 @@ package router
 
 import (
 	"net/http"
-	"strconv"
-	"strings"
 
 	"github.com/cloud-barista/cb-mcks/src/core/app"
 	"github.com/cloud-barista/cb-mcks/src/core/service"
@@ -11,57 +9,191 @@ import (
 	logger "github.com/sirupsen/logrus"
 )
 
+// ListConnection godoc
+// @Tags Mcir
+// @Summary List Connections
+// @Description List connections with a CSP, a region and a zone (whether the MCKS supports a CSP and an image is resolvable)
+// @ID ListConnection
+// @Accept json
+// @Produce json
+// @Success 200 {object} service.ConnectionList
+// @Failure 500 {object} app.Status
+// @Router /mcir/connections [get]
+func ListConnection(c echo.Context) error {
+
+	connections, err := service.ListConnections()
+	if err != nil {
+		logger.Warnf("(ListConnection) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return app.Send(c, http.StatusOK, connections)
+}
+
 // ListSpec godoc
 // @Tags Mcir
 // @Summary List Specs
-// @Description List Specs
+// @Description List Specs (filters, a sort and a cursor pagination)
 // @ID List Spec
 // @Accept json
 // @Produce json
 // @Param	connection	path	string		true  "Connection Name"
-// @Param   control-plane  query    string    	true  "string enums"       Enums(Y, N)
+// @Param   control-plane  query    string    	false  "string enums"       Enums(Y, N)    default(N)
 // @Param   cpu-min     query     int        	false  "if Control-Plane, >= 2"           minimum(1)
-// @Param   cpu-max     query     int        	false  " <= 99999"          minimum(1)    maximum(99999)
-// @Param   memory-min     query     int        false  " if Control-Plane, >= 2"          minimum(1)
-// @Param   memory-max     query     int        false  " <= 99999"          minimum(1)    maximum(99999)
+// @Param   cpu-max     query     int        	false  "0 = unlimited"          minimum(0)
+// @Param   memory-min     query     number        false  "GiB, if Control-Plane, >= 2"          minimum(0)
+// @Param   memory-max     query     number        false  "GiB, 0 = unlimited"          minimum(0)
+// @Param   clock-min     query     number        false  "GHz"          minimum(0)
+// @Param   clock-max     query     number        false  "GHz, 0 = unlimited"          minimum(0)
+// @Param   arch     query     string        false  "Architecture"          Enums(x86_64, arm64)
+// @Param   gpu     query     string        false  "Y (with GPUs), N (without GPUs)"          Enums(Y, N)
+// @Param   name     query     string        false  "Name pattern (glob, case-insensitive)"
+// @Param   sort     query     string        false  "Sort key ('-' prefix for descending)"          Enums(name, -name, cpu, -cpu, memory, -memory, clock, -clock)    default(name)
+// @Param   limit     query     int        false  "Page size (0 = unlimited)"          minimum(0)    maximum(1000)
+// @Param   cursor     query     string        false  "Cursor of a next page (nextCursor)"
 // @Success 200 {object} service.SpecList
 // @Failure 400 {object} app.Status
+// @Failure 404 {object} app.Status
 // @Router /mcir/connections/{connection}/specs [get]
 func ListSpec(c echo.Context) error {
 
-	controlPlane := c.QueryParam("control-plane")
-	if controlPlane == "" {
-		controlPlane = "N"
+	req := &app.SpecListReq{}
+	if err := c.Bind(req); err != nil {
+		logger.Warnf("(ListSpec) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+	app.SpecListReqDef(req)
+	if err := app.SpecListReqValidate(*req); err != nil {
+		logger.Warnf("(ListSpec) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
 	}
-	cpumin := validateSpec(c, "cpu-min")
-	memorymin := validateSpec(c, "memory-min")
-	cpumax := validateSpec(c, "cpu-max")
-	memorymax := validateSpec(c, "memory-max")
-
-	lookupSpecs, err := service.VerifySpecList(c.Param("connection"), controlPlane, cpumin, cpumax, memorymin, memorymax)
 
+	specList, err := service.ListSpecs(c.Param("connection"), *req)
 	if err != nil {
 		logger.Warnf("(ListSpec) %s'", err.Error())
 		return app.SendMessage(c, http.StatusNotFound, err.Error())
 	}
 
-	return app.Send(c, http.StatusOK, lookupSpecs)
+	return app.Send(c, http.StatusOK, specList)
 }
 
-func validateSpec(c echo.Context, param string) int {
-	controlPlane := c.QueryParam("control-plane")
-	if c.QueryParam(param) == "" {
-		if strings.Contains(param, "min") {
-			if controlPlane == "Y" {
-				return 2
-			} else {
-				return 1
-			}
-		}
-		if strings.Contains(param, "max") {
-			return 99999
-		}
+// RecommendSpec godoc
+// @Tags Mcir
+// @Summary Recommend Specs
+// @Description Recommend specs of candidate connections that meet cpu & memory requirements (ranked by a price or the closest fit)
+// @ID RecommendSpec
+// @Accept json
+// @Produce json
+// @Param   role        query    string    false  "Node role"        Enums(control-plane, worker)    default(worker)
+// @Param   cpu         query    int       false  "Minimum vCPUs"    minimum(0)
+// @Param   memory      query    int       false  "Minimum memory (GiB)"    minimum(0)
+// @Param   connection  query    []string  true   "Candidate connection names (repeated or comma-separated)"    collectionFormat(multi)
+// @Param   sort        query    string    false  "Sort order"       Enums(price, fit)    default(price)
+// @Param   limit       query    int       false  "Maximum number of specs"    minimum(1)    maximum(100)    default(20)
+// @Success 200 {object} service.SpecRecommendList
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /mcir/specs/recommend [get]
+func RecommendSpec(c echo.Context) error {
+
+	req := &app.SpecRecommendReq{}
+	if err := c.Bind(req); err != nil {
+		logger.Warnf("(RecommendSpec) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
 	}
-	returnParam, _ := strconv.Atoi(c.QueryParam(param))
-	return returnParam
+	app.SpecRecommendReqDef(req)
+	if err := app.SpecRecommendReqValidate(*req); err != nil {
+		logger.Warnf("(RecommendSpec) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	specs, err := service.RecommendSpecs(*req)
+	if err != nil {
+		logger.Warnf("(RecommendSpec) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return app.Send(c, http.StatusOK, specs)
+}
+
+// GetBastion godoc
+// @Tags Mcir
+// @Summary Get a Bastion of a Connection
+// @Description Get a bastion (jump-host) of a connection (a private key is not returned)
+// @ID GetBastion
+// @Accept json
+// @Produce json
+// @Param	connection	path	string	true  "Connection Name"
+// @Success 200 {object} model.Bastion
+// @Failure 404 {object} app.Status
+// @Router /mcir/connections/{connection}/bastion [get]
+func GetBastion(c echo.Context) error {
+
+	bastion, err := service.GetBastion(c.Param("connection"))
+	if err != nil {
+		logger.Warnf("(GetBastion) %s", err.Error())
+		return app.SendMessage(c, http.StatusNotFound, err.Error())
+	}
+
+	return app.Send(c, http.StatusOK, bastion)
+}
+
+// PutBastion godoc
+// @Tags Mcir
+// @Summary Create or update a Bastion of a Connection
+// @Description Create or update a bastion (jump-host) of a connection, SSH/SCP to nodes of the connection tunnels through the bastion and private IPs of nodes are used (nodes without public IPs)
+// @ID PutBastion
+// @Accept json
+// @Produce json
+// @Param	connection	path	string	true  "Connection Name"
+// @Param bastionReq body app.BastionReq true "Request Body to create or update a bastion"
+// @Success 200 {object} model.Bastion
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /mcir/connections/{connection}/bastion [put]
+func PutBastion(c echo.Context) error {
+
+	req := &app.BastionReq{}
+	if err := c.Bind(req); err != nil {
+		logger.Warnf("(PutBastion) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+	app.BastionReqDef(req)
+	if err := app.BastionReqValidate(*req); err != nil {
+		logger.Warnf("(PutBastion) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	bastion, err := service.PutBastion(c.Param("connection"), req)
+	if err != nil {
+		logger.Warnf("(PutBastion) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	return app.Send(c, http.StatusOK, bastion)
+}
+
+// DeleteBastion godoc
+// @Tags Mcir
+// @Summary Delete a Bastion of a Connection
+// @Description Delete a bastion (jump-host) of a connection
+// @ID DeleteBastion
+// @Accept json
+// @Produce json
+// @Param	connection	path	string	true  "Connection Name"
+// @Success 200 {object} app.Status
+// @Failure 404 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /mcir/connections/{connection}/bastion [delete]
+func DeleteBastion(c echo.Context) error {
+
+	status, err := service.DeleteBastion(c.Param("connection"))
+	if err != nil {
+		logger.Warnf("(DeleteBastion) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	} else if status.Code == app.STATUS_NOTFOUND {
+		return app.Send(c, http.StatusNotFound, status)
+	}
+
+	return app.Send(c, http.StatusOK, status)
 }
@@ -100,7 +100,7 @@ func AddNode(c echo.Context) error {
 		return app.SendMessage(c, http.StatusBadRequest, err.Error())
 	}
 
-	node, err := service.AddNode(c.Param("namespace"), c.Param("cluster"), nodeReq)
+	node, err := service.AddNode(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), nodeReq)
 	if err != nil {
 		logger.Warnf("(AddNode) %s", err.Error())
 		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
@@ -131,7 +131,7 @@ func RemoveNode(c echo.Context) error {
 		return app.SendMessage(c, http.StatusBadRequest, err.Error())
 	}
 
-	status, err := service.RemoveNode(c.Param("namespace"), c.Param("cluster"), c.Param("node"))
+	status, err := service.RemoveNode(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), c.Param("node"))
 	if err != nil {
 		logger.Warnf("(RemoveNode) %s", err.Error())
 		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
@@ -145,3 +145,107 @@ func RemoveNode(c echo.Context) error {
 	}
 
 }
+
+// UpdateNode godoc
+// @Tags Node
+// @Summary Update Node labels and taints in specified Cluster
+// @Description Update labels and taints of a Node (replace all user labels and taints, topology labels are managed by MCKS)
+// @ID UpdateNode
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param	node	path	string	true  "Node Name"
+// @Param nodeUpdateReq body app.NodeUpdateReq true "Request Body to update labels and taints"
+// @Success 200 {object} model.Node
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/nodes/{node} [put]
+func UpdateNode(c echo.Context) error {
+	start := time.Now()
+	if err := app.Validate(c, []string{"cluster", "node"}); err != nil {
+		logger.Warnf("(UpdateNode) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	nodeUpdateReq := &app.NodeUpdateReq{}
+	if err := c.Bind(nodeUpdateReq); err != nil {
+		logger.Warnf("(UpdateNode) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := app.NodeUpdateReqValidate(*nodeUpdateReq); err != nil {
+		logger.Warnf("(UpdateNode) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	node, err := service.UpdateNode(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), c.Param("node"), nodeUpdateReq)
+	if err != nil {
+		logger.Warnf("(UpdateNode) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	logger.Info("(UpdateNode) Duration = ", time.Since(start))
+	return app.Send(c, http.StatusOK, node)
+}
+
+// ResetHostKey godoc
+// @Tags Node
+// @Summary Reset a pinned host key of a Node
+// @Description Reset a pinned SSH host key of a Node and pin a host key of a new contact (e.g. a host key is regenerated on a VM)
+// @ID ResetHostKey
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param	node	path	string	true  "Node Name"
+// @Success 200 {object} model.Node
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/nodes/{node}/hostkey/reset [post]
+func ResetHostKey(c echo.Context) error {
+	start := time.Now()
+	if err := app.Validate(c, []string{"cluster", "node"}); err != nil {
+		logger.Warnf("(ResetHostKey) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	node, err := service.ResetHostKey(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), c.Param("node"))
+	if err != nil {
+		logger.Warnf("(ResetHostKey) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	logger.Info("(ResetHostKey) Duration = ", time.Since(start))
+	return app.Send(c, http.StatusOK, node)
+}
+
+// ListNodeLog godoc
+// @Tags Node
+// @Summary List logs of a Node
+// @Description List outputs of remote commands of a Node per a provisioning step (stdout, stderr, size-capped)
+// @ID ListNodeLog
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param	node	path	string	true  "Node Name"
+// @Param	step	query	string	false  "Provisioning step (empty : all steps)"	Enums(bootstrap, haproxy, init, join, cni, other)
+// @Success 200 {object} model.NodeLogList
+// @Failure 400 {object} app.Status
+// @Failure 404 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/nodes/{node}/logs [get]
+func ListNodeLog(c echo.Context) error {
+	if err := app.Validate(c, []string{"cluster", "node"}); err != nil {
+		logger.Warnf("(ListNodeLog) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	logs, err := service.ListNodeLog(c.Param("namespace"), c.Param("cluster"), c.Param("node"), c.QueryParam("step"))
+	if err != nil {
+		logger.Warnf("(ListNodeLog) %s", err.Error())
+		return app.SendMessage(c, http.StatusNotFound, err.Error())
+	}
+
+	return app.Send(c, http.StatusOK, logs)
+}
@@ -0,0 +1,232 @@
+package router
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/cloud-barista/cb-mcks/src/core/app"
+	"github.com/cloud-barista/cb-mcks/src/core/service"
+
+	"github.com/labstack/echo/v4"
+	logger "github.com/sirupsen/logrus"
+)
+
+// ListRelease godoc
+// @Tags Release
+// @Summary List all Helm Releases in specified Cluster
+// @Description List all Helm Releases in specified Cluster
+// @ID ListRelease
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Success 200 {object} model.ReleaseList
+// @Failure 400 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/releases [get]
+func ListRelease(c echo.Context) error {
+	if err := app.Validate(c, []string{"cluster"}); err != nil {
+		logger.Warnf("(ListRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	releaseList, err := service.ListRelease(c.Param("namespace"), c.Param("cluster"))
+	if err != nil {
+		logger.Warnf("(ListRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	return app.Send(c, http.StatusOK, releaseList)
+}
+
+// GetRelease godoc
+// @Tags Release
+// @Summary Get Helm Release in specified Cluster
+// @Description Get Helm Release in specified Cluster
+// @ID GetRelease
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param	release	path	string	true  "Release Name"
+// @Success 200 {object} model.Release
+// @Failure 400 {object} app.Status
+// @Failure 404 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/releases/{release} [get]
+func GetRelease(c echo.Context) error {
+	if err := app.Validate(c, []string{"cluster", "release"}); err != nil {
+		logger.Warnf("(GetRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	release, err := service.GetRelease(c.Param("namespace"), c.Param("cluster"), c.Param("release"))
+	if err != nil {
+		logger.Warnf("(GetRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusNotFound, err.Error())
+	}
+
+	return app.Send(c, http.StatusOK, release)
+}
+
+// InstallRelease godoc
+// @Tags Release
+// @Summary Install Helm Release in specified Cluster
+// @Description Install Helm Release in specified Cluster
+// @ID InstallRelease
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param releaseReq body app.ReleaseReq true "Request Body to install a release"
+// @Success 200 {object} model.Release
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/releases [post]
+func InstallRelease(c echo.Context) error {
+	start := time.Now()
+	if err := app.Validate(c, []string{"cluster"}); err != nil {
+		logger.Warnf("(InstallRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	releaseReq := &app.ReleaseReq{}
+	if err := c.Bind(releaseReq); err != nil {
+		logger.Warnf("(InstallRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	if err := app.ReleaseReqValidate(*releaseReq); err != nil {
+		logger.Warnf("(InstallRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	release, err := service.InstallRelease(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), releaseReq)
+	if err != nil {
+		logger.Warnf("(InstallRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	logger.Info("(InstallRelease) Duration = ", time.Since(start))
+	return app.Send(c, http.StatusOK, release)
+}
+
+// UpgradeRelease godoc
+// @Tags Release
+// @Summary Upgrade Helm Release in specified Cluster
+// @Description Upgrade Helm Release in specified Cluster (if chart, repo or version is empty, a installed one is used)
+// @ID UpgradeRelease
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param	release	path	string	true  "Release Name"
+// @Param releaseReq body app.ReleaseReq true "Request Body to upgrade a release"
+// @Success 200 {object} model.Release
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/releases/{release} [put]
+func UpgradeRelease(c echo.Context) error {
+	start := time.Now()
+	if err := app.Validate(c, []string{"cluster", "release"}); err != nil {
+		logger.Warnf("(UpgradeRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	releaseReq := &app.ReleaseReq{}
+	if err := c.Bind(releaseReq); err != nil {
+		logger.Warnf("(UpgradeRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+	releaseReq.Name = c.Param("release")
+
+	if err := app.ReleaseReqValidate(*releaseReq); err != nil {
+		logger.Warnf("(UpgradeRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	release, err := service.UpgradeRelease(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), c.Param("release"), releaseReq)
+	if err != nil {
+		logger.Warnf("(UpgradeRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	logger.Info("(UpgradeRelease) Duration = ", time.Since(start))
+	return app.Send(c, http.StatusOK, release)
+}
+
+// RollbackRelease godoc
+// @Tags Release
+// @Summary Rollback Helm Release in specified Cluster
+// @Description Rollback Helm Release in specified Cluster (if revision is 0, rollback to the previous revision)
+// @ID RollbackRelease
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param	release	path	string	true  "Release Name"
+// @Param rollbackReq body app.ReleaseRollbackReq true "Request Body to rollback a release"
+// @Success 200 {object} model.Release
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/releases/{release}/rollback [post]
+func RollbackRelease(c echo.Context) error {
+	start := time.Now()
+	if err := app.Validate(c, []string{"cluster", "release"}); err != nil {
+		logger.Warnf("(RollbackRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	rollbackReq := &app.ReleaseRollbackReq{}
+	if err := c.Bind(rollbackReq); err != nil {
+		logger.Warnf("(RollbackRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+	if rollbackReq.Revision < 0 {
+		logger.Warnf("(RollbackRelease) Revision must be zero or positive")
+		return app.SendMessage(c, http.StatusBadRequest, "Revision must be zero or positive")
+	}
+
+	release, err := service.RollbackRelease(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), c.Param("release"), rollbackReq)
+	if err != nil {
+		logger.Warnf("(RollbackRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	}
+
+	logger.Info("(RollbackRelease) Duration = ", time.Since(start))
+	return app.Send(c, http.StatusOK, release)
+}
+
+// UninstallRelease godoc
+// @Tags Release
+// @Summary Uninstall Helm Release in specified Cluster
+// @Description Uninstall Helm Release in specified Cluster
+// @ID UninstallRelease
+// @Accept json
+// @Produce json
+// @Param	namespace	path	string	true  "Namespace ID"
+// @Param	cluster	path	string	true  "Cluster Name"
+// @Param	release	path	string	true  "Release Name"
+// @Success 200 {object} app.Status
+// @Failure 400 {object} app.Status
+// @Failure 500 {object} app.Status
+// @Router /ns/{namespace}/clusters/{cluster}/releases/{release} [delete]
+func UninstallRelease(c echo.Context) error {
+	start := time.Now()
+	if err := app.Validate(c, []string{"cluster", "release"}); err != nil {
+		logger.Warnf("(UninstallRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusBadRequest, err.Error())
+	}
+
+	status, err := service.UninstallRelease(c.Request().Context(), c.Param("namespace"), c.Param("cluster"), c.Param("release"))
+	if err != nil {
+		logger.Warnf("(UninstallRelease) %s", err.Error())
+		return app.SendMessage(c, http.StatusInternalServerError, err.Error())
+	} else {
+		if status.Code == app.STATUS_NOTFOUND {
+			return app.Send(c, http.StatusNotFound, status)
+		} else {
+			logger.Info("(UninstallRelease) Duration = ", time.Since(start))
+			return app.Send(c, http.StatusOK, status)
+		}
+	}
+
+}
@@ -33,20 +33,45 @@ func Server() {
 
 	m := e.Group(*app.Config.RootURL + "/mcir/connections")
 
+	m.GET("", router.ListConnection)
 	m.GET("/:connection/specs", router.ListSpec)
+	m.GET("/:connection/bastion", router.GetBastion)
+	m.PUT("/:connection/bastion", router.PutBastion)
+	m.DELETE("/:connection/bastion", router.DeleteBastion)
+	e.GET(*app.Config.RootURL+"/mcir/specs/recommend", router.RecommendSpec)
 
 	g := e.Group(*app.Config.RootURL+"/ns", validMiddlewareFunc())
 
 	// Routes
 	g.GET("/:namespace/clusters", router.ListCluster)
 	g.POST("/:namespace/clusters", router.CreateCluster)
+	g.POST("/:namespace/clusters/estimate", router.EstimateCluster)
 	g.GET("/:namespace/clusters/:cluster", router.GetCluster)
 	g.DELETE("/:namespace/clusters/:cluster", router.DeleteCluster)
+	g.PUT("/:namespace/clusters/:cluster/endpoint", router.UpdateEndpoint)
+	g.PUT("/:namespace/clusters/:cluster/oidc", router.UpdateOidc)
+	g.POST("/:namespace/clusters/:cluster/encryption/rotate", router.RotateEncryptionKey)
 
 	g.GET("/:namespace/clusters/:cluster/nodes", router.ListNode)
 	g.POST("/:namespace/clusters/:cluster/nodes", router.AddNode)
 	g.GET("/:namespace/clusters/:cluster/nodes/:node", router.GetNode)
+	g.PUT("/:namespace/clusters/:cluster/nodes/:node", router.UpdateNode)
 	g.DELETE("/:namespace/clusters/:cluster/nodes/:node", router.RemoveNode)
+	g.POST("/:namespace/clusters/:cluster/nodes/:node/hostkey/reset", router.ResetHostKey)
+	g.GET("/:namespace/clusters/:cluster/nodes/:node/logs", router.ListNodeLog)
+
+	g.GET("/:namespace/clusters/:cluster/addons", router.ListAddon)
+	g.POST("/:namespace/clusters/:cluster/addons", router.InstallAddon)
+	g.DELETE("/:namespace/clusters/:cluster/addons/:addon", router.UninstallAddon)
+
+	g.POST("/:namespace/clusters/:cluster/manifests", router.ApplyManifest)
+
+	g.GET("/:namespace/clusters/:cluster/releases", router.ListRelease)
+	g.POST("/:namespace/clusters/:cluster/releases", router.InstallRelease)
+	g.GET("/:namespace/clusters/:cluster/releases/:release", router.GetRelease)
+	g.PUT("/:namespace/clusters/:cluster/releases/:release", router.UpgradeRelease)
+	g.POST("/:namespace/clusters/:cluster/releases/:release/rollback", router.RollbackRelease)
+	g.DELETE("/:namespace/clusters/:cluster/releases/:release", router.UninstallRelease)
 
 	// Start server
 	e.Logger.Fatal(e.Start(":1470"))
@@ -2,8 +2,10 @@
 K8S_VERSION="$1"	# curl https://packages.cloud.google.com/apt/dists/kubernetes-xenial/main/binary-amd64/Packages
 CSP="$2"
 HOSTNAME="$3"
-PUBLIC_IP="$4"			# openstack
+PUBLIC_IP="$4"			# openstack, private
 NETWORK_CNI="$5"
+NODE_IP_TYPE="$6"		# public, private (bastion 경유 노드는 사설 IP 를 node-ip 로 사용)
+ANNOTATION="$7"			# mcks-bootstrap 이 부팅 시 지정하는 node annotation (network-cni 별, ${PUBLIC_IP} 포함)
 
 # hostname
 sudo hostnamectl set-hostname ${HOSTNAME}
@@ -99,15 +101,18 @@ fi
 sudo apt-get install -y kubeadm=${K8S_VERSION} kubelet=${K8S_VERSION} kubectl=${K8S_VERSION}
 sudo apt-mark hold kubeadm kubelet kubectl
 
-if [ "${CSP}" != "openstack" ]; then 
+if [ "${CSP}" != "openstack" ] && [ "${NODE_IP_TYPE}" != "private" ]; then 
 	PUBLIC_IP='$(dig +short myip.opendns.com @resolver1.opendns.com)'
 fi
 
-if [ "${NETWORK_CNI}" == "kilo" ]; then 
-# install wireguard
-sudo add-apt-repository -y ppa:wireguard/wireguard
-sudo apt-get update
-sudo apt-get install -y wireguard
+# node annotation (network-cni 에 annotation 이 없으면 annotate 생략)
+if [ -n "${ANNOTATION}" ]; then
+	SED_ANNOTATION="s|{{ANNOTATION}}|${ANNOTATION}|g"
+else
+	SED_ANNOTATION="/{{ANNOTATION}}/d"
+fi
+
+if [ "${NETWORK_CNI}" != "canal" ]; then 
 # mcks-bootstrap
 echo -e '#!/bin/sh
 IFACE="$(ip route get 8.8.8.8 | awk \047{ print $5; exit }\047)"
@@ -116,10 +121,10 @@ ifconfig ${IFACE}:1 ${PUBLIC_IP} netmask 255.255.255.255  broadcast 0.0.0.0 up
 echo "KUBELET_EXTRA_ARGS=-\"-node-ip=${PUBLIC_IP}\"" > /etc/default/kubelet
 if [ -f "/etc/kubernetes/kubelet.conf" ]; then
   systemctl restart kubelet
-  kubectl --kubeconfig=/etc/kubernetes/kubelet.conf annotate node {{HOSTNAME}} kilo.squat.ai/force-endpoint=${PUBLIC_IP}:51820 --overwrite
+  kubectl --kubeconfig=/etc/kubernetes/kubelet.conf annotate node {{HOSTNAME}} {{ANNOTATION}} --overwrite
 fi
 exit 0
-fi' | sed "s/{{HOSTNAME}}/${HOSTNAME}/g" | sed "s/{{PUBLIC_IP}}/${PUBLIC_IP}/g" | sudo tee /lib/systemd/system/mcks-bootstrap > /dev/null
+fi' | sed "${SED_ANNOTATION}" | sed "s/{{HOSTNAME}}/${HOSTNAME}/g" | sed "s/{{PUBLIC_IP}}/${PUBLIC_IP}/g" | sudo tee /lib/systemd/system/mcks-bootstrap > /dev/null
 sudo chmod +x /lib/systemd/system/mcks-bootstrap
 fi
 